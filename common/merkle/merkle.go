@@ -0,0 +1,136 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package merkle
+
+import (
+	"crypto/sha256"
+	"errors"
+
+	"github.com/dappley/go-dappley/common/hash"
+)
+
+// Leaves and inner nodes are hashed with different prefixes so that an inner node can never be passed off as a leaf.
+// A node without a sibling is moved up to the next level unchanged instead of being paired with itself, so that two
+// different lists of leaves never have the same root.
+const (
+	leafPrefix  byte = 0
+	innerPrefix byte = 1
+)
+
+var (
+	ErrIndexOutOfRange = errors.New("merkle: leaf index is out of range")
+	ErrInvalidBranch   = errors.New("merkle: branch length does not match the number of leaves")
+)
+
+//CalculateRoot returns the root of the binary Merkle tree over the leaves. The root of no leaves is the hash of
+//an empty input
+func CalculateRoot(leaves [][]byte) hash.Hash {
+	if len(leaves) == 0 {
+		root := sha256.Sum256(nil)
+		return root[:]
+	}
+
+	level := make([]hash.Hash, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = hashLeaf(leaf)
+	}
+	for len(level) > 1 {
+		level = nextLevel(level)
+	}
+	return level[0]
+}
+
+//GetBranch returns the sibling hashes on the path from the leaf at the index up to the root, from the bottom level up.
+//Levels where the node has no sibling are skipped
+func GetBranch(leaves [][]byte, index int) ([]hash.Hash, error) {
+	if index < 0 || index >= len(leaves) {
+		return nil, ErrIndexOutOfRange
+	}
+
+	level := make([]hash.Hash, len(leaves))
+	for i, leaf := range leaves {
+		level[i] = hashLeaf(leaf)
+	}
+	branch := []hash.Hash{}
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < len(level) {
+			branch = append(branch, level[sibling])
+		}
+		level = nextLevel(level)
+		index /= 2
+	}
+	return branch, nil
+}
+
+//CalculateRootFromBranch returns the root of a tree with numLeaves leaves in which the leaf at the index has the
+//input branch
+func CalculateRootFromBranch(leaf []byte, index int, numLeaves int, branch []hash.Hash) (hash.Hash, error) {
+	if index < 0 || index >= numLeaves {
+		return nil, ErrIndexOutOfRange
+	}
+
+	node := hashLeaf(leaf)
+	for levelSize := numLeaves; levelSize > 1; levelSize = (levelSize + 1) / 2 {
+		sibling := index ^ 1
+		if sibling < levelSize {
+			if len(branch) == 0 {
+				return nil, ErrInvalidBranch
+			}
+			if index%2 == 0 {
+				node = hashInner(node, branch[0])
+			} else {
+				node = hashInner(branch[0], node)
+			}
+			branch = branch[1:]
+		}
+		index /= 2
+	}
+	if len(branch) != 0 {
+		return nil, ErrInvalidBranch
+	}
+	return node, nil
+}
+
+//nextLevel pairs up the nodes of a level and returns their parents
+func nextLevel(level []hash.Hash) []hash.Hash {
+	next := make([]hash.Hash, 0, (len(level)+1)/2)
+	for i := 0; i < len(level); i += 2 {
+		if i+1 == len(level) {
+			next = append(next, level[i])
+			break
+		}
+		next = append(next, hashInner(level[i], level[i+1]))
+	}
+	return next
+}
+
+func hashLeaf(leaf []byte) hash.Hash {
+	h := sha256.Sum256(append([]byte{leafPrefix}, leaf...))
+	return h[:]
+}
+
+func hashInner(left, right hash.Hash) hash.Hash {
+	data := make([]byte, 0, 1+len(left)+len(right))
+	data = append(data, innerPrefix)
+	data = append(data, left...)
+	data = append(data, right...)
+	h := sha256.Sum256(data)
+	return h[:]
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package merkle
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"testing"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+func generateLeaves(n int) [][]byte {
+	leaves := make([][]byte, n)
+	for i := range leaves {
+		leaves[i] = []byte(fmt.Sprintf("leaf%d", i))
+	}
+	return leaves
+}
+
+func TestCalculateRoot(t *testing.T) {
+	empty := sha256.Sum256(nil)
+	assert.Equal(t, hash.Hash(empty[:]), CalculateRoot(nil))
+
+	leaves := generateLeaves(3)
+	expected := hashInner(hashInner(hashLeaf(leaves[0]), hashLeaf(leaves[1])), hashLeaf(leaves[2]))
+	assert.Equal(t, expected, CalculateRoot(leaves))
+
+	//repeating the last leaf changes the root
+	assert.NotEqual(t, CalculateRoot(leaves), CalculateRoot(append(leaves, leaves[2])))
+}
+
+func TestGetBranch(t *testing.T) {
+	for numLeaves := 1; numLeaves <= 9; numLeaves++ {
+		leaves := generateLeaves(numLeaves)
+		root := CalculateRoot(leaves)
+		for index := range leaves {
+			branch, err := GetBranch(leaves, index)
+			assert.Nil(t, err)
+
+			branchRoot, err := CalculateRootFromBranch(leaves[index], index, numLeaves, branch)
+			assert.Nil(t, err)
+			assert.Equal(t, root, branchRoot)
+
+			branchRoot, err = CalculateRootFromBranch([]byte("fake"), index, numLeaves, branch)
+			assert.Nil(t, err)
+			assert.NotEqual(t, root, branchRoot)
+		}
+	}
+
+	_, err := GetBranch(generateLeaves(2), 2)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+}
+
+func TestCalculateRootFromBranch_InvalidBranch(t *testing.T) {
+	leaves := generateLeaves(4)
+	branch, err := GetBranch(leaves, 1)
+	assert.Nil(t, err)
+
+	_, err = CalculateRootFromBranch(leaves[1], 4, 4, branch)
+	assert.Equal(t, ErrIndexOutOfRange, err)
+	_, err = CalculateRootFromBranch(leaves[1], 1, 4, branch[:1])
+	assert.Equal(t, ErrInvalidBranch, err)
+	_, err = CalculateRootFromBranch(leaves[1], 1, 2, branch)
+	assert.Equal(t, ErrInvalidBranch, err)
+}
@@ -91,16 +91,19 @@ func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 		}
 		switch len(val) {
 		case 16: // Branch Node
+			if len(curRoute) == 0 {
+				return errors.New("wrong hash")
+			}
 			wantHash = val[curRoute[0]]
 			curRoute = curRoute[1:]
 			break
 		case 3: // Extension Node or Leaf Node
-			if val[0] == nil || len(val) == 0 {
+			if len(val[0]) == 0 {
 				return errors.New("unknown node type")
 			}
 			if val[0][0] == byte(ext) {
 				extLen := len(val[1])
-				if !bytes.Equal(val[1], curRoute[:extLen]) {
+				if extLen > len(curRoute) || !bytes.Equal(val[1], curRoute[:extLen]) {
 					return errors.New("wrong hash")
 				}
 				wantHash = val[2]
@@ -117,5 +120,5 @@ func (t *Trie) Verify(rootHash []byte, key []byte, proof MerkleProof) error {
 			return errors.New("wrong node value, expect [16][]byte or [3][]byte, get [" + string(len(proofHash)) + "][]byte")
 		}
 	}
-	return nil
+	return errors.New("incomplete proof")
 }
@@ -2,11 +2,12 @@
 // versions:
 // 	protoc-gen-go v1.23.0
 // 	protoc        v3.13.0
-// source: pb/config.proto
+// source: github.com/dappley/go-dappley/config/pb/config.proto
 
 package configpb
 
 import (
+	pb "github.com/dappley/go-dappley/consensus/pb"
 	proto "github.com/golang/protobuf/proto"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -37,7 +38,7 @@ type Config struct {
 func (x *Config) Reset() {
 	*x = Config{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_config_proto_msgTypes[0]
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -50,7 +51,7 @@ func (x *Config) String() string {
 func (*Config) ProtoMessage() {}
 
 func (x *Config) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_config_proto_msgTypes[0]
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,7 +64,7 @@ func (x *Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Config.ProtoReflect.Descriptor instead.
 func (*Config) Descriptor() ([]byte, []int) {
-	return file_pb_config_proto_rawDescGZIP(), []int{0}
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{0}
 }
 
 func (x *Config) GetConsensusConfig() *ConsensusConfig {
@@ -85,14 +86,21 @@ type ConsensusConfig struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MinerAddress string `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
-	PrivateKey   string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	MinerAddress           string `protobuf:"bytes,1,opt,name=miner_address,json=minerAddress,proto3" json:"miner_address,omitempty"`
+	PrivateKey             string `protobuf:"bytes,2,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	Type                   string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`                                                                     // "dpos" by default, "instant" seals a block whenever transactions arrive
+	Keystore               string `protobuf:"bytes,4,opt,name=keystore,proto3" json:"keystore,omitempty"`                                                             // encrypted key file of the producer, used instead of private_key
+	KeystorePassphraseFile string `protobuf:"bytes,5,opt,name=keystore_passphrase_file,json=keystorePassphraseFile,proto3" json:"keystore_passphrase_file,omitempty"` // file with the passphrase of the keystore, prompted on start if empty
+	RemoteSigner           string `protobuf:"bytes,6,opt,name=remote_signer,json=remoteSigner,proto3" json:"remote_signer,omitempty"`                                 // host:port or unix:///path of the signer server holding the producer key
+	RemoteSignerCert       string `protobuf:"bytes,7,opt,name=remote_signer_cert,json=remoteSignerCert,proto3" json:"remote_signer_cert,omitempty"`                   // client certificate presented to a signer server on a TCP address
+	RemoteSignerKey        string `protobuf:"bytes,8,opt,name=remote_signer_key,json=remoteSignerKey,proto3" json:"remote_signer_key,omitempty"`                      // key of the client certificate
+	RemoteSignerCa         string `protobuf:"bytes,9,opt,name=remote_signer_ca,json=remoteSignerCa,proto3" json:"remote_signer_ca,omitempty"`                         // CA that issued the certificate of the signer server
 }
 
 func (x *ConsensusConfig) Reset() {
 	*x = ConsensusConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_config_proto_msgTypes[1]
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -105,7 +113,7 @@ func (x *ConsensusConfig) String() string {
 func (*ConsensusConfig) ProtoMessage() {}
 
 func (x *ConsensusConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_config_proto_msgTypes[1]
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -118,7 +126,7 @@ func (x *ConsensusConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConsensusConfig.ProtoReflect.Descriptor instead.
 func (*ConsensusConfig) Descriptor() ([]byte, []int) {
-	return file_pb_config_proto_rawDescGZIP(), []int{1}
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *ConsensusConfig) GetMinerAddress() string {
@@ -135,6 +143,55 @@ func (x *ConsensusConfig) GetPrivateKey() string {
 	return ""
 }
 
+func (x *ConsensusConfig) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetKeystore() string {
+	if x != nil {
+		return x.Keystore
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetKeystorePassphraseFile() string {
+	if x != nil {
+		return x.KeystorePassphraseFile
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetRemoteSigner() string {
+	if x != nil {
+		return x.RemoteSigner
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetRemoteSignerCert() string {
+	if x != nil {
+		return x.RemoteSignerCert
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetRemoteSignerKey() string {
+	if x != nil {
+		return x.RemoteSignerKey
+	}
+	return ""
+}
+
+func (x *ConsensusConfig) GetRemoteSignerCa() string {
+	if x != nil {
+		return x.RemoteSignerCa
+	}
+	return ""
+}
+
 type NodeConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -151,12 +208,16 @@ type NodeConfig struct {
 	GenesisPath            string   `protobuf:"bytes,9,opt,name=genesis_path,json=genesisPath,proto3" json:"genesis_path,omitempty"`
 	MetricsPollingInterval int64    `protobuf:"varint,12,opt,name=metrics_polling_interval,json=metricsPollingInterval,proto3" json:"metrics_polling_interval,omitempty"` // seconds
 	MetricsInterval        int64    `protobuf:"varint,13,opt,name=metrics_interval,json=metricsInterval,proto3" json:"metrics_interval,omitempty"`                        // seconds
+	MaxClockDrift          uint32   `protobuf:"varint,14,opt,name=max_clock_drift,json=maxClockDrift,proto3" json:"max_clock_drift,omitempty"`                            // seconds a block may be stamped ahead of the local clock, 2 by default
+	LightNode              bool     `protobuf:"varint,15,opt,name=light_node,json=lightNode,proto3" json:"light_node,omitempty"`                                          // sync only the block headers and fetch the rest from full peers with proofs
+	PruneDepth             uint64   `protobuf:"varint,16,opt,name=prune_depth,json=pruneDepth,proto3" json:"prune_depth,omitempty"`                                       // blocks kept below the LIB before their bodies and state changelogs are pruned, 0 keeps all blocks
+	DbEngine               string   `protobuf:"bytes,17,opt,name=db_engine,json=dbEngine,proto3" json:"db_engine,omitempty"`                                              // storage backend of the database, "leveldb" by default or "badger"
 }
 
 func (x *NodeConfig) Reset() {
 	*x = NodeConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_config_proto_msgTypes[2]
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -169,7 +230,7 @@ func (x *NodeConfig) String() string {
 func (*NodeConfig) ProtoMessage() {}
 
 func (x *NodeConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_config_proto_msgTypes[2]
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -182,7 +243,7 @@ func (x *NodeConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
 func (*NodeConfig) Descriptor() ([]byte, []int) {
-	return file_pb_config_proto_rawDescGZIP(), []int{2}
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *NodeConfig) GetPort() uint32 {
@@ -262,19 +323,57 @@ func (x *NodeConfig) GetMetricsInterval() int64 {
 	return 0
 }
 
+func (x *NodeConfig) GetMaxClockDrift() uint32 {
+	if x != nil {
+		return x.MaxClockDrift
+	}
+	return 0
+}
+
+func (x *NodeConfig) GetLightNode() bool {
+	if x != nil {
+		return x.LightNode
+	}
+	return false
+}
+
+func (x *NodeConfig) GetPruneDepth() uint64 {
+	if x != nil {
+		return x.PruneDepth
+	}
+	return 0
+}
+
+func (x *NodeConfig) GetDbEngine() string {
+	if x != nil {
+		return x.DbEngine
+	}
+	return ""
+}
+
 type DynastyConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Producers    []string `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers,omitempty"`
-	MaxProducers uint32   `protobuf:"varint,2,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	Producers            []string             `protobuf:"bytes,1,rep,name=producers,proto3" json:"producers,omitempty"`
+	MaxProducers         uint32               `protobuf:"varint,2,opt,name=max_producers,json=maxProducers,proto3" json:"max_producers,omitempty"`
+	DynastyChanges       []*pb.DynastyChange  `protobuf:"bytes,3,rep,name=dynasty_changes,json=dynastyChanges,proto3" json:"dynasty_changes,omitempty"`
+	Forks                *ForkConfig          `protobuf:"bytes,4,opt,name=forks,proto3" json:"forks,omitempty"`                                                               // heights from which the rules added after the launch of the network are enforced
+	TimeBetweenBlk       uint32               `protobuf:"varint,5,opt,name=time_between_blk,json=timeBetweenBlk,proto3" json:"time_between_blk,omitempty"`                    // seconds per slot, 5 by default
+	MaxMintingTimeMs     uint32               `protobuf:"varint,6,opt,name=max_minting_time_ms,json=maxMintingTimeMs,proto3" json:"max_minting_time_ms,omitempty"`            // time budget to fill a block, 30% of the slot (at most 1500) by default
+	EpochLength          uint64               `protobuf:"varint,7,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty"`                               // blocks per election epoch, a multiple of max_producers
+	LibConfirmationRatio float64              `protobuf:"fixed64,8,opt,name=lib_confirmation_ratio,json=libConfirmationRatio,proto3" json:"lib_confirmation_ratio,omitempty"` // share of the producers confirming or finalizing a block before it becomes the LIB, between 2/3 (default) and 1
+	ChainId              uint64               `protobuf:"varint,9,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                           // id of the network, 1 by default
+	GenesisTimestamp     int64                `protobuf:"varint,10,opt,name=genesis_timestamp,json=genesisTimestamp,proto3" json:"genesis_timestamp,omitempty"`               // unix time of the genesis block in seconds
+	Allocations          []*GenesisAllocation `protobuf:"bytes,11,rep,name=allocations,proto3" json:"allocations,omitempty"`                                                  // balances paid in the genesis block, the subsidy to the default genesis address if empty
+	Contracts            []*GenesisContract   `protobuf:"bytes,12,rep,name=contracts,proto3" json:"contracts,omitempty"`                                                      // contracts deployed in the genesis block
 }
 
 func (x *DynastyConfig) Reset() {
 	*x = DynastyConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_config_proto_msgTypes[3]
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -287,7 +386,7 @@ func (x *DynastyConfig) String() string {
 func (*DynastyConfig) ProtoMessage() {}
 
 func (x *DynastyConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_config_proto_msgTypes[3]
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -300,7 +399,7 @@ func (x *DynastyConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DynastyConfig.ProtoReflect.Descriptor instead.
 func (*DynastyConfig) Descriptor() ([]byte, []int) {
-	return file_pb_config_proto_rawDescGZIP(), []int{3}
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DynastyConfig) GetProducers() []string {
@@ -317,6 +416,281 @@ func (x *DynastyConfig) GetMaxProducers() uint32 {
 	return 0
 }
 
+func (x *DynastyConfig) GetDynastyChanges() []*pb.DynastyChange {
+	if x != nil {
+		return x.DynastyChanges
+	}
+	return nil
+}
+
+func (x *DynastyConfig) GetForks() *ForkConfig {
+	if x != nil {
+		return x.Forks
+	}
+	return nil
+}
+
+func (x *DynastyConfig) GetTimeBetweenBlk() uint32 {
+	if x != nil {
+		return x.TimeBetweenBlk
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetMaxMintingTimeMs() uint32 {
+	if x != nil {
+		return x.MaxMintingTimeMs
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetEpochLength() uint64 {
+	if x != nil {
+		return x.EpochLength
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetLibConfirmationRatio() float64 {
+	if x != nil {
+		return x.LibConfirmationRatio
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetGenesisTimestamp() int64 {
+	if x != nil {
+		return x.GenesisTimestamp
+	}
+	return 0
+}
+
+func (x *DynastyConfig) GetAllocations() []*GenesisAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+func (x *DynastyConfig) GetContracts() []*GenesisContract {
+	if x != nil {
+		return x.Contracts
+	}
+	return nil
+}
+
+type ForkConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VrfHeight           uint64 `protobuf:"varint,1,opt,name=vrf_height,json=vrfHeight,proto3" json:"vrf_height,omitempty"`                                 // first block that has to carry the vrf proof of its producer, 0 from genesis
+	SlotTimestampHeight uint64 `protobuf:"varint,2,opt,name=slot_timestamp_height,json=slotTimestampHeight,proto3" json:"slot_timestamp_height,omitempty"` // first block that has to be stamped at the start of a later slot than its parent
+	StateRootHeight     uint64 `protobuf:"varint,3,opt,name=state_root_height,json=stateRootHeight,proto3" json:"state_root_height,omitempty"`             // first block that has to carry the state root after its transactions
+	MerkleRootHeight    uint64 `protobuf:"varint,4,opt,name=merkle_root_height,json=merkleRootHeight,proto3" json:"merkle_root_height,omitempty"`          // first block whose transactions are hashed into a merkle root
+	ChainId             uint64 `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`                                       // chain id of a network whose genesis block has none, ignored if the genesis block has one
+	ChainIdHeight       uint64 `protobuf:"varint,6,opt,name=chain_id_height,json=chainIdHeight,proto3" json:"chain_id_height,omitempty"`                   // first block whose transactions have to be signed for the chain id
+}
+
+func (x *ForkConfig) Reset() {
+	*x = ForkConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ForkConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ForkConfig) ProtoMessage() {}
+
+func (x *ForkConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ForkConfig.ProtoReflect.Descriptor instead.
+func (*ForkConfig) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ForkConfig) GetVrfHeight() uint64 {
+	if x != nil {
+		return x.VrfHeight
+	}
+	return 0
+}
+
+func (x *ForkConfig) GetSlotTimestampHeight() uint64 {
+	if x != nil {
+		return x.SlotTimestampHeight
+	}
+	return 0
+}
+
+func (x *ForkConfig) GetStateRootHeight() uint64 {
+	if x != nil {
+		return x.StateRootHeight
+	}
+	return 0
+}
+
+func (x *ForkConfig) GetMerkleRootHeight() uint64 {
+	if x != nil {
+		return x.MerkleRootHeight
+	}
+	return 0
+}
+
+func (x *ForkConfig) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *ForkConfig) GetChainIdHeight() uint64 {
+	if x != nil {
+		return x.ChainIdHeight
+	}
+	return 0
+}
+
+type GenesisAllocation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *GenesisAllocation) Reset() {
+	*x = GenesisAllocation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisAllocation) ProtoMessage() {}
+
+func (x *GenesisAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisAllocation.ProtoReflect.Descriptor instead.
+func (*GenesisAllocation) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GenesisAllocation) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GenesisAllocation) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type GenesisContract struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`                         // contract address
+	SourceFile string            `protobuf:"bytes,2,opt,name=source_file,json=sourceFile,proto3" json:"source_file,omitempty"` // path of the contract source, relative to the genesis file
+	Storage    map[string]string `protobuf:"bytes,3,rep,name=storage,proto3" json:"storage,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *GenesisContract) Reset() {
+	*x = GenesisContract{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisContract) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisContract) ProtoMessage() {}
+
+func (x *GenesisContract) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisContract.ProtoReflect.Descriptor instead.
+func (*GenesisContract) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GenesisContract) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GenesisContract) GetSourceFile() string {
+	if x != nil {
+		return x.SourceFile
+	}
+	return ""
+}
+
+func (x *GenesisContract) GetStorage() map[string]string {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
 type CliConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -329,7 +703,7 @@ type CliConfig struct {
 func (x *CliConfig) Reset() {
 	*x = CliConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pb_config_proto_msgTypes[4]
+		mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -342,7 +716,7 @@ func (x *CliConfig) String() string {
 func (*CliConfig) ProtoMessage() {}
 
 func (x *CliConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_config_proto_msgTypes[4]
+	mi := &file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -355,7 +729,7 @@ func (x *CliConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CliConfig.ProtoReflect.Descriptor instead.
 func (*CliConfig) Descriptor() ([]byte, []int) {
-	return file_pb_config_proto_rawDescGZIP(), []int{4}
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *CliConfig) GetPort() uint32 {
@@ -372,97 +746,202 @@ func (x *CliConfig) GetPassword() string {
 	return ""
 }
 
-var File_pb_config_proto protoreflect.FileDescriptor
-
-var file_pb_config_proto_rawDesc = []byte{
-	0x0a, 0x0f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x12, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x22, 0x85, 0x01, 0x0a, 0x06,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e,
-	0x73, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73,
-	0x65, 0x6e, 0x73, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
-	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x0b,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x22, 0x57, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+var File_github_com_dappley_go_dappley_config_pb_config_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_config_pb_config_proto_rawDesc = []byte{
+	0x0a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x70, 0x62, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62,
+	0x1a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x79, 0x6e,
+	0x61, 0x73, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x85, 0x01, 0x0a, 0x06, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73,
+	0x75, 0x73, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x65,
+	0x6e, 0x73, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x35, 0x0a, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x4e, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x22, 0xea, 0x02, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
 	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f,
 	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d,
 	0x69, 0x6e, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
 	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xef, 0x02, 0x0a,
-	0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x73,
-	0x65, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x50, 0x61, 0x74, 0x68, 0x12, 0x19, 0x0a, 0x08,
-	0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x78, 0x5f,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x41,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69,
-	0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x18, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x6d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72,
-	0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x69,
-	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x6d,
-	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22, 0x52,
-	0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a,
-	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x73, 0x22, 0x3b, 0x0a, 0x09, 0x43, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70,
-	0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x52, 0x0a, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x38, 0x0a, 0x18,
+	0x6b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
+	0x61, 0x73, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
+	0x6b, 0x65, 0x79, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x72,
+	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x65, 0x72,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x43, 0x65, 0x72, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x43, 0x61, 0x22,
+	0xf4, 0x03, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x64, 0x62, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x62, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0d,
+	0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x6f,
+	0x64, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x61, 0x74, 0x68, 0x12, 0x38, 0x0a, 0x18,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x5f, 0x70, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x5f,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x29, 0x0a, 0x10, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x64,
+	0x72, 0x69, 0x66, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x44, 0x72, 0x69, 0x66, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x69, 0x67,
+	0x68, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6c,
+	0x69, 0x67, 0x68, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x75, 0x6e,
+	0x65, 0x5f, 0x64, 0x65, 0x70, 0x74, 0x68, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x70,
+	0x72, 0x75, 0x6e, 0x65, 0x44, 0x65, 0x70, 0x74, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x64, 0x62, 0x5f,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x62,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x22, 0xb5, 0x04, 0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61, 0x73,
+	0x74, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d,
+	0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x43, 0x0a, 0x0f, 0x64,
+	0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73,
+	0x70, 0x62, 0x2e, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x52, 0x0e, 0x64, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73,
+	0x12, 0x2a, 0x0a, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x46, 0x6f, 0x72, 0x6b, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x05, 0x66, 0x6f, 0x72, 0x6b, 0x73, 0x12, 0x28, 0x0a, 0x10,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x65, 0x74, 0x77, 0x65, 0x65, 0x6e, 0x5f, 0x62, 0x6c, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x69, 0x6d, 0x65, 0x42, 0x65, 0x74, 0x77,
+	0x65, 0x65, 0x6e, 0x42, 0x6c, 0x6b, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x69,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x54,
+	0x69, 0x6d, 0x65, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x6c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x16, 0x6c, 0x69, 0x62, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x14, 0x6c, 0x69, 0x62, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x19,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x41, 0x6c,
+	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x73, 0x22, 0xfc,
+	0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x72, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1d, 0x0a,
+	0x0a, 0x76, 0x72, 0x66, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x76, 0x72, 0x66, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x32, 0x0a, 0x15,
+	0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x13, 0x73, 0x6c, 0x6f,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x72, 0x6b, 0x6c, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x45, 0x0a,
+	0x11, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x41, 0x6c, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
+	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x66, 0x69, 0x6c,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x2e,
+	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x3a, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x22, 0x3b, 0x0a, 0x09, 0x43, 0x6c, 0x69, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
-	file_pb_config_proto_rawDescOnce sync.Once
-	file_pb_config_proto_rawDescData = file_pb_config_proto_rawDesc
+	file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescData = file_github_com_dappley_go_dappley_config_pb_config_proto_rawDesc
 )
 
-func file_pb_config_proto_rawDescGZIP() []byte {
-	file_pb_config_proto_rawDescOnce.Do(func() {
-		file_pb_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_pb_config_proto_rawDescData)
+func file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescData)
 	})
-	return file_pb_config_proto_rawDescData
+	return file_github_com_dappley_go_dappley_config_pb_config_proto_rawDescData
 }
 
-var file_pb_config_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
-var file_pb_config_proto_goTypes = []interface{}{
-	(*Config)(nil),          // 0: configpb.Config
-	(*ConsensusConfig)(nil), // 1: configpb.ConsensusConfig
-	(*NodeConfig)(nil),      // 2: configpb.NodeConfig
-	(*DynastyConfig)(nil),   // 3: configpb.DynastyConfig
-	(*CliConfig)(nil),       // 4: configpb.CliConfig
+var file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_github_com_dappley_go_dappley_config_pb_config_proto_goTypes = []interface{}{
+	(*Config)(nil),            // 0: configpb.Config
+	(*ConsensusConfig)(nil),   // 1: configpb.ConsensusConfig
+	(*NodeConfig)(nil),        // 2: configpb.NodeConfig
+	(*DynastyConfig)(nil),     // 3: configpb.DynastyConfig
+	(*ForkConfig)(nil),        // 4: configpb.ForkConfig
+	(*GenesisAllocation)(nil), // 5: configpb.GenesisAllocation
+	(*GenesisContract)(nil),   // 6: configpb.GenesisContract
+	(*CliConfig)(nil),         // 7: configpb.CliConfig
+	nil,                       // 8: configpb.GenesisContract.StorageEntry
+	(*pb.DynastyChange)(nil),  // 9: consensuspb.DynastyChange
 }
-var file_pb_config_proto_depIdxs = []int32{
+var file_github_com_dappley_go_dappley_config_pb_config_proto_depIdxs = []int32{
 	1, // 0: configpb.Config.consensus_config:type_name -> configpb.ConsensusConfig
 	2, // 1: configpb.Config.node_config:type_name -> configpb.NodeConfig
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	9, // 2: configpb.DynastyConfig.dynasty_changes:type_name -> consensuspb.DynastyChange
+	4, // 3: configpb.DynastyConfig.forks:type_name -> configpb.ForkConfig
+	5, // 4: configpb.DynastyConfig.allocations:type_name -> configpb.GenesisAllocation
+	6, // 5: configpb.DynastyConfig.contracts:type_name -> configpb.GenesisContract
+	8, // 6: configpb.GenesisContract.storage:type_name -> configpb.GenesisContract.StorageEntry
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
-func init() { file_pb_config_proto_init() }
-func file_pb_config_proto_init() {
-	if File_pb_config_proto != nil {
+func init() { file_github_com_dappley_go_dappley_config_pb_config_proto_init() }
+func file_github_com_dappley_go_dappley_config_pb_config_proto_init() {
+	if File_github_com_dappley_go_dappley_config_pb_config_proto != nil {
 		return
 	}
 	if !protoimpl.UnsafeEnabled {
-		file_pb_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Config); i {
 			case 0:
 				return &v.state
@@ -474,7 +953,7 @@ func file_pb_config_proto_init() {
 				return nil
 			}
 		}
-		file_pb_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConsensusConfig); i {
 			case 0:
 				return &v.state
@@ -486,7 +965,7 @@ func file_pb_config_proto_init() {
 				return nil
 			}
 		}
-		file_pb_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeConfig); i {
 			case 0:
 				return &v.state
@@ -498,7 +977,7 @@ func file_pb_config_proto_init() {
 				return nil
 			}
 		}
-		file_pb_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DynastyConfig); i {
 			case 0:
 				return &v.state
@@ -510,7 +989,43 @@ func file_pb_config_proto_init() {
 				return nil
 			}
 		}
-		file_pb_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForkConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisAllocation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisContract); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CliConfig); i {
 			case 0:
 				return &v.state
@@ -527,18 +1042,18 @@ func file_pb_config_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_pb_config_proto_rawDesc,
+			RawDescriptor: file_github_com_dappley_go_dappley_config_pb_config_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
-		GoTypes:           file_pb_config_proto_goTypes,
-		DependencyIndexes: file_pb_config_proto_depIdxs,
-		MessageInfos:      file_pb_config_proto_msgTypes,
+		GoTypes:           file_github_com_dappley_go_dappley_config_pb_config_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_config_pb_config_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_config_pb_config_proto_msgTypes,
 	}.Build()
-	File_pb_config_proto = out.File
-	file_pb_config_proto_rawDesc = nil
-	file_pb_config_proto_goTypes = nil
-	file_pb_config_proto_depIdxs = nil
+	File_github_com_dappley_go_dappley_config_pb_config_proto = out.File
+	file_github_com_dappley_go_dappley_config_pb_config_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_config_pb_config_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_config_pb_config_proto_depIdxs = nil
 }
@@ -0,0 +1,45 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"errors"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/block"
+)
+
+var (
+	ErrDoubleSignHeader    = errors.New("block signer: a different block is signed in the same time slot")
+	ErrDoubleSignPreCommit = errors.New("block signer: a different block is precommitted at the same height")
+	ErrSlotRegression      = errors.New("block signer: a block in a later time slot is signed already")
+	ErrHeightRegression    = errors.New("block signer: a block at a greater height is precommitted already")
+	ErrInvalidKey          = errors.New("block signer: the private key is invalid")
+	ErrInvalidCA           = errors.New("block signer: the CA file has no certificate")
+	ErrTLSRequired         = errors.New("block signer: a TCP connection to the signer server requires mutual TLS")
+)
+
+// BlockSigner signs blocks, VRF proofs and finality votes on behalf of a producer, so that the consensus never holds
+// the private key of the producer
+type BlockSigner interface {
+	GetAddress() string
+	SignHeader(header *block.SignedHeader) (hash.Hash, error)
+	ProveVRF(message []byte) ([]byte, error)
+	SignPreCommit(blockHash hash.Hash, height uint64) (*block.PreCommit, error)
+}
@@ -0,0 +1,149 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/crypto"
+	"github.com/dappley/go-dappley/crypto/cipher"
+	"github.com/dappley/go-dappley/crypto/keystore"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1/vrf/secp256k1VRF"
+)
+
+const keystoreFilePerm = 0600
+
+// LocalSigner signs with a producer key held in the memory of the process. The key is loaded from a keystore file
+// encrypted with a passphrase
+type LocalSigner struct {
+	key     keystore.PrivateKey
+	address string
+}
+
+//NewLocalSigner returns a signer of the input private key
+func NewLocalSigner(key keystore.PrivateKey) (*LocalSigner, error) {
+	pubKey, err := key.PublicKey().Encoded()
+	if err != nil {
+		return nil, err
+	}
+	if ok, _ := account.IsValidPubKey(pubKey[1:]); !ok {
+		return nil, ErrInvalidKey
+	}
+	return &LocalSigner{
+		key:     key,
+		address: account.NewTransactionAccountByPubKey(pubKey[1:]).GetAddress().String(),
+	}, nil
+}
+
+//NewLocalSignerFromHex returns a signer of the private key in hex
+func NewLocalSignerFromHex(key string) (*LocalSigner, error) {
+	privData, err := hex.DecodeString(key)
+	if err != nil || len(privData) == 0 {
+		return nil, ErrInvalidKey
+	}
+	privKey, err := crypto.NewPrivateKey(keystore.SECP256K1, privData)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalSigner(privKey)
+}
+
+//LoadLocalSigner decrypts the keystore file with the passphrase and returns a signer of its key
+func LoadLocalSigner(path string, passphrase []byte) (*LocalSigner, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	privData, err := cipher.NewCipher(uint8(keystore.SCRYPT)).DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := crypto.NewPrivateKey(keystore.SECP256K1, privData)
+	if err != nil {
+		return nil, err
+	}
+	return NewLocalSigner(privKey)
+}
+
+//SaveKeystore encrypts the key of the signer with the passphrase and writes it to the keystore file
+func (signer *LocalSigner) SaveKeystore(path string, passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return keystore.ErrInvalidPassphrase
+	}
+	privData, err := signer.key.Encoded()
+	if err != nil {
+		return err
+	}
+	keyJSON, err := cipher.NewCipher(uint8(keystore.SCRYPT)).EncryptKey(signer.address, privData, passphrase)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, keyJSON, keystoreFilePerm)
+}
+
+//GetAddress returns the address of the producer
+func (signer *LocalSigner) GetAddress() string {
+	return signer.address
+}
+
+//SignHeader returns the signature of the block hash of the header
+func (signer *LocalSigner) SignHeader(header *block.SignedHeader) (hash.Hash, error) {
+	return signer.sign(header.Hash())
+}
+
+//ProveVRF evaluates the VRF of the producer key on the message and returns the proof
+func (signer *LocalSigner) ProveVRF(message []byte) ([]byte, error) {
+	privData, err := signer.key.Encoded()
+	if err != nil {
+		return nil, err
+	}
+	vrfSigner, err := secp256k1VRF.NewVRFSignerFromRawKey(privData)
+	if err != nil {
+		return nil, err
+	}
+	_, proof := vrfSigner.Evaluate(message)
+	if proof == nil {
+		return nil, secp256k1VRF.ErrEvaluateFailed
+	}
+	return proof, nil
+}
+
+//SignPreCommit returns the precommit of the block signed by the producer
+func (signer *LocalSigner) SignPreCommit(blockHash hash.Hash, height uint64) (*block.PreCommit, error) {
+	signature, err := signer.sign(block.CalculatePreCommitHash(blockHash, height))
+	if err != nil {
+		return nil, err
+	}
+	return &block.PreCommit{BlockHash: blockHash, Height: height, Signature: signature}, nil
+}
+
+func (signer *LocalSigner) sign(data hash.Hash) (hash.Hash, error) {
+	signature, err := crypto.NewSignature(keystore.SECP256K1)
+	if err != nil {
+		return nil, err
+	}
+	if err := signature.InitSign(signer.key); err != nil {
+		return nil, err
+	}
+	return signature.Sign(data)
+}
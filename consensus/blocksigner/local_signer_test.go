@@ -0,0 +1,106 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1/vrf/secp256k1VRF"
+	"github.com/dappley/go-dappley/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProducerAddr = "dPGZmHd73UpZhrM6uvgnzu49ttbLp4AzU8"
+	testProducerKey  = "5a66b0fdb69c99935783059bb200e86e97b506ae443a62febd7d0750cd7fac55"
+)
+
+//newTestHeader returns an unsigned header of the producer stamped at the input time. Headers at different heights
+//have different hashes
+func newTestHeader(producer string, height uint64, timestamp int64) *block.SignedHeader {
+	return &block.SignedHeader{
+		PrevHash:  util.UintToHex(height - 1),
+		TxsHash:   []byte("txs"),
+		Timestamp: timestamp,
+		Producer:  producer,
+		Height:    height,
+	}
+}
+
+func TestLocalSigner_Sign(t *testing.T) {
+	signer, err := NewLocalSignerFromHex(testProducerKey)
+	require.Nil(t, err)
+	assert.Equal(t, testProducerAddr, signer.GetAddress())
+
+	header := newTestHeader(testProducerAddr, 1, 5)
+	header.Signature, err = signer.SignHeader(header)
+	assert.Nil(t, err)
+	signerAddr, err := header.GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, testProducerAddr, signerAddr)
+
+	preCommit, err := signer.SignPreCommit([]byte("hash"), 1)
+	assert.Nil(t, err)
+	signerAddr, err = preCommit.GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, testProducerAddr, signerAddr)
+
+	message := []byte("message")
+	proof, err := signer.ProveVRF(message)
+	assert.Nil(t, err)
+	pubKey, err := signer.key.PublicKey().Encoded()
+	require.Nil(t, err)
+	verifier, err := secp256k1VRF.NewVRFVerifierFromRawKey(pubKey)
+	require.Nil(t, err)
+	_, err = verifier.ProofToHash(message, proof)
+	assert.Nil(t, err)
+
+	_, err = NewLocalSignerFromHex("")
+	assert.Equal(t, ErrInvalidKey, err)
+	_, err = NewLocalSignerFromHex("not a key")
+	assert.Equal(t, ErrInvalidKey, err)
+}
+
+func TestLocalSigner_Keystore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keystore")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "producer.json")
+
+	signer, err := NewLocalSignerFromHex(testProducerKey)
+	require.Nil(t, err)
+	assert.NotNil(t, signer.SaveKeystore(path, nil))
+	assert.Nil(t, signer.SaveKeystore(path, []byte("passphrase")))
+
+	//the key is not kept in plaintext
+	keyJSON, err := ioutil.ReadFile(path)
+	require.Nil(t, err)
+	assert.NotContains(t, string(keyJSON), testProducerKey)
+
+	_, err = LoadLocalSigner(path, []byte("wrong passphrase"))
+	assert.NotNil(t, err)
+	loaded, err := LoadLocalSigner(path, []byte("passphrase"))
+	require.Nil(t, err)
+	assert.Equal(t, testProducerAddr, loaded.GetAddress())
+}
@@ -0,0 +1,822 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/consensus/blocksigner/pb/block_signer.proto
+
+package blocksignerpb
+
+import (
+	context "context"
+	pb "github.com/dappley/go-dappley/core/block/pb"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GetAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAddressRequest) Reset() {
+	*x = GetAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressRequest) ProtoMessage() {}
+
+func (x *GetAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{0}
+}
+
+type GetAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *GetAddressResponse) Reset() {
+	*x = GetAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAddressResponse) ProtoMessage() {}
+
+func (x *GetAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAddressResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetAddressResponse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type SignHeaderRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Header *pb.SignedHeader `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
+}
+
+func (x *SignHeaderRequest) Reset() {
+	*x = SignHeaderRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignHeaderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignHeaderRequest) ProtoMessage() {}
+
+func (x *SignHeaderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignHeaderRequest.ProtoReflect.Descriptor instead.
+func (*SignHeaderRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignHeaderRequest) GetHeader() *pb.SignedHeader {
+	if x != nil {
+		return x.Header
+	}
+	return nil
+}
+
+type SignHeaderResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SignHeaderResponse) Reset() {
+	*x = SignHeaderResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignHeaderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignHeaderResponse) ProtoMessage() {}
+
+func (x *SignHeaderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignHeaderResponse.ProtoReflect.Descriptor instead.
+func (*SignHeaderResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *SignHeaderResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+type ProveVRFRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Message []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *ProveVRFRequest) Reset() {
+	*x = ProveVRFRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveVRFRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveVRFRequest) ProtoMessage() {}
+
+func (x *ProveVRFRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveVRFRequest.ProtoReflect.Descriptor instead.
+func (*ProveVRFRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProveVRFRequest) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+type ProveVRFResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (x *ProveVRFResponse) Reset() {
+	*x = ProveVRFResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProveVRFResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProveVRFResponse) ProtoMessage() {}
+
+func (x *ProveVRFResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProveVRFResponse.ProtoReflect.Descriptor instead.
+func (*ProveVRFResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProveVRFResponse) GetProof() []byte {
+	if x != nil {
+		return x.Proof
+	}
+	return nil
+}
+
+type SignPreCommitRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (x *SignPreCommitRequest) Reset() {
+	*x = SignPreCommitRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignPreCommitRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPreCommitRequest) ProtoMessage() {}
+
+func (x *SignPreCommitRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPreCommitRequest.ProtoReflect.Descriptor instead.
+func (*SignPreCommitRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SignPreCommitRequest) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *SignPreCommitRequest) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+type SignPreCommitResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (x *SignPreCommitResponse) Reset() {
+	*x = SignPreCommitResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignPreCommitResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignPreCommitResponse) ProtoMessage() {}
+
+func (x *SignPreCommitResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignPreCommitResponse.ProtoReflect.Descriptor instead.
+func (*SignPreCommitResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SignPreCommitResponse) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDesc = []byte{
+	0x0a, 0x49, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67,
+	0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x42, 0x0a, 0x11, 0x53, 0x69, 0x67, 0x6e,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a,
+	0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x32, 0x0a, 0x12,
+	0x53, 0x69, 0x67, 0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x22, 0x2b, 0x0a, 0x0f, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x28, 0x0a,
+	0x10, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x52, 0x46, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x22, 0x4d, 0x0a, 0x14, 0x53, 0x69, 0x67, 0x6e, 0x50,
+	0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x35, 0x0a, 0x15, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x72,
+	0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x32, 0xeb, 0x02,
+	0x0a, 0x12, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e,
+	0x65, 0x72, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0a, 0x53, 0x69, 0x67,
+	0x6e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4d,
+	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x65, 0x56, 0x52, 0x46, 0x12, 0x1e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x56, 0x52, 0x46, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x76, 0x65,
+	0x56, 0x52, 0x46, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5c, 0x0a,
+	0x0d, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x23,
+	0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x70, 0x62, 0x2e, 0x53,
+	0x69, 0x67, 0x6e, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x72, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x50, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x62, 0x06, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescData = file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_goTypes = []interface{}{
+	(*GetAddressRequest)(nil),     // 0: blocksignerpb.GetAddressRequest
+	(*GetAddressResponse)(nil),    // 1: blocksignerpb.GetAddressResponse
+	(*SignHeaderRequest)(nil),     // 2: blocksignerpb.SignHeaderRequest
+	(*SignHeaderResponse)(nil),    // 3: blocksignerpb.SignHeaderResponse
+	(*ProveVRFRequest)(nil),       // 4: blocksignerpb.ProveVRFRequest
+	(*ProveVRFResponse)(nil),      // 5: blocksignerpb.ProveVRFResponse
+	(*SignPreCommitRequest)(nil),  // 6: blocksignerpb.SignPreCommitRequest
+	(*SignPreCommitResponse)(nil), // 7: blocksignerpb.SignPreCommitResponse
+	(*pb.SignedHeader)(nil),       // 8: blockpb.SignedHeader
+}
+var file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_depIdxs = []int32{
+	8, // 0: blocksignerpb.SignHeaderRequest.header:type_name -> blockpb.SignedHeader
+	0, // 1: blocksignerpb.BlockSignerService.GetAddress:input_type -> blocksignerpb.GetAddressRequest
+	2, // 2: blocksignerpb.BlockSignerService.SignHeader:input_type -> blocksignerpb.SignHeaderRequest
+	4, // 3: blocksignerpb.BlockSignerService.ProveVRF:input_type -> blocksignerpb.ProveVRFRequest
+	6, // 4: blocksignerpb.BlockSignerService.SignPreCommit:input_type -> blocksignerpb.SignPreCommitRequest
+	1, // 5: blocksignerpb.BlockSignerService.GetAddress:output_type -> blocksignerpb.GetAddressResponse
+	3, // 6: blocksignerpb.BlockSignerService.SignHeader:output_type -> blocksignerpb.SignHeaderResponse
+	5, // 7: blocksignerpb.BlockSignerService.ProveVRF:output_type -> blocksignerpb.ProveVRFResponse
+	7, // 8: blocksignerpb.BlockSignerService.SignPreCommit:output_type -> blocksignerpb.SignPreCommitResponse
+	5, // [5:9] is the sub-list for method output_type
+	1, // [1:5] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_init() }
+func file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_init() {
+	if File_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignHeaderRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignHeaderResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveVRFRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProveVRFResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignPreCommitRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignPreCommitResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto = out.File
+	file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_consensus_blocksigner_pb_block_signer_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// BlockSignerServiceClient is the client API for BlockSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type BlockSignerServiceClient interface {
+	GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error)
+	SignHeader(ctx context.Context, in *SignHeaderRequest, opts ...grpc.CallOption) (*SignHeaderResponse, error)
+	ProveVRF(ctx context.Context, in *ProveVRFRequest, opts ...grpc.CallOption) (*ProveVRFResponse, error)
+	SignPreCommit(ctx context.Context, in *SignPreCommitRequest, opts ...grpc.CallOption) (*SignPreCommitResponse, error)
+}
+
+type blockSignerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBlockSignerServiceClient(cc grpc.ClientConnInterface) BlockSignerServiceClient {
+	return &blockSignerServiceClient{cc}
+}
+
+func (c *blockSignerServiceClient) GetAddress(ctx context.Context, in *GetAddressRequest, opts ...grpc.CallOption) (*GetAddressResponse, error) {
+	out := new(GetAddressResponse)
+	err := c.cc.Invoke(ctx, "/blocksignerpb.BlockSignerService/GetAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockSignerServiceClient) SignHeader(ctx context.Context, in *SignHeaderRequest, opts ...grpc.CallOption) (*SignHeaderResponse, error) {
+	out := new(SignHeaderResponse)
+	err := c.cc.Invoke(ctx, "/blocksignerpb.BlockSignerService/SignHeader", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockSignerServiceClient) ProveVRF(ctx context.Context, in *ProveVRFRequest, opts ...grpc.CallOption) (*ProveVRFResponse, error) {
+	out := new(ProveVRFResponse)
+	err := c.cc.Invoke(ctx, "/blocksignerpb.BlockSignerService/ProveVRF", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blockSignerServiceClient) SignPreCommit(ctx context.Context, in *SignPreCommitRequest, opts ...grpc.CallOption) (*SignPreCommitResponse, error) {
+	out := new(SignPreCommitResponse)
+	err := c.cc.Invoke(ctx, "/blocksignerpb.BlockSignerService/SignPreCommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BlockSignerServiceServer is the server API for BlockSignerService service.
+type BlockSignerServiceServer interface {
+	GetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error)
+	SignHeader(context.Context, *SignHeaderRequest) (*SignHeaderResponse, error)
+	ProveVRF(context.Context, *ProveVRFRequest) (*ProveVRFResponse, error)
+	SignPreCommit(context.Context, *SignPreCommitRequest) (*SignPreCommitResponse, error)
+}
+
+// UnimplementedBlockSignerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedBlockSignerServiceServer struct {
+}
+
+func (*UnimplementedBlockSignerServiceServer) GetAddress(context.Context, *GetAddressRequest) (*GetAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAddress not implemented")
+}
+func (*UnimplementedBlockSignerServiceServer) SignHeader(context.Context, *SignHeaderRequest) (*SignHeaderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignHeader not implemented")
+}
+func (*UnimplementedBlockSignerServiceServer) ProveVRF(context.Context, *ProveVRFRequest) (*ProveVRFResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProveVRF not implemented")
+}
+func (*UnimplementedBlockSignerServiceServer) SignPreCommit(context.Context, *SignPreCommitRequest) (*SignPreCommitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SignPreCommit not implemented")
+}
+
+func RegisterBlockSignerServiceServer(s *grpc.Server, srv BlockSignerServiceServer) {
+	s.RegisterService(&_BlockSignerService_serviceDesc, srv)
+}
+
+func _BlockSignerService_GetAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServiceServer).GetAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocksignerpb.BlockSignerService/GetAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServiceServer).GetAddress(ctx, req.(*GetAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockSignerService_SignHeader_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignHeaderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServiceServer).SignHeader(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocksignerpb.BlockSignerService/SignHeader",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServiceServer).SignHeader(ctx, req.(*SignHeaderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockSignerService_ProveVRF_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProveVRFRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServiceServer).ProveVRF(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocksignerpb.BlockSignerService/ProveVRF",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServiceServer).ProveVRF(ctx, req.(*ProveVRFRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlockSignerService_SignPreCommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignPreCommitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlockSignerServiceServer).SignPreCommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/blocksignerpb.BlockSignerService/SignPreCommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlockSignerServiceServer).SignPreCommit(ctx, req.(*SignPreCommitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BlockSignerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "blocksignerpb.BlockSignerService",
+	HandlerType: (*BlockSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAddress",
+			Handler:    _BlockSignerService_GetAddress_Handler,
+		},
+		{
+			MethodName: "SignHeader",
+			Handler:    _BlockSignerService_SignHeader_Handler,
+		},
+		{
+			MethodName: "ProveVRF",
+			Handler:    _BlockSignerService_ProveVRF_Handler,
+		},
+		{
+			MethodName: "SignPreCommit",
+			Handler:    _BlockSignerService_SignPreCommit_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "github.com/dappley/go-dappley/consensus/blocksigner/pb/block_signer.proto",
+}
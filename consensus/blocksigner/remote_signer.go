@@ -0,0 +1,121 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/dappley/go-dappley/common/hash"
+	blocksignerpb "github.com/dappley/go-dappley/consensus/blocksigner/pb"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+const remoteSignerTimeout = 3 * time.Second
+
+// RemoteSigner signs through a signer server on another host or process. The server keeps the producer key and its
+// own record of the signed slots, so a compromised or misconfigured node cannot make the producer double sign.
+type RemoteSigner struct {
+	conn    *grpc.ClientConn
+	client  blocksignerpb.BlockSignerServiceClient
+	address string
+}
+
+//NewRemoteSigner connects to the signer server at the address, which is either a TCP address or a Unix socket in the
+//form of unix:///path, and fetches the address of its producer. The TLS config is required on a TCP address and
+//ignored on a Unix socket
+func NewRemoteSigner(address string, tlsConfig *tls.Config) (*RemoteSigner, error) {
+	network, addr := parseSignerAddress(address)
+	transport := grpc.WithInsecure()
+	if network == "tcp" {
+		if tlsConfig == nil {
+			return nil, ErrTLSRequired
+		}
+		transport = grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))
+	}
+	conn, err := grpc.Dial(addr, transport, grpc.WithContextDialer(
+		func(ctx context.Context, target string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, target)
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	signer := &RemoteSigner{conn: conn, client: blocksignerpb.NewBlockSignerServiceClient(conn)}
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	resp, err := signer.client.GetAddress(ctx, &blocksignerpb.GetAddressRequest{})
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	signer.address = resp.GetAddress()
+	return signer, nil
+}
+
+//Close closes the connection to the signer server
+func (signer *RemoteSigner) Close() error {
+	return signer.conn.Close()
+}
+
+//GetAddress returns the address of the producer
+func (signer *RemoteSigner) GetAddress() string {
+	return signer.address
+}
+
+//SignHeader returns the signature of the block hash of the header
+func (signer *RemoteSigner) SignHeader(header *block.SignedHeader) (hash.Hash, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	resp, err := signer.client.SignHeader(ctx, &blocksignerpb.SignHeaderRequest{
+		Header: header.ToProto().(*blockpb.SignedHeader),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSignature(), nil
+}
+
+//ProveVRF evaluates the VRF of the producer key on the message and returns the proof
+func (signer *RemoteSigner) ProveVRF(message []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	resp, err := signer.client.ProveVRF(ctx, &blocksignerpb.ProveVRFRequest{Message: message})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetProof(), nil
+}
+
+//SignPreCommit returns the precommit of the block signed by the producer
+func (signer *RemoteSigner) SignPreCommit(blockHash hash.Hash, height uint64) (*block.PreCommit, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteSignerTimeout)
+	defer cancel()
+	resp, err := signer.client.SignPreCommit(ctx, &blocksignerpb.SignPreCommitRequest{BlockHash: blockHash, Height: height})
+	if err != nil {
+		return nil, err
+	}
+	return &block.PreCommit{BlockHash: blockHash, Height: height, Signature: resp.GetSignature()}, nil
+}
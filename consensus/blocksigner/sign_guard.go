@@ -0,0 +1,91 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+)
+
+var (
+	lastSignedHeaderKey    = []byte("lastSignedHeader")
+	lastSignedPreCommitKey = []byte("lastSignedPreCommit")
+)
+
+// SignGuard keeps the last block header and the last precommit signed by a producer in a database, so that the
+// producer never signs two different blocks in the same time slot or precommits two different blocks at the same
+// height, even across restarts. The slot of a header is its timestamp divided by the block interval, so that blocks
+// stamped at different times of the same slot are refused as well.
+type SignGuard struct {
+	db             storage.Storage
+	timeBetweenBlk uint64
+	mutex          sync.Mutex
+}
+
+//NewSignGuard returns a sign guard that keeps the signed slots in the input database. The time between blocks is in
+//seconds and has to be the block interval of the network
+func NewSignGuard(db storage.Storage, timeBetweenBlk uint64) *SignGuard {
+	if timeBetweenBlk == 0 {
+		timeBetweenBlk = 1
+	}
+	return &SignGuard{db: db, timeBetweenBlk: timeBetweenBlk}
+}
+
+//CheckHeader records the header as signed. It returns an error if a different block is signed in the same or a later
+//time slot already
+func (guard *SignGuard) CheckHeader(header *block.SignedHeader) error {
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+	slot := uint64(header.Timestamp) / guard.timeBetweenBlk
+	return guard.check(lastSignedHeaderKey, util.UintToHex(slot), header.Hash(), ErrDoubleSignHeader, ErrSlotRegression)
+}
+
+//CheckPreCommit records the precommit as signed. It returns an error if a different block is precommitted at the same
+//or a greater height already
+func (guard *SignGuard) CheckPreCommit(blockHash hash.Hash, height uint64) error {
+	guard.mutex.Lock()
+	defer guard.mutex.Unlock()
+	return guard.check(lastSignedPreCommitKey, util.UintToHex(height), blockHash, ErrDoubleSignPreCommit, ErrHeightRegression)
+}
+
+//check compares the big endian position and the hash with the last record under the key, and replaces the record if
+//the position moves forward. Signing the same hash again at the last position is allowed
+func (guard *SignGuard) check(key []byte, position []byte, blkHash hash.Hash, errDoubleSign error, errRegression error) error {
+	last, err := guard.db.Get(key)
+	if err != nil && err != storage.ErrKeyInvalid {
+		return err
+	}
+	if len(last) >= len(position) {
+		switch bytes.Compare(position, last[:len(position)]) {
+		case -1:
+			return errRegression
+		case 0:
+			if !bytes.Equal(blkHash, last[len(position):]) {
+				return errDoubleSign
+			}
+			return nil
+		}
+	}
+	return guard.db.Put(key, append(append([]byte{}, position...), blkHash...))
+}
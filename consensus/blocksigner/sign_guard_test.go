@@ -0,0 +1,59 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignGuard_CheckHeader(t *testing.T) {
+	db := storage.NewRamStorage()
+	guard := NewSignGuard(db, 5)
+
+	header := newTestHeader(testProducerAddr, 2, 10)
+	assert.Nil(t, guard.CheckHeader(header))
+	//signing the same block again is allowed
+	assert.Nil(t, guard.CheckHeader(header))
+
+	other := newTestHeader(testProducerAddr, 3, 10)
+	assert.Equal(t, ErrDoubleSignHeader, guard.CheckHeader(other))
+	assert.Equal(t, ErrSlotRegression, guard.CheckHeader(newTestHeader(testProducerAddr, 1, 5)))
+	assert.Nil(t, guard.CheckHeader(newTestHeader(testProducerAddr, 3, 15)))
+	//a block stamped later in the same slot is refused as well
+	assert.Equal(t, ErrDoubleSignHeader, guard.CheckHeader(newTestHeader(testProducerAddr, 4, 17)))
+
+	//the signed slots are kept across restarts
+	assert.Equal(t, ErrDoubleSignHeader, NewSignGuard(db, 5).CheckHeader(newTestHeader(testProducerAddr, 4, 15)))
+}
+
+func TestSignGuard_CheckPreCommit(t *testing.T) {
+	db := storage.NewRamStorage()
+	guard := NewSignGuard(db, 5)
+
+	assert.Nil(t, guard.CheckPreCommit(hash.Hash("blk2"), 2))
+	assert.Nil(t, guard.CheckPreCommit(hash.Hash("blk2"), 2))
+	assert.Equal(t, ErrDoubleSignPreCommit, guard.CheckPreCommit(hash.Hash("fork2"), 2))
+	assert.Equal(t, ErrHeightRegression, guard.CheckPreCommit(hash.Hash("blk1"), 1))
+	assert.Nil(t, guard.CheckPreCommit(hash.Hash("blk3"), 3))
+	assert.Equal(t, ErrDoubleSignPreCommit, NewSignGuard(db, 5).CheckPreCommit(hash.Hash("fork3"), 3))
+}
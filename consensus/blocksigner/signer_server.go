@@ -0,0 +1,153 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/dappley/go-dappley/common/log"
+	blocksignerpb "github.com/dappley/go-dappley/consensus/blocksigner/pb"
+	"github.com/dappley/go-dappley/core/block"
+	logger "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+)
+
+const unixSocketPrefix = "unix://"
+
+// SignerServer serves the signer of a producer to its nodes over gRPC, so that the producer key stays on the signer
+// host. Every header and precommit passes the sign guard of the server before it is signed. A server on a TCP address
+// only accepts nodes that present a client certificate of its CA, while a Unix socket is protected by its file
+// permissions.
+type SignerServer struct {
+	signer BlockSigner
+	guard  *SignGuard
+	srv    *grpc.Server
+}
+
+//NewSignerServer returns a server of the signer that refuses to sign what the guard rejects
+func NewSignerServer(signer BlockSigner, guard *SignGuard) *SignerServer {
+	return &SignerServer{signer: signer, guard: guard}
+}
+
+//Start serves the signer at the address, which is either a TCP address or a Unix socket in the form of unix:///path.
+//The TLS config is required on a TCP address and ignored on a Unix socket
+func (server *SignerServer) Start(address string, tlsConfig *tls.Config) error {
+	network, addr := parseSignerAddress(address)
+	var opts []grpc.ServerOption
+	if network == "tcp" {
+		if tlsConfig == nil {
+			return ErrTLSRequired
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	if network == "unix" {
+		//a socket file left by a previous run blocks the listener
+		os.Remove(addr)
+	}
+	lis, err := net.Listen(network, addr)
+	if err != nil {
+		return err
+	}
+
+	server.srv = grpc.NewServer(opts...)
+	blocksignerpb.RegisterBlockSignerServiceServer(server.srv, server)
+	go func() {
+		defer log.CrashHandler()
+		if err := server.srv.Serve(lis); err != nil {
+			logger.WithError(err).Error("SignerServer: encounters an error while serving.")
+		}
+	}()
+	logger.WithFields(logger.Fields{
+		"address":  address,
+		"producer": server.signer.GetAddress(),
+	}).Info("SignerServer: started.")
+	return nil
+}
+
+//Stop stops serving the signer
+func (server *SignerServer) Stop() {
+	if server.srv != nil {
+		server.srv.Stop()
+	}
+}
+
+func (server *SignerServer) GetAddress(ctx context.Context, in *blocksignerpb.GetAddressRequest) (*blocksignerpb.GetAddressResponse, error) {
+	return &blocksignerpb.GetAddressResponse{Address: server.signer.GetAddress()}, nil
+}
+
+func (server *SignerServer) SignHeader(ctx context.Context, in *blocksignerpb.SignHeaderRequest) (*blocksignerpb.SignHeaderResponse, error) {
+	if in.GetHeader() == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing block header")
+	}
+	header := &block.SignedHeader{}
+	header.FromProto(in.GetHeader())
+	if header.Producer != server.signer.GetAddress() {
+		return nil, status.Error(codes.InvalidArgument, "block is not produced by the producer of the signer")
+	}
+
+	if err := server.guard.CheckHeader(header); err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height":    header.Height,
+			"timestamp": header.Timestamp,
+		}).Warn("SignerServer: refused to sign the block header.")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	signature, err := server.signer.SignHeader(header)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &blocksignerpb.SignHeaderResponse{Signature: signature}, nil
+}
+
+func (server *SignerServer) ProveVRF(ctx context.Context, in *blocksignerpb.ProveVRFRequest) (*blocksignerpb.ProveVRFResponse, error) {
+	proof, err := server.signer.ProveVRF(in.GetMessage())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &blocksignerpb.ProveVRFResponse{Proof: proof}, nil
+}
+
+func (server *SignerServer) SignPreCommit(ctx context.Context, in *blocksignerpb.SignPreCommitRequest) (*blocksignerpb.SignPreCommitResponse, error) {
+	if err := server.guard.CheckPreCommit(in.GetBlockHash(), in.GetHeight()); err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height": in.GetHeight(),
+		}).Warn("SignerServer: refused to sign the precommit.")
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	preCommit, err := server.signer.SignPreCommit(in.GetBlockHash(), in.GetHeight())
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &blocksignerpb.SignPreCommitResponse{Signature: preCommit.Signature}, nil
+}
+
+//parseSignerAddress returns the network and the address to listen or dial
+func parseSignerAddress(address string) (string, string) {
+	if strings.HasPrefix(address, unixSocketPrefix) {
+		return "unix", strings.TrimPrefix(address, unixSocketPrefix)
+	}
+	return "tcp", address
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package blocksigner
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1/vrf/secp256k1VRF"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRemoteSigner(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	address := unixSocketPrefix + filepath.Join(dir, "signer.sock")
+
+	local, err := NewLocalSignerFromHex(testProducerKey)
+	require.Nil(t, err)
+	server := NewSignerServer(local, NewSignGuard(storage.NewRamStorage(), 5))
+	require.Nil(t, server.Start(address, nil))
+	defer server.Stop()
+
+	signer, err := NewRemoteSigner(address, nil)
+	require.Nil(t, err)
+	defer signer.Close()
+	assert.Equal(t, testProducerAddr, signer.GetAddress())
+
+	header := newTestHeader(testProducerAddr, 1, 5)
+	header.Signature, err = signer.SignHeader(header)
+	assert.Nil(t, err)
+	signerAddr, err := header.GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, testProducerAddr, signerAddr)
+
+	//the server refuses to double sign
+	_, err = signer.SignHeader(newTestHeader(testProducerAddr, 2, 5))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	_, err = signer.SignHeader(newTestHeader("dQEooMsqp23RkPsvZXj3XbsRh9BUyGz2S9", 2, 10))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	preCommit, err := signer.SignPreCommit(hash.Hash("blk1"), 1)
+	assert.Nil(t, err)
+	signerAddr, err = preCommit.GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, testProducerAddr, signerAddr)
+	_, err = signer.SignPreCommit(hash.Hash("fork1"), 1)
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	remoteProof, err := signer.ProveVRF([]byte("message"))
+	assert.Nil(t, err)
+	localProof, err := local.ProveVRF([]byte("message"))
+	assert.Nil(t, err)
+	//the proofs differ, but they prove the same output
+	remoteOutput, err := secp256k1VRF.ProofToIndex(remoteProof)
+	assert.Nil(t, err)
+	localOutput, err := secp256k1VRF.ProofToIndex(localProof)
+	assert.Nil(t, err)
+	assert.Equal(t, localOutput, remoteOutput)
+}
+
+func TestRemoteSigner_TLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	caCert, caKey := writeTestCert(t, dir, "ca", nil, nil)
+	writeTestCert(t, dir, "server", caCert, caKey)
+	writeTestCert(t, dir, "node", caCert, caKey)
+	otherCACert, otherCAKey := writeTestCert(t, dir, "other_ca", nil, nil)
+	writeTestCert(t, dir, "other_node", otherCACert, otherCAKey)
+	serverTLS, err := LoadTLSConfig(filepath.Join(dir, "server.crt"), filepath.Join(dir, "server.key"), filepath.Join(dir, "ca.crt"))
+	require.Nil(t, err)
+	nodeTLS, err := LoadTLSConfig(filepath.Join(dir, "node.crt"), filepath.Join(dir, "node.key"), filepath.Join(dir, "ca.crt"))
+	require.Nil(t, err)
+	otherNodeTLS, err := LoadTLSConfig(filepath.Join(dir, "other_node.crt"), filepath.Join(dir, "other_node.key"), filepath.Join(dir, "ca.crt"))
+	require.Nil(t, err)
+
+	local, err := NewLocalSignerFromHex(testProducerKey)
+	require.Nil(t, err)
+	server := NewSignerServer(local, NewSignGuard(storage.NewRamStorage(), 5))
+	//a signer server on TCP never runs without client authentication
+	assert.Equal(t, ErrTLSRequired, server.Start("127.0.0.1:0", nil))
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	address := lis.Addr().String()
+	lis.Close()
+	require.Nil(t, server.Start(address, serverTLS))
+	defer server.Stop()
+
+	_, err = NewRemoteSigner(address, nil)
+	assert.Equal(t, ErrTLSRequired, err)
+	//a node with a certificate of another CA is refused
+	_, err = NewRemoteSigner(address, otherNodeTLS)
+	assert.NotNil(t, err)
+
+	signer, err := NewRemoteSigner(address, nodeTLS)
+	require.Nil(t, err)
+	defer signer.Close()
+	assert.Equal(t, testProducerAddr, signer.GetAddress())
+}
+
+//writeTestCert writes a certificate for 127.0.0.1 and its key into the directory. The certificate is a CA if no
+//issuer is given
+func writeTestCert(t *testing.T, dir string, name string, issuer *x509.Certificate, issuerKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		issuer, issuerKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, issuer, &key.PublicKey, issuerKey)
+	require.Nil(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.Nil(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	return cert, key
+}
@@ -20,6 +20,7 @@ package consensus
 
 import (
 	"bytes"
+	"errors"
 	"github.com/dappley/go-dappley/common/deadline"
 	"strings"
 	"time"
@@ -27,27 +28,48 @@ import (
 	"github.com/hashicorp/golang-lru"
 	logger "github.com/sirupsen/logrus"
 
+	"github.com/dappley/go-dappley/consensus/blocksigner"
 	"github.com/dappley/go-dappley/core/blockproducerinfo"
 
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/storage"
 
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 )
 
+var (
+	ErrDynastyScheduleNotSet  = errors.New("dynasty schedule is not set")
+	ErrActivationHeightPassed = errors.New("activation height must be above the current tail block")
+	ErrEvidenceDifferentSlots = errors.New("evidence: blocks are not produced in the same time slot")
+	ErrFinalityNotEnabled     = errors.New("finality gadget is not enabled")
+	ErrElectionActive         = errors.New("dynasty changes are not allowed while producers are elected by votes")
+)
+
 const (
-	MinConsensusSize   = 4
-	maxMintingTimeInMs = 1500
+	MinConsensusSize          = 4
+	defaultMaxMintingTimeInMs = 1500
 )
 
 type DPOS struct {
 	producer        *blockproducerinfo.BlockProducerInfo
-	producerKey     string
+	signer          blocksigner.BlockSigner
 	stopCh          chan bool
 	dynasty         *Dynasty
+	election        *Election
+	schedule        *DynastySchedule
+	chain           ChainReader
+	shuffler        *SlotShuffler
+	forks           *block.Forks
+	liveness        *LivenessTracker
+	finality        *FinalityGadget
+	evidenceHandler func(evidence *block.Evidence)
 	slot            *lru.Cache
 	lastProduceTime int64
+	maxMintingTime  int64
+	libRatio        float64
 }
 
 //NewDPOS returns a new DPOS instance
@@ -55,6 +77,7 @@ func NewDPOS(producer *blockproducerinfo.BlockProducerInfo) *DPOS {
 	dpos := &DPOS{
 		producer: producer,
 		stopCh:   make(chan bool, 1),
+		shuffler: NewSlotShuffler(nil),
 	}
 
 	slot, err := lru.New(128)
@@ -65,9 +88,25 @@ func NewDPOS(producer *blockproducerinfo.BlockProducerInfo) *DPOS {
 	return dpos
 }
 
-//SetKey sets the producer key
+//SetKey signs the blocks with the producer key in hex held by the process
 func (dpos *DPOS) SetKey(key string) {
-	dpos.producerKey = key
+	if key == "" {
+		return
+	}
+	signer, err := blocksigner.NewLocalSignerFromHex(key)
+	if err != nil {
+		logger.WithError(err).Error("DPoS: the producer key is invalid.")
+		return
+	}
+	dpos.SetSigner(signer)
+}
+
+//SetSigner sets the signer of the blocks and precommits of the local producer
+func (dpos *DPOS) SetSigner(signer blocksigner.BlockSigner) {
+	dpos.signer = signer
+	if dpos.finality != nil && dpos.producer != nil {
+		dpos.finality.SetProducer(dpos.producer.Beneficiary(), signer)
+	}
 }
 
 //SetDynasty sets the dynasty
@@ -80,10 +119,203 @@ func (dpos *DPOS) GetDynasty() *Dynasty {
 	return dpos.dynasty
 }
 
-//AddProducer adds a producer to the dynasty
-func (dpos *DPOS) AddProducer(producer string) error {
-	err := dpos.dynasty.AddProducer(producer)
-	return err
+//SetMaxMintingTime sets the time budget in milliseconds to fill a block. The default budget is used if it is 0
+func (dpos *DPOS) SetMaxMintingTime(maxMintingTimeInMs int64) {
+	dpos.maxMintingTime = maxMintingTimeInMs
+}
+
+//GetMaxMintingTime returns the time budget in milliseconds to fill a block. The default budget is 30% of the block
+//time and at most 1500ms
+func (dpos *DPOS) GetMaxMintingTime() int64 {
+	if dpos.maxMintingTime > 0 {
+		return dpos.maxMintingTime
+	}
+	budget := int64(dpos.dynasty.timeBetweenBlk) * 1000 * 3 / 10
+	if budget > defaultMaxMintingTimeInMs {
+		return defaultMaxMintingTimeInMs
+	}
+	return budget
+}
+
+//SetLibConfirmationRatio sets the share of the producers that have to confirm a block before it becomes the LIB. It is
+//also the quorum ratio of the finality certificates. The default of 2/3 is used if it is 0
+func (dpos *DPOS) SetLibConfirmationRatio(ratio float64) {
+	dpos.libRatio = ratio
+	if dpos.finality != nil {
+		dpos.finality.SetQuorumRatio(ratio)
+	}
+}
+
+//SetElection sets the election that derives the dynasty of each epoch from the votes on chain
+func (dpos *DPOS) SetElection(election *Election) {
+	dpos.election = election
+}
+
+//GetElection returns the election
+func (dpos *DPOS) GetElection() *Election {
+	return dpos.election
+}
+
+//SetDynastySchedule sets the persisted schedule of dynasty changes
+func (dpos *DPOS) SetDynastySchedule(schedule *DynastySchedule) {
+	dpos.schedule = schedule
+}
+
+//GetDynastySchedule returns the schedule of dynasty changes
+func (dpos *DPOS) GetDynastySchedule() *DynastySchedule {
+	return dpos.schedule
+}
+
+//SetForks sets the heights from which the rules added after the launch of the network are enforced
+func (dpos *DPOS) SetForks(forks *block.Forks) {
+	dpos.forks = forks
+	dpos.shuffler.SetForks(forks)
+}
+
+//SetChain sets the main chain that decides which dynasty is currently in charge
+func (dpos *DPOS) SetChain(chain ChainReader) {
+	dpos.chain = chain
+	dpos.shuffler = NewSlotShuffler(chain)
+	dpos.shuffler.SetForks(dpos.forks)
+	dpos.liveness = NewLivenessTracker(chain, dpos.getDynastyAtHeight)
+}
+
+//GetLivenessTracker returns the tracker of the slots filled and missed by the producers on the main chain
+func (dpos *DPOS) GetLivenessTracker() *LivenessTracker {
+	return dpos.liveness
+}
+
+//EnableFinality starts finalizing the blocks of the input chain with the precommits of the producers. The database keeps
+//the height of the last precommit of the local producer
+func (dpos *DPOS) EnableFinality(chain FinalizedChain, db storage.Storage) *FinalityGadget {
+	dpos.finality = NewFinalityGadget(chain, dpos.getDynastyAtHeight, db)
+	dpos.finality.SetQuorumRatio(dpos.libRatio)
+	if dpos.producer != nil {
+		dpos.finality.SetProducer(dpos.producer.Beneficiary(), dpos.signer)
+	}
+	return dpos.finality
+}
+
+//GetFinalityGadget returns the finality gadget
+func (dpos *DPOS) GetFinalityGadget() *FinalityGadget {
+	return dpos.finality
+}
+
+//AddPreCommit collects a precommit signed by a producer
+func (dpos *DPOS) AddPreCommit(preCommit *block.PreCommit) (bool, error) {
+	if dpos.finality == nil {
+		return false, ErrFinalityNotEnabled
+	}
+	return dpos.finality.AddPreCommit(preCommit)
+}
+
+//SetEvidenceHandler sets the function that is called with the evidence of every double-minting producer detected
+func (dpos *DPOS) SetEvidenceHandler(handler func(evidence *block.Evidence)) {
+	dpos.evidenceHandler = handler
+}
+
+//getDynastyAtHeight returns the dynasty that is in charge of producing the block at the input height. Its slot order
+//in each round is shuffled by the VRF outputs committed on chain
+func (dpos *DPOS) getDynastyAtHeight(height uint64) *Dynasty {
+	var producers []string
+	switch {
+	case dpos.election != nil:
+		producers = dpos.election.GetProducersAtHeight(height)
+	case dpos.schedule != nil:
+		producers = dpos.schedule.GetProducersAtHeight(height)
+	case dpos.chain != nil:
+		producers = dpos.dynasty.GetProducers()
+	default:
+		return dpos.dynasty
+	}
+	return dpos.shuffler.ShuffleDynasty(NewDynasty(producers, dpos.dynasty.maxProducers, dpos.dynasty.timeBetweenBlk), height)
+}
+
+//updateDynasty switches the current dynasty to the producers in charge of the next block on chain
+func (dpos *DPOS) updateDynasty() {
+	if dpos.chain == nil {
+		return
+	}
+	producers := dpos.getDynastyAtHeight(dpos.chain.GetMaxHeight() + 1).GetProducers()
+	if isSameProducers(producers, dpos.dynasty.GetProducers()) {
+		return
+	}
+	dpos.dynasty.SetProducers(producers)
+	logger.WithFields(logger.Fields{
+		"producers": producers,
+	}).Info("DPoS: switched to the new dynasty.")
+}
+
+//AddProducer schedules the producer to join the dynasty from the activation height on.
+//The change takes effect at the next epoch if the activation height is 0. The schedule is only saved in the local
+//database and is not shared with other nodes, so it is meant for a network run by a single operator who applies the
+//same change on every node. It is refused once producers are elected by votes
+func (dpos *DPOS) AddProducer(producer string, activationHeight uint64) error {
+	if err := dpos.checkScheduleChange(); err != nil {
+		return err
+	}
+	activationHeight, err := dpos.getActivationHeight(activationHeight)
+	if err != nil {
+		return err
+	}
+	dynasty := NewDynasty(dpos.schedule.GetProducersAtHeight(activationHeight), dpos.dynasty.maxProducers, dpos.dynasty.timeBetweenBlk)
+	if err := dynasty.AddProducer(producer); err != nil {
+		return err
+	}
+	return dpos.schedule.AddChange(&DynastyChange{activationHeight, dynasty.GetProducers()})
+}
+
+//ScheduleProducers schedules the producers to replace the dynasty from the activation height on.
+//The change takes effect at the next epoch if the activation height is 0. The producers are expected to be checked
+//by IsSettingProducersAllowed beforehand. Like AddProducer, the change only applies to the local node
+func (dpos *DPOS) ScheduleProducers(producers []string, activationHeight uint64) error {
+	if err := dpos.checkScheduleChange(); err != nil {
+		return err
+	}
+	activationHeight, err := dpos.getActivationHeight(activationHeight)
+	if err != nil {
+		return err
+	}
+	return dpos.schedule.AddChange(&DynastyChange{activationHeight, producers})
+}
+
+//checkScheduleChange returns an error if the schedule is not set, or if votes are tallied on chain. The election
+//overrides the schedule as soon as anyone votes, so a local change would not take effect
+func (dpos *DPOS) checkScheduleChange() error {
+	if dpos.schedule == nil {
+		return ErrDynastyScheduleNotSet
+	}
+	if dpos.election == nil || dpos.chain == nil {
+		return nil
+	}
+	hasVotes, err := dpos.election.HasVotes(dpos.chain.GetMaxHeight() + 1)
+	if err != nil {
+		return err
+	}
+	if hasVotes {
+		return ErrElectionActive
+	}
+	return nil
+}
+
+//getActivationHeight returns the first block height of the next epoch if the input height is 0. Otherwise it checks
+//that the input height is not produced yet, so that the blocks on chain are not affected by the change
+func (dpos *DPOS) getActivationHeight(height uint64) (uint64, error) {
+	if dpos.chain == nil {
+		return height, nil
+	}
+	tailHeight := dpos.chain.GetMaxHeight()
+	if height == 0 {
+		epochLength := uint64(DefaultEpochLength)
+		if dpos.election != nil {
+			epochLength = dpos.election.GetEpochLength()
+		}
+		return ((tailHeight+1)/epochLength + 1) * epochLength, nil
+	}
+	if height <= tailHeight {
+		return 0, ErrActivationHeightPassed
+	}
+	return height, nil
 }
 
 //GetProducers returns all current producers
@@ -108,8 +340,13 @@ func (dpos *DPOS) ProduceBlock(ProduceBlockFunc func(process func(*block.Block),
 	for {
 		select {
 		case now := <-ticker:
-			if dpos.dynasty.IsMyTurn(dpos.producer.Beneficiary(), now.Unix()) {
-				dl := deadline.NewDeadline(now.UnixNano()/deadline.NanoSecsInMilliSec + maxMintingTimeInMs)
+			dpos.updateDynasty()
+			dynasty := dpos.dynasty
+			if dpos.chain != nil {
+				dynasty = dpos.getDynastyAtHeight(dpos.chain.GetMaxHeight() + 1)
+			}
+			if dynasty.IsMyTurn(dpos.producer.Beneficiary(), now.Unix()) {
+				dl := deadline.NewDeadline(now.UnixNano()/deadline.NanoSecsInMilliSec + dpos.GetMaxMintingTime())
 				ProduceBlockFunc(dpos.hashAndSign, dl)
 				return
 			}
@@ -127,14 +364,25 @@ func (dpos *DPOS) IsProducedLocally(blk *block.Block) bool {
 	return false
 }
 
-//hashAndSign signs the block
+//hashAndSign stamps the block at the start of its time slot, puts the VRF proof of the producer in the block and
+//signs the block
 func (dpos *DPOS) hashAndSign(blk *block.Block) {
-	hash := lblock.CalculateHash(blk)
+	blk.SetTimestamp(dpos.getSlotStart(blk.GetTimestamp()))
+	if dpos.signer == nil {
+		logger.Warn("DPoS: no signer is set to sign the new block.")
+		return
+	}
+	if err := dpos.shuffler.ProveBlock(blk, dpos.dynasty.dynastyTime, dpos.signer); err != nil {
+		logger.WithError(err).Warn("DPoS: failed to evaluate the VRF of the new block.")
+	}
+	hash := lblock.CalculateHash(blk, dpos.forks)
 	blk.SetHash(hash)
-	ok := lblock.SignBlock(blk, dpos.producerKey)
-	if !ok {
-		logger.Warn("DPoS: failed to sign the new block.")
+	signature, err := dpos.signer.SignHeader(lblock.NewSignedHeader(blk, dpos.forks))
+	if err != nil {
+		logger.WithError(err).Warn("DPoS: failed to sign the new block.")
+		return
 	}
+	blk.SetSignature(signature)
 }
 
 // Validate checks that the block fulfills the dpos requirement and accepts the block in the time slot
@@ -146,6 +394,19 @@ func (dpos *DPOS) Validate(block *block.Block) bool {
 
 	if dpos.isDoubleMint(block) {
 		logger.Warn("DPoS: double-minting is detected.")
+		dpos.reportDoubleMint(block)
+		return false
+	}
+
+	if dpos.forks.IsSlotTimestampActive(block.GetHeight()) && block.GetTimestamp() != dpos.getSlotStart(block.GetTimestamp()) {
+		logger.WithFields(logger.Fields{
+			"height":    block.GetHeight(),
+			"timestamp": block.GetTimestamp(),
+		}).Warn("DPoS: the block is not stamped at the start of its time slot.")
+		return false
+	}
+
+	if !dpos.verifyEvidenceTxs(block) {
 		return false
 	}
 
@@ -153,6 +414,59 @@ func (dpos *DPOS) Validate(block *block.Block) bool {
 	return true
 }
 
+//VerifyFork checks that every block of the fork is signed by the producer in charge of its time slot, where the
+//dynasty at the height of the block is elected and shuffled by its ancestors on the fork. The blocks are ordered from
+//the fork head down to the child of the fork parent, which has to be on the chain. It lets a fork be checked before the
+//chain is rolled back to the fork parent
+func (dpos *DPOS) VerifyFork(forkBlks []*block.Block) error {
+	if dpos.chain == nil || len(forkBlks) == 0 {
+		return nil
+	}
+	fork, err := newForkChain(dpos.chain, forkBlks)
+	if err != nil {
+		return err
+	}
+
+	verifier := NewHeaderVerifier(dpos.dynasty, dpos.schedule, fork)
+	verifier.SetForks(dpos.forks)
+	if dpos.election != nil {
+		verifier.SetElection(dpos.election.ForChain(fork))
+	}
+	for i := len(forkBlks) - 1; i >= 0; i-- {
+		if err := verifier.Verify(lblock.NewSignedHeader(forkBlks[i], dpos.forks)); err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"height": forkBlks[i].GetHeight(),
+				"hash":   forkBlks[i].GetHash().String(),
+			}).Warn("DPoS: the block of the fork is not signed by its producer.")
+			return err
+		}
+	}
+	return nil
+}
+
+//VerifyTimestamp checks that the block is stamped in a later time slot than its parent, since a slot holds at most
+//one block of the chain
+func (dpos *DPOS) VerifyTimestamp(blk *block.Block, parentBlk *block.Block) bool {
+	if dpos.forks.IsSlotTimestampActive(blk.GetHeight()) && blk.GetTimestamp() <= parentBlk.GetTimestamp() {
+		logger.WithFields(logger.Fields{
+			"height":           blk.GetHeight(),
+			"timestamp":        blk.GetTimestamp(),
+			"parent_timestamp": parentBlk.GetTimestamp(),
+		}).Warn("DPoS: the block is not stamped later than its parent.")
+		return false
+	}
+	return true
+}
+
+//getSlotStart returns the start time of the time slot that contains the input time
+func (dpos *DPOS) getSlotStart(timestamp int64) int64 {
+	timeBetweenBlk := int64(dpos.dynasty.GetTimeBetweenBlk())
+	if timeBetweenBlk <= 0 {
+		return timestamp
+	}
+	return timestamp - timestamp%timeBetweenBlk
+}
+
 // verifyProducer verifies a given block is produced by the valid producer by verifying the signature of the block
 func (dpos *DPOS) verifyProducer(block *block.Block) bool {
 	if block == nil {
@@ -163,7 +477,7 @@ func (dpos *DPOS) verifyProducer(block *block.Block) bool {
 	hash := block.GetHash()
 	sign := block.GetSign()
 
-	producer := dpos.dynasty.ProducerAtATime(block.GetTimestamp())
+	dynasty := dpos.getDynastyAtHeight(block.GetHeight())
 
 	if hash == nil {
 		logger.Warn("DPoS: block hash is empty!")
@@ -187,11 +501,28 @@ func (dpos *DPOS) verifyProducer(block *block.Block) bool {
 
 	ta := account.NewTransactionAccountByPubKey(pubkey[1:])
 
-	if strings.Compare(ta.GetAddress().String(), producer) != 0 {
+	//the slot order of a shuffled round is derived from the ancestors of the block, which are not on the chain yet if
+	//the block is on a fork. The block is then only checked to be produced by a producer of the dynasty and it is
+	//verified again when its fork is merged
+	if dpos.forks.IsVRFActive(block.GetHeight()) && !dpos.shuffler.IsParentOnChain(block) {
+		if dynasty.GetProducerIndex(ta.GetAddress().String()) < 0 {
+			logger.Warn("DPoS: the signer is not a producer of the dynasty.")
+			return false
+		}
+		return dpos.isBeneficiary(block, ta.GetAddress().String())
+	}
+
+	if strings.Compare(ta.GetAddress().String(), dynasty.ProducerAtATime(block.GetTimestamp())) != 0 {
 		logger.Warn("DPoS: the signer is not the producer in this time slot.")
 		return false
 	}
 
+	//the slot order of the round is derived from the VRF outputs committed on chain, so every output has to be proven
+	if err := dpos.shuffler.VerifyBlock(block, dynasty.GetDynastyTime(), pubkey); err != nil {
+		logger.WithError(err).Warn("DPoS: the VRF proof of the block is invalid.")
+		return false
+	}
+
 	if !dpos.isProducerBeneficiary(block) {
 		logger.Warn("DPoS: failed to validate producer.")
 		return false
@@ -207,7 +538,11 @@ func (dpos *DPOS) isProducerBeneficiary(block *block.Block) bool {
 		return false
 	}
 
-	producer := dpos.dynasty.ProducerAtATime(block.GetTimestamp())
+	return dpos.isBeneficiary(block, dpos.getDynastyAtHeight(block.GetHeight()).ProducerAtATime(block.GetTimestamp()))
+}
+
+//isBeneficiary returns true if the coinbase transaction of the block pays the reward to the producer
+func (dpos *DPOS) isBeneficiary(block *block.Block, producer string) bool {
 	producerAccount := account.NewTransactionAccountByAddress(account.NewAddress(producer))
 	producerHash := producerAccount.GetPubKeyHash()
 	cbtx := block.GetCoinbaseTransaction()
@@ -242,14 +577,61 @@ func (dpos *DPOS) isDoubleMint(blk *block.Block) bool {
 	return !lblock.IsHashEqual(existBlock.(*block.Block).GetHash(), blk.GetHash())
 }
 
+//reportDoubleMint passes the evidence of the block and the block cached in the same time slot to the evidence handler
+func (dpos *DPOS) reportDoubleMint(blk *block.Block) {
+	existBlock, exist := dpos.slot.Get(int(blk.GetTimestamp() / int64(dpos.GetDynasty().timeBetweenBlk)))
+	if !exist || dpos.evidenceHandler == nil {
+		return
+	}
+	evidence := lblock.NewEvidence(existBlock.(*block.Block), blk, dpos.forks)
+	if err := dpos.VerifyEvidence(evidence); err != nil {
+		logger.WithError(err).Warn("DPoS: double-minting blocks do not make a valid evidence.")
+		return
+	}
+	dpos.evidenceHandler(evidence)
+}
+
+//VerifyEvidence checks that the evidence proves a producer signed two different blocks in the same time slot
+func (dpos *DPOS) VerifyEvidence(evidence *block.Evidence) error {
+	if _, err := evidence.Verify(); err != nil {
+		return err
+	}
+	if !evidence.IsSameSlot(dpos.dynasty.timeBetweenBlk) {
+		return ErrEvidenceDifferentSlots
+	}
+	return nil
+}
+
+//verifyEvidenceTxs returns if all evidence transactions in the block carry valid evidence
+func (dpos *DPOS) verifyEvidenceTxs(blk *block.Block) bool {
+	for _, tx := range blk.GetTransactions() {
+		if !tx.IsEvidence() {
+			continue
+		}
+		evidenceTx := &ltransaction.TxEvidence{Transaction: tx}
+		evidence, err := evidenceTx.GetEvidence()
+		if err == nil {
+			err = dpos.VerifyEvidence(evidence)
+		}
+		if err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"height": blk.GetHeight(),
+				"hash":   blk.GetHash().String(),
+			}).Warn("DPoS: block contains an invalid evidence.")
+			return false
+		}
+	}
+	return true
+}
+
 //cacheBlock adds the block to cache for double minting check
 func (dpos *DPOS) cacheBlock(block *block.Block) {
 	dpos.slot.Add(int(block.GetTimestamp()/int64(dpos.GetDynasty().timeBetweenBlk)), block)
 }
 
-//GetMinConfirmationNum returns the minimum number of producers required
+//GetMinConfirmationNum returns the minimum number of producers required. It is the same quorum that finalizes a block
 func (dpos *DPOS) GetMinConfirmationNum() int {
-	return len(dpos.dynasty.GetProducers())*2/3 + 1
+	return block.GetFinalityQuorum(len(dpos.dynasty.GetProducers()), dpos.libRatio)
 }
 
 //IsBypassingLibCheck returns if LIB check should be skipped
@@ -261,3 +643,16 @@ func (dpos *DPOS) IsBypassingLibCheck() bool {
 func (dpos *DPOS) GetTotalProducersNum() int {
 	return dpos.dynasty.maxProducers
 }
+
+//isSameProducers returns if the two producer lists are identical in the same order
+func isSameProducers(producers1, producers2 []string) bool {
+	if len(producers1) != len(producers2) {
+		return false
+	}
+	for i := range producers1 {
+		if producers1[i] != producers2[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/dappley/go-dappley/core/transaction"
 
+	"github.com/dappley/go-dappley/consensus/blocksigner"
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/logic/lblock"
 
@@ -34,11 +35,54 @@ import (
 	"github.com/dappley/go-dappley/core/account"
 )
 
+const (
+	producerAddr = "dPGZmHd73UpZhrM6uvgnzu49ttbLp4AzU8"
+	producerKey  = "5a66b0fdb69c99935783059bb200e86e97b506ae443a62febd7d0750cd7fac55"
+)
+
 func TestNewDpos(t *testing.T) {
 	dpos := NewDPOS(nil)
 	assert.Equal(t, 1, cap(dpos.stopCh))
 }
 
+func TestDPOS_GetMaxMintingTime(t *testing.T) {
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producerAddr}, 1, defaultTimeBetweenBlk))
+	assert.Equal(t, int64(defaultMaxMintingTimeInMs), dpos.GetMaxMintingTime())
+
+	//the default budget leaves most of a short slot to broadcast the block
+	dpos.SetDynasty(NewDynasty([]string{producerAddr}, 1, 1))
+	assert.Equal(t, int64(300), dpos.GetMaxMintingTime())
+
+	dpos.SetMaxMintingTime(500)
+	assert.Equal(t, int64(500), dpos.GetMaxMintingTime())
+}
+
+func TestDPOS_GetMinConfirmationNum(t *testing.T) {
+	producers := []string{
+		"dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf",
+		"dG6HhzSdA5m7KqvJNszVSf8i5f4neAteSs",
+		"dZ8GsrkSAiARL7ZnJLZSADzVXH4ea9EzhL",
+		producerAddr,
+		"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD",
+		"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+	}
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty(producers, len(producers), defaultTimeBetweenBlk))
+	assert.Equal(t, 5, dpos.GetMinConfirmationNum())
+
+	//the ratio cannot lower the quorum of the finality certificates
+	dpos.SetLibConfirmationRatio(0.5)
+	assert.Equal(t, 5, dpos.GetMinConfirmationNum())
+
+	dpos.SetLibConfirmationRatio(0.9)
+	assert.Equal(t, 6, dpos.GetMinConfirmationNum())
+
+	//a block cannot require more confirmations than there are producers
+	dpos.SetLibConfirmationRatio(1)
+	assert.Equal(t, len(producers), dpos.GetMinConfirmationNum())
+}
+
 func TestDpos_beneficiaryIsProducer(t *testing.T) {
 	producers := []string{
 		"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD",
@@ -121,6 +165,108 @@ func TestDPOS_isDoubleMint(t *testing.T) {
 	assert.True(t, dpos.isDoubleMint(blk2))
 }
 
+func TestDPOS_ReportDoubleMint(t *testing.T) {
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producerAddr}, 1, defaultTimeBetweenBlk))
+	var reported *block.Evidence
+	dpos.SetEvidenceHandler(func(evidence *block.Evidence) {
+		reported = evidence
+	})
+
+	blk1 := fakeSignedBlock(t, 10, 50, producerAddr, producerKey)
+	blk2 := fakeSignedBlock(t, 10, 51, producerAddr, producerKey)
+	assert.True(t, dpos.Validate(blk1))
+	assert.Nil(t, reported)
+	assert.False(t, dpos.Validate(blk2))
+
+	assert.NotNil(t, reported)
+	assert.Equal(t, lblock.NewEvidence(blk1, blk2, nil), reported)
+	assert.Nil(t, dpos.VerifyEvidence(reported))
+}
+
+func TestDPOS_VerifyEvidenceTxs(t *testing.T) {
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producerAddr}, 1, defaultTimeBetweenBlk))
+
+	sameSlot := lblock.NewEvidence(
+		fakeSignedBlock(t, 10, 50, producerAddr, producerKey),
+		fakeSignedBlock(t, 10, 51, producerAddr, producerKey),
+		nil,
+	)
+	differentSlots := lblock.NewEvidence(
+		fakeSignedBlock(t, 10, 50, producerAddr, producerKey),
+		fakeSignedBlock(t, 11, 55, producerAddr, producerKey),
+		nil,
+	)
+	assert.Nil(t, dpos.VerifyEvidence(sameSlot))
+	assert.Equal(t, ErrEvidenceDifferentSlots, dpos.VerifyEvidence(differentSlots))
+
+	validTx, err := ltransaction.NewEvidenceTx(sameSlot)
+	assert.Nil(t, err)
+	invalidTx, err := ltransaction.NewEvidenceTx(differentSlots)
+	assert.Nil(t, err)
+	assert.True(t, dpos.Validate(fakeSignedBlock(t, 12, 60, producerAddr, producerKey, &validTx)))
+	assert.False(t, dpos.Validate(fakeSignedBlock(t, 13, 65, producerAddr, producerKey, &invalidTx)))
+}
+
+func TestDPOS_VerifyTimestamp(t *testing.T) {
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producerAddr}, 1, defaultTimeBetweenBlk))
+	dpos.SetKey(producerKey)
+
+	//blocks are stamped at the start of their time slot
+	assert.False(t, dpos.Validate(fakeSignedBlock(t, 10, 52, producerAddr, producerKey)))
+	assert.True(t, dpos.Validate(fakeSignedBlock(t, 10, 50, producerAddr, producerKey)))
+
+	blk := FakeNewBlockWithTimestamp(58, nil, nil)
+	dpos.hashAndSign(blk)
+	assert.Equal(t, int64(55), blk.GetTimestamp())
+	assert.True(t, lblock.VerifyHash(blk, nil))
+
+	parent := FakeNewBlockWithTimestamp(50, nil, nil)
+	assert.True(t, dpos.VerifyTimestamp(FakeNewBlockWithTimestamp(55, nil, parent), parent))
+	assert.False(t, dpos.VerifyTimestamp(FakeNewBlockWithTimestamp(50, nil, parent), parent))
+	assert.False(t, dpos.VerifyTimestamp(FakeNewBlockWithTimestamp(45, nil, parent), parent))
+}
+
+func TestDPOS_VerifyTimestampBelowForkHeight(t *testing.T) {
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty([]string{producerAddr}, 1, defaultTimeBetweenBlk))
+	dpos.SetKey(producerKey)
+	dpos.SetForks(&block.Forks{SlotTimestampHeight: 11})
+
+	//the blocks below the fork height keep the timestamps of the producers
+	assert.True(t, dpos.Validate(fakeSignedBlock(t, 10, 52, producerAddr, producerKey)))
+	assert.False(t, dpos.Validate(fakeSignedBlock(t, 11, 57, producerAddr, producerKey)))
+
+	parent := FakeNewBlockWithTimestamp(50, nil, nil)
+	blk := FakeNewBlockWithTimestamp(50, nil, parent)
+	blk.SetHeight(10)
+	assert.True(t, dpos.VerifyTimestamp(blk, parent))
+	blk.SetHeight(11)
+	assert.False(t, dpos.VerifyTimestamp(blk, parent))
+}
+
+//fakeSignedBlock returns a block at the input height that pays the coinbase to the producer and is signed with its key.
+//The VRF proof of the block is evaluated for a single producer dynasty without chain
+func fakeSignedBlock(t *testing.T, height uint64, timestamp int64, producer string, key string, txs ...*transaction.Transaction) *block.Block {
+	cbtx := ltransaction.NewCoinbaseTX(account.NewAddress(producer), "", height, common.NewAmount(0))
+	parent := FakeNewBlockWithTimestamp(0, nil, nil)
+	parent.SetHeight(height - 1)
+	blk := FakeNewBlockWithTimestamp(timestamp, append(txs, &cbtx), parent)
+	assert.Nil(t, NewSlotShuffler(nil).ProveBlock(blk, defaultTimeBetweenBlk, newTestSigner(t, key)))
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.True(t, lblock.SignBlock(blk, key))
+	return blk
+}
+
+//newTestSigner returns a local signer of the key in hex
+func newTestSigner(t *testing.T, key string) blocksigner.BlockSigner {
+	signer, err := blocksigner.NewLocalSignerFromHex(key)
+	assert.Nil(t, err)
+	return signer
+}
+
 func FakeNewBlockWithTimestamp(t int64, txs []*transaction.Transaction, parent *block.Block) *block.Block {
 	var prevHash []byte
 	var height uint64
@@ -141,7 +287,7 @@ func FakeNewBlockWithTimestamp(t int64, txs []*transaction.Transaction, parent *
 		height,
 		txs)
 
-	hash := lblock.CalculateHashWithNonce(blk)
+	hash := lblock.CalculateHashWithNonce(blk, nil)
 	blk.SetHash(hash)
 	return blk
 }
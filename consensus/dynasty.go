@@ -22,6 +22,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/dappley/go-dappley/common/hash"
 	"github.com/dappley/go-dappley/core/account"
 	logger "github.com/sirupsen/logrus"
 )
@@ -31,6 +32,7 @@ type Dynasty struct {
 	maxProducers   int
 	timeBetweenBlk int
 	dynastyTime    int
+	roundSeed      func(round int64) hash.Hash
 }
 
 const (
@@ -48,8 +50,9 @@ func NewDynasty(producers []string, maxProducers, timeBetweenBlk int) *Dynasty {
 	}
 }
 
-//NewDynastyWithConfigProducers returns a new dynasty from config file
-func NewDynastyWithConfigProducers(producers []string, maxProducers int) *Dynasty {
+//NewDynastyWithConfigProducers returns a new dynasty from config file. The defaults are used for the maximum number of
+//producers and the block time if they are 0
+func NewDynastyWithConfigProducers(producers []string, maxProducers, timeBetweenBlk int) *Dynasty {
 	validProducers := []string{}
 	for _, producer := range producers {
 		producerAccount := account.NewTransactionAccountByAddress(account.NewAddress(producer))
@@ -61,12 +64,15 @@ func NewDynastyWithConfigProducers(producers []string, maxProducers int) *Dynast
 	if maxProducers == 0 {
 		maxProducers = defaultMaxProducers
 	}
+	if timeBetweenBlk == 0 {
+		timeBetweenBlk = defaultTimeBetweenBlk
+	}
 
 	d := &Dynasty{
 		producers:      validProducers,
 		maxProducers:   maxProducers,
-		timeBetweenBlk: defaultTimeBetweenBlk,
-		dynastyTime:    maxProducers * defaultTimeBetweenBlk,
+		timeBetweenBlk: timeBetweenBlk,
+		dynastyTime:    maxProducers * timeBetweenBlk,
 	}
 	d.trimProducers()
 	return d
@@ -75,8 +81,8 @@ func NewDynastyWithConfigProducers(producers []string, maxProducers int) *Dynast
 //trimProducers deletes producers if the number of producers are more than the maximum
 func (dynasty *Dynasty) trimProducers() {
 	//if producer conf file has too many producers
-	if len(dynasty.producers) > defaultMaxProducers {
-		dynasty.producers = dynasty.producers[:defaultMaxProducers]
+	if len(dynasty.producers) > dynasty.maxProducers {
+		dynasty.producers = dynasty.producers[:dynasty.maxProducers]
 	}
 }
 
@@ -150,7 +156,13 @@ func (dynasty *Dynasty) AddMultipleProducers(producers []string) {
 
 //IsMyTurn returns if it is the input producer's turn to produce block
 func (dynasty *Dynasty) IsMyTurn(producer string, now int64) bool {
-	index := dynasty.GetProducerIndex(producer)
+	index := -1
+	for i, m := range dynasty.getSlotOrder(now) {
+		if producer == m {
+			index = i
+			break
+		}
+	}
 	return dynasty.isMyTurnByIndex(index, now)
 }
 
@@ -170,7 +182,24 @@ func (dynasty *Dynasty) ProducerAtATime(time int64) string {
 	}
 	dynastyTimeElapsed := int(time % int64(dynasty.dynastyTime))
 	index := dynastyTimeElapsed / dynasty.timeBetweenBlk
-	return dynasty.producers[index]
+	//an elected dynasty may have less producers than the maximum, which leaves the remaining slots empty
+	if index >= len(dynasty.producers) {
+		return ""
+	}
+	return dynasty.getSlotOrder(time)[index]
+}
+
+//getSlotOrder returns the producers in the order of their slots in the round of the input time. The order is
+//shuffled by the round seed if the dynasty has one
+func (dynasty *Dynasty) getSlotOrder(time int64) []string {
+	if dynasty.roundSeed == nil || time < 0 {
+		return dynasty.producers
+	}
+	seed := dynasty.roundSeed(time / int64(dynasty.dynastyTime))
+	if seed == nil {
+		return dynasty.producers
+	}
+	return shuffleProducers(dynasty.producers, seed)
 }
 
 //find the index of the producer. If not found, return -1
@@ -183,6 +212,11 @@ func (dynasty *Dynasty) GetProducerIndex(producer string) int {
 	return -1
 }
 
+//GetTimeBetweenBlk returns the block time
+func (dynasty *Dynasty) GetTimeBetweenBlk() int {
+	return dynasty.timeBetweenBlk
+}
+
 //GetDynastyTime returns the dynasty time
 func (dynasty *Dynasty) GetDynastyTime() int {
 	return dynasty.dynastyTime
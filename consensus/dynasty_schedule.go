@@ -0,0 +1,183 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"sort"
+	"sync"
+
+	consensuspb "github.com/dappley/go-dappley/consensus/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const dynastyScheduleKey = "dynastySchedule"
+
+// DynastyChange replaces the producers of the dynasty from the activation height on
+type DynastyChange struct {
+	Height    uint64
+	Producers []string
+}
+
+// DynastySchedule keeps the dynasty changes ordered by activation height and persists them in the database,
+// so that every block can be checked against the producers that were in charge at its height
+type DynastySchedule struct {
+	db               storage.Storage
+	initialProducers []string
+	changes          []*DynastyChange
+	mutex            sync.RWMutex
+}
+
+//NewDynastySchedule returns a schedule starting with the input producers and loads the changes saved in the database
+func NewDynastySchedule(db storage.Storage, initialProducers []string) *DynastySchedule {
+	schedule := &DynastySchedule{
+		db:               db,
+		initialProducers: copyProducers(initialProducers),
+	}
+	if db == nil {
+		return schedule
+	}
+
+	rawBytes, err := db.Get([]byte(dynastyScheduleKey))
+	if err != nil {
+		return schedule
+	}
+	schedulePb := &consensuspb.DynastySchedule{}
+	if err := proto.Unmarshal(rawBytes, schedulePb); err != nil {
+		logger.WithError(err).Warn("DynastySchedule: failed to load the dynasty changes from database.")
+		return schedule
+	}
+	schedule.FromProto(schedulePb)
+	return schedule
+}
+
+//AddChange schedules the input producers to take over from the activation height on and saves the schedule.
+//A change at an already scheduled height replaces the previous one
+func (schedule *DynastySchedule) AddChange(change *DynastyChange) error {
+	schedule.mutex.Lock()
+	defer schedule.mutex.Unlock()
+
+	index := sort.Search(len(schedule.changes), func(i int) bool {
+		return schedule.changes[i].Height >= change.Height
+	})
+	newChange := &DynastyChange{change.Height, copyProducers(change.Producers)}
+	if index < len(schedule.changes) && schedule.changes[index].Height == change.Height {
+		if isSameProducers(schedule.changes[index].Producers, change.Producers) {
+			return nil
+		}
+		oldChange := schedule.changes[index]
+		schedule.changes[index] = newChange
+		if err := schedule.save(); err != nil {
+			schedule.changes[index] = oldChange
+			return err
+		}
+	} else {
+		schedule.changes = append(schedule.changes, nil)
+		copy(schedule.changes[index+1:], schedule.changes[index:])
+		schedule.changes[index] = newChange
+		if err := schedule.save(); err != nil {
+			schedule.changes = append(schedule.changes[:index], schedule.changes[index+1:]...)
+			return err
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"height":    change.Height,
+		"producers": change.Producers,
+	}).Info("DynastySchedule: scheduled a dynasty change.")
+	return nil
+}
+
+//GetProducersAtHeight returns the producers that are in charge of the block at the input height
+func (schedule *DynastySchedule) GetProducersAtHeight(height uint64) []string {
+	schedule.mutex.RLock()
+	defer schedule.mutex.RUnlock()
+
+	index := sort.Search(len(schedule.changes), func(i int) bool {
+		return schedule.changes[i].Height > height
+	})
+	if index == 0 {
+		return copyProducers(schedule.initialProducers)
+	}
+	return copyProducers(schedule.changes[index-1].Producers)
+}
+
+//GetChanges returns all scheduled changes ordered by activation height
+func (schedule *DynastySchedule) GetChanges() []*DynastyChange {
+	schedule.mutex.RLock()
+	defer schedule.mutex.RUnlock()
+
+	changes := make([]*DynastyChange, len(schedule.changes))
+	for i, change := range schedule.changes {
+		changes[i] = &DynastyChange{change.Height, copyProducers(change.Producers)}
+	}
+	return changes
+}
+
+//save writes the schedule into the database
+func (schedule *DynastySchedule) save() error {
+	if schedule.db == nil {
+		return nil
+	}
+	rawBytes, err := proto.Marshal(schedule.ToProto())
+	if err != nil {
+		return err
+	}
+	return schedule.db.Put([]byte(dynastyScheduleKey), rawBytes)
+}
+
+func (schedule *DynastySchedule) ToProto() proto.Message {
+	changesPb := []*consensuspb.DynastyChange{}
+	for _, change := range schedule.changes {
+		changesPb = append(changesPb, change.ToProto().(*consensuspb.DynastyChange))
+	}
+	return &consensuspb.DynastySchedule{Changes: changesPb}
+}
+
+func (schedule *DynastySchedule) FromProto(pb proto.Message) {
+	schedule.changes = []*DynastyChange{}
+	for _, changePb := range pb.(*consensuspb.DynastySchedule).GetChanges() {
+		change := &DynastyChange{}
+		change.FromProto(changePb)
+		schedule.changes = append(schedule.changes, change)
+	}
+	sort.SliceStable(schedule.changes, func(i, j int) bool {
+		return schedule.changes[i].Height < schedule.changes[j].Height
+	})
+}
+
+func (change *DynastyChange) ToProto() proto.Message {
+	return &consensuspb.DynastyChange{
+		Height:    change.Height,
+		Producers: change.Producers,
+	}
+}
+
+func (change *DynastyChange) FromProto(pb proto.Message) {
+	change.Height = pb.(*consensuspb.DynastyChange).GetHeight()
+	change.Producers = pb.(*consensuspb.DynastyChange).GetProducers()
+}
+
+//copyProducers returns a copy of the producer list so that callers cannot modify the stored lists
+func copyProducers(producers []string) []string {
+	producersCopy := make([]string, len(producers))
+	copy(producersCopy, producers)
+	return producersCopy
+}
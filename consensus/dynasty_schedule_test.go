@@ -0,0 +1,141 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDynastySchedule_GetProducersAtHeight(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	producers1 := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD", "1MeSBgufmzwpiJNLemUe1emxAussBnz7a7"}
+	producers2 := []string{"1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"}
+
+	schedule := NewDynastySchedule(nil, initialProducers)
+	assert.Nil(t, schedule.AddChange(&DynastyChange{Height: 20, Producers: producers2}))
+	assert.Nil(t, schedule.AddChange(&DynastyChange{Height: 10, Producers: producers1}))
+
+	assert.Equal(t, initialProducers, schedule.GetProducersAtHeight(0))
+	assert.Equal(t, initialProducers, schedule.GetProducersAtHeight(9))
+	assert.Equal(t, producers1, schedule.GetProducersAtHeight(10))
+	assert.Equal(t, producers1, schedule.GetProducersAtHeight(19))
+	assert.Equal(t, producers2, schedule.GetProducersAtHeight(20))
+	assert.Equal(t, producers2, schedule.GetProducersAtHeight(1000))
+
+	//a change at a scheduled height replaces the previous one
+	assert.Nil(t, schedule.AddChange(&DynastyChange{Height: 10, Producers: producers2}))
+	assert.Equal(t, producers2, schedule.GetProducersAtHeight(10))
+	assert.Equal(t, 2, len(schedule.GetChanges()))
+
+	//the returned list is a copy
+	producers := schedule.GetProducersAtHeight(0)
+	producers[0] = "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+	assert.Equal(t, initialProducers, schedule.GetProducersAtHeight(0))
+}
+
+func TestDynastySchedule_Persistence(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	producers := []string{"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7", "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"}
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	schedule := NewDynastySchedule(db, initialProducers)
+	assert.Nil(t, schedule.AddChange(&DynastyChange{Height: 100, Producers: producers}))
+
+	//the schedule survives a restart
+	reloaded := NewDynastySchedule(db, initialProducers)
+	assert.Equal(t, schedule.GetChanges(), reloaded.GetChanges())
+	assert.Equal(t, initialProducers, reloaded.GetProducersAtHeight(99))
+	assert.Equal(t, producers, reloaded.GetProducersAtHeight(100))
+}
+
+func TestDPOS_AddProducer(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	newProducer := "1MeSBgufmzwpiJNLemUe1emxAussBnz7a7"
+	chain := &fakeChain{}
+	chain.addBlocks(5)
+
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty(initialProducers, 3, defaultTimeBetweenBlk))
+	assert.Equal(t, ErrDynastyScheduleNotSet, dpos.AddProducer(newProducer, 0))
+
+	dpos.SetChain(chain)
+	dpos.SetDynastySchedule(NewDynastySchedule(nil, initialProducers))
+
+	//blocks on chain cannot be changed
+	assert.Equal(t, ErrActivationHeightPassed, dpos.AddProducer(newProducer, 4))
+	assert.NotNil(t, dpos.AddProducer("invalid", 0))
+
+	//the producer joins at the next epoch
+	assert.Nil(t, dpos.AddProducer(newProducer, 0))
+	assert.Equal(t, []*DynastyChange{{Height: DefaultEpochLength, Producers: []string{initialProducers[0], newProducer}}},
+		dpos.GetDynastySchedule().GetChanges())
+	assert.NotNil(t, dpos.AddProducer(newProducer, 0))
+
+	//the current dynasty is not changed before the activation height
+	dpos.updateDynasty()
+	assert.Equal(t, initialProducers, dpos.GetProducers())
+	chain.addBlocks(DefaultEpochLength - 5)
+	dpos.updateDynasty()
+	assert.Equal(t, []string{initialProducers[0], newProducer}, dpos.GetProducers())
+}
+
+func TestDPOS_AddProducerWithElection(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	newProducer := "1MeSBgufmzwpiJNLemUe1emxAussBnz7a7"
+	chain := &fakeChain{}
+	chain.addBlocks(5)
+
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty(initialProducers, 3, defaultTimeBetweenBlk))
+	dpos.SetChain(chain)
+	schedule := NewDynastySchedule(nil, initialProducers)
+	dpos.SetDynastySchedule(schedule)
+	dpos.SetElection(NewElection(chain, 10, 3, schedule))
+
+	//the schedule can be changed as long as nobody votes
+	assert.Nil(t, dpos.AddProducer(newProducer, 0))
+
+	chain.addBlocks(1, fakeVoteTx(account.NewAccount(), newProducer, 10))
+	assert.Equal(t, ErrElectionActive, dpos.AddProducer("dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB", 0))
+	assert.Equal(t, ErrElectionActive, dpos.ScheduleProducers(initialProducers, 0))
+}
+
+func TestDPOS_ValidateAgainstScheduledDynasty(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty(initialProducers, 1, defaultTimeBetweenBlk))
+	dpos.SetDynastySchedule(NewDynastySchedule(nil, initialProducers))
+	assert.Nil(t, dpos.GetDynastySchedule().AddChange(&DynastyChange{Height: 10, Producers: []string{producerAddr}}))
+
+	newSignedBlock := func(height uint64) bool {
+		return dpos.Validate(fakeSignedBlock(t, height, int64(height)*defaultTimeBetweenBlk, producerAddr, producerKey))
+	}
+
+	//the producer is only valid from the activation height on, regardless of the current dynasty
+	assert.False(t, newSignedBlock(9))
+	assert.True(t, newSignedBlock(10))
+	assert.True(t, newSignedBlock(11))
+	assert.Equal(t, initialProducers, dpos.GetProducers())
+}
@@ -34,6 +34,26 @@ func TestDynasty_NewDynasty(t *testing.T) {
 	assert.Empty(t, dynasty.producers)
 }
 
+func TestDynasty_NewDynastyWithConfigProducers(t *testing.T) {
+	producers := []string{
+		"dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf",
+		"m1",
+		"dG6HhzSdA5m7KqvJNszVSf8i5f4neAteSs",
+		"dZ8GsrkSAiARL7ZnJLZSADzVXH4ea9EzhL",
+	}
+
+	//invalid producers are skipped and the rest is trimmed to the configured maximum
+	dynasty := NewDynastyWithConfigProducers(producers, 2, 1)
+	assert.Equal(t, []string{producers[0], producers[2]}, dynasty.GetProducers())
+	assert.Equal(t, 1, dynasty.GetTimeBetweenBlk())
+	assert.Equal(t, 2, dynasty.GetDynastyTime())
+
+	dynasty = NewDynastyWithConfigProducers(producers, 0, 0)
+	assert.Equal(t, []string{producers[0], producers[2], producers[3]}, dynasty.GetProducers())
+	assert.Equal(t, defaultMaxProducers, dynasty.GetMaxProducers())
+	assert.Equal(t, defaultTimeBetweenBlk, dynasty.GetTimeBetweenBlk())
+}
+
 func TestDynasty_AddProducer(t *testing.T) {
 	tests := []struct {
 		name     string
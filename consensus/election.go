@@ -0,0 +1,529 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	consensuspb "github.com/dappley/go-dappley/consensus/pb"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/golang-lru"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	DefaultEpochLength    = 100
+	electedCacheSize      = 16
+	electionCheckpointKey = "electionCheckpoint"
+)
+
+var (
+	ErrTallyBelowCheckpoint = errors.New("election: the votes below the checkpoint cannot be tallied again")
+	ErrStakeNotFound        = errors.New("election: the stake of a vote is not an unspent output")
+)
+
+// ChainReader provides read access to the main chain from which dynasties are elected
+type ChainReader interface {
+	GetMaxHeight() uint64
+	GetBlockByHeight(height uint64) (*block.Block, error)
+}
+
+// vote is the latest vote of a voter. It stays valid as long as its stake utxo is unspent
+type vote struct {
+	candidate string
+	stake     *common.Amount
+	utxoKey   string
+}
+
+// tallyResult is the outcome of tallying the blocks before an epoch
+type tallyResult struct {
+	elected []string
+	slashed map[string]bool
+}
+
+// ElectionCheckpoint is the tally of the votes in the blocks below a height, with the producers of the epochs that are
+// elected by blocks below that height and the heights of the blocks that changed the tally. An election resumes from
+// its checkpoint without the blocks below the height
+type ElectionCheckpoint struct {
+	tallyHeight uint64
+	tallyHash   hash.Hash
+	votes       map[string]*vote
+	slashed     map[string]bool
+	elected     map[uint64][]string
+	voteHeights []uint64
+}
+
+// Election tallies the stake-weighted votes recorded on chain and elects the producers of each epoch.
+// The producers of epoch e are elected by the votes included in blocks before epoch e-1, so that a
+// dynasty is known one full epoch before it takes effect. Producers proven to double-mint by an evidence
+// in the same blocks are excluded from the dynasty.
+type Election struct {
+	chain        ChainReader
+	epochLength  uint64
+	maxProducers int
+	schedule     *DynastySchedule
+	votes        map[string]*vote
+	voteUtxos    map[string]string
+	slashed      map[string]bool
+	tallyHeight  uint64
+	tallyHash    hash.Hash
+	voteHeights  []uint64
+	elected      *lru.Cache
+	checkpoint   *ElectionCheckpoint
+	mutex        sync.Mutex
+}
+
+//NewElection returns an election that elects at most maxProducers producers per epoch. The producers of the
+//input schedule are in charge until the first votes are tallied and whenever no candidate is elected
+func NewElection(chain ChainReader, epochLength uint64, maxProducers int, schedule *DynastySchedule) *Election {
+	if epochLength == 0 {
+		epochLength = DefaultEpochLength
+	}
+	elected, err := lru.New(electedCacheSize)
+	if err != nil {
+		logger.Panic(err)
+	}
+	return &Election{
+		chain:        chain,
+		epochLength:  epochLength,
+		maxProducers: maxProducers,
+		schedule:     schedule,
+		votes:        make(map[string]*vote),
+		voteUtxos:    make(map[string]string),
+		slashed:      make(map[string]bool),
+		elected:      elected,
+	}
+}
+
+//ForChain returns an election of the same producers on top of the input chain, e.g. a fork that is not merged yet. It
+//resumes from the checkpoint of the election and shares the producers elected by the same blocks
+func (e *Election) ForChain(chain ChainReader) *Election {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	election := &Election{
+		chain:        chain,
+		epochLength:  e.epochLength,
+		maxProducers: e.maxProducers,
+		schedule:     e.schedule,
+		elected:      e.elected,
+		checkpoint:   e.checkpoint,
+	}
+	election.reset()
+	return election
+}
+
+//GetEpochLength returns the number of blocks in an epoch
+func (e *Election) GetEpochLength() uint64 {
+	return e.epochLength
+}
+
+//GetEpoch returns the epoch of the input block height
+func (e *Election) GetEpoch(height uint64) uint64 {
+	return height / e.epochLength
+}
+
+//IsEpochBoundary returns if the block at the input height starts a new epoch
+func (e *Election) IsEpochBoundary(height uint64) bool {
+	return height%e.epochLength == 0
+}
+
+//GetProducersAtHeight returns the ordered producer list elected for the epoch of the input block height
+func (e *Election) GetProducersAtHeight(height uint64) []string {
+	epoch := e.GetEpoch(height)
+	if epoch < 2 {
+		return e.schedule.GetProducersAtHeight(height)
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if e.checkpoint != nil {
+		if producers, ok := e.checkpoint.elected[epoch]; ok {
+			return copyProducers(producers)
+		}
+	}
+
+	tallyEnd := (epoch - 1) * e.epochLength
+	lastBlk, err := e.chain.GetBlockByHeight(tallyEnd - 1)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height": height,
+			"epoch":  epoch,
+		}).Warn("Election: cannot find the last block of the tally window.")
+		return e.schedule.GetProducersAtHeight(height)
+	}
+
+	if result, ok := e.elected.Get(lastBlk.GetHash().String()); ok {
+		return e.getProducers(result.(*tallyResult), height)
+	}
+
+	if err := e.tallyUntil(tallyEnd); err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height": height,
+			"epoch":  epoch,
+		}).Warn("Election: failed to tally votes.")
+		return e.schedule.GetProducersAtHeight(height)
+	}
+
+	result := &tallyResult{e.elect(), make(map[string]bool)}
+	for producer := range e.slashed {
+		result.slashed[producer] = true
+	}
+	e.elected.Add(lastBlk.GetHash().String(), result)
+	logger.WithFields(logger.Fields{
+		"epoch":     epoch,
+		"producers": result.elected,
+		"slashed":   len(result.slashed),
+	}).Debug("Election: elected producers.")
+	return e.getProducers(result, height)
+}
+
+//getProducers returns a copy of the elected producers, or the scheduled producers at the input height without the
+//slashed ones if nobody is elected
+func (e *Election) getProducers(result *tallyResult, height uint64) []string {
+	if len(result.elected) > 0 {
+		return copyProducers(result.elected)
+	}
+
+	scheduled := e.schedule.GetProducersAtHeight(height)
+	producers := []string{}
+	for _, producer := range scheduled {
+		if !result.slashed[producer] {
+			producers = append(producers, producer)
+		}
+	}
+	//a dynasty without producers would halt the chain
+	if len(producers) == 0 {
+		logger.WithFields(logger.Fields{
+			"height": height,
+		}).Warn("Election: all scheduled producers are slashed.")
+		return scheduled
+	}
+	return producers
+}
+
+//tallyUntil applies the votes of all blocks below the input height. The tally restarts from genesis, or from the
+//checkpoint, if the blocks already tallied are no longer on the main chain or the tally is ahead of the input height
+func (e *Election) tallyUntil(height uint64) error {
+	if e.tallyHeight > 0 {
+		blk, err := e.chain.GetBlockByHeight(e.tallyHeight - 1)
+		if e.tallyHeight > height || err != nil || !blk.GetHash().Equals(e.tallyHash) {
+			e.reset()
+		}
+	}
+	//the votes below the checkpoint cannot be tallied again
+	if e.tallyHeight > height {
+		return ErrTallyBelowCheckpoint
+	}
+
+	for ; e.tallyHeight < height; e.tallyHeight++ {
+		blk, err := e.chain.GetBlockByHeight(e.tallyHeight)
+		if err != nil {
+			e.reset()
+			return err
+		}
+		if e.applyBlock(blk) {
+			e.voteHeights = append(e.voteHeights, blk.GetHeight())
+		}
+		e.tallyHash = blk.GetHash()
+	}
+	return nil
+}
+
+//HasVotes returns if any vote is standing in the blocks below the input height
+func (e *Election) HasVotes(height uint64) (bool, error) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if err := e.tallyUntil(height); err != nil {
+		return false, err
+	}
+	return len(e.votes) > 0, nil
+}
+
+//reset clears all tallied votes, or restores the tally of the checkpoint if the election has one
+func (e *Election) reset() {
+	e.votes = make(map[string]*vote)
+	e.voteUtxos = make(map[string]string)
+	e.slashed = make(map[string]bool)
+	e.tallyHeight = 0
+	e.tallyHash = nil
+	e.voteHeights = nil
+	if e.checkpoint == nil {
+		return
+	}
+
+	for voter, v := range e.checkpoint.votes {
+		e.votes[voter] = &vote{v.candidate, v.stake, v.utxoKey}
+		e.voteUtxos[v.utxoKey] = voter
+	}
+	for producer := range e.checkpoint.slashed {
+		e.slashed[producer] = true
+	}
+	e.tallyHeight = e.checkpoint.tallyHeight
+	e.tallyHash = e.checkpoint.tallyHash
+	e.voteHeights = append([]uint64{}, e.checkpoint.voteHeights...)
+}
+
+//GetCheckpoint returns the checkpoint of the election with the votes of the blocks up to the input height
+func (e *Election) GetCheckpoint(height uint64) (*ElectionCheckpoint, error) {
+	//the producers of the epochs elected by blocks up to the height are kept in the checkpoint
+	elected := make(map[uint64][]string)
+	for epoch := e.GetEpoch(height); epoch <= e.GetEpoch(height)+1; epoch++ {
+		if epoch >= 2 {
+			elected[epoch] = e.GetProducersAtHeight(epoch * e.epochLength)
+		}
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	if err := e.tallyUntil(height + 1); err != nil {
+		return nil, err
+	}
+
+	checkpoint := &ElectionCheckpoint{
+		tallyHeight: e.tallyHeight,
+		tallyHash:   e.tallyHash,
+		votes:       make(map[string]*vote),
+		slashed:     make(map[string]bool),
+		elected:     elected,
+		voteHeights: append([]uint64{}, e.voteHeights...),
+	}
+	for voter, v := range e.votes {
+		checkpoint.votes[voter] = &vote{v.candidate, v.stake, v.utxoKey}
+	}
+	for producer := range e.slashed {
+		checkpoint.slashed[producer] = true
+	}
+	return checkpoint, nil
+}
+
+//SetCheckpoint makes the election resume from the checkpoint instead of tallying the votes from genesis
+func (e *Election) SetCheckpoint(checkpoint *ElectionCheckpoint) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.checkpoint = checkpoint
+	e.elected.Purge()
+	e.reset()
+}
+
+//SaveCheckpoint writes the checkpoint of the votes in the blocks up to the input height into the database, and resumes
+//the election from it, so that the bodies of the blocks up to the height are no longer needed
+func (e *Election) SaveCheckpoint(db storage.Storage, height uint64) error {
+	checkpoint, err := e.GetCheckpoint(height)
+	if err != nil {
+		return err
+	}
+	if err := checkpoint.Save(db); err != nil {
+		return err
+	}
+	e.SetCheckpoint(checkpoint)
+	return nil
+}
+
+//GetHeight returns the height of the first block that is not tallied in the checkpoint
+func (checkpoint *ElectionCheckpoint) GetHeight() uint64 {
+	return checkpoint.tallyHeight
+}
+
+//GetHash returns the hash of the last block tallied in the checkpoint
+func (checkpoint *ElectionCheckpoint) GetHash() hash.Hash {
+	return checkpoint.tallyHash
+}
+
+//GetVoteHeights returns the heights of the blocks that changed the tally of the checkpoint in ascending order. The
+//votes of the checkpoint can be tallied again from these blocks alone
+func (checkpoint *ElectionCheckpoint) GetVoteHeights() []uint64 {
+	return checkpoint.voteHeights
+}
+
+//VerifyStakes checks that the stake of every standing vote in the checkpoint is one of the input unspent outputs
+func (checkpoint *ElectionCheckpoint) VerifyStakes(utxos []*utxo.UTXO) error {
+	unspent := make(map[string]*utxo.UTXO)
+	for _, u := range utxos {
+		unspent[getVoteUtxoKey(u.Txid, u.TxIndex)] = u
+	}
+	for voter, v := range checkpoint.votes {
+		u, ok := unspent[v.utxoKey]
+		if !ok || hex.EncodeToString(u.PubKeyHash) != voter || u.Contract != v.candidate || u.Value.Cmp(v.stake) != 0 {
+			return ErrStakeNotFound
+		}
+	}
+	return nil
+}
+
+//Save writes the checkpoint into the database
+func (checkpoint *ElectionCheckpoint) Save(db storage.Storage) error {
+	rawBytes, err := proto.Marshal(checkpoint.ToProto())
+	if err != nil {
+		return err
+	}
+	return db.Put([]byte(electionCheckpointKey), rawBytes)
+}
+
+//LoadElectionCheckpoint returns the checkpoint saved in the database
+func LoadElectionCheckpoint(db storage.Storage) (*ElectionCheckpoint, error) {
+	rawBytes, err := db.Get([]byte(electionCheckpointKey))
+	if err != nil {
+		return nil, err
+	}
+	checkpointPb := &consensuspb.ElectionCheckpoint{}
+	if err := proto.Unmarshal(rawBytes, checkpointPb); err != nil {
+		return nil, err
+	}
+	checkpoint := &ElectionCheckpoint{}
+	checkpoint.FromProto(checkpointPb)
+	return checkpoint, nil
+}
+
+func (checkpoint *ElectionCheckpoint) ToProto() proto.Message {
+	checkpointPb := &consensuspb.ElectionCheckpoint{
+		TallyHeight: checkpoint.tallyHeight,
+		TallyHash:   checkpoint.tallyHash,
+	}
+	for voter, v := range checkpoint.votes {
+		checkpointPb.Votes = append(checkpointPb.Votes, &consensuspb.Vote{
+			Voter:     voter,
+			Candidate: v.candidate,
+			Stake:     v.stake.Bytes(),
+			UtxoKey:   v.utxoKey,
+		})
+	}
+	sort.Slice(checkpointPb.Votes, func(i, j int) bool {
+		return checkpointPb.Votes[i].Voter < checkpointPb.Votes[j].Voter
+	})
+	for producer := range checkpoint.slashed {
+		checkpointPb.Slashed = append(checkpointPb.Slashed, producer)
+	}
+	sort.Strings(checkpointPb.Slashed)
+	for epoch, producers := range checkpoint.elected {
+		checkpointPb.Elected = append(checkpointPb.Elected, &consensuspb.ElectedProducers{Epoch: epoch, Producers: producers})
+	}
+	sort.Slice(checkpointPb.Elected, func(i, j int) bool {
+		return checkpointPb.Elected[i].Epoch < checkpointPb.Elected[j].Epoch
+	})
+	checkpointPb.VoteHeights = checkpoint.voteHeights
+	return checkpointPb
+}
+
+func (checkpoint *ElectionCheckpoint) FromProto(pb proto.Message) {
+	checkpointPb := pb.(*consensuspb.ElectionCheckpoint)
+	checkpoint.tallyHeight = checkpointPb.GetTallyHeight()
+	checkpoint.tallyHash = checkpointPb.GetTallyHash()
+	checkpoint.votes = make(map[string]*vote)
+	for _, votePb := range checkpointPb.GetVotes() {
+		checkpoint.votes[votePb.GetVoter()] = &vote{votePb.GetCandidate(), common.NewAmountFromBytes(votePb.GetStake()), votePb.GetUtxoKey()}
+	}
+	checkpoint.slashed = make(map[string]bool)
+	for _, producer := range checkpointPb.GetSlashed() {
+		checkpoint.slashed[producer] = true
+	}
+	checkpoint.elected = make(map[uint64][]string)
+	for _, electedPb := range checkpointPb.GetElected() {
+		checkpoint.elected[electedPb.GetEpoch()] = electedPb.GetProducers()
+	}
+	checkpoint.voteHeights = checkpointPb.GetVoteHeights()
+}
+
+//applyBlock withdraws the votes whose stake is spent in the block, records the new votes in the block and
+//slashes the producers proven to double-mint by the evidence in the block. It returns if the block changed the tally
+func (e *Election) applyBlock(blk *block.Block) bool {
+	changed := false
+	for _, tx := range blk.GetTransactions() {
+		if tx.IsEvidence() {
+			evidenceTx := &ltransaction.TxEvidence{Transaction: tx}
+			if offender, err := evidenceTx.GetOffender(); err == nil {
+				e.slashed[offender] = true
+				changed = true
+			}
+			continue
+		}
+
+		for _, vin := range tx.Vin {
+			utxoKey := getVoteUtxoKey(vin.Txid, vin.Vout)
+			if voter, ok := e.voteUtxos[utxoKey]; ok {
+				delete(e.voteUtxos, utxoKey)
+				delete(e.votes, voter)
+				changed = true
+			}
+		}
+
+		adaptedTx := transaction.NewTxAdapter(tx)
+		if !adaptedTx.IsVote() || len(tx.Vout) <= transaction.VoteTxOutputIndex {
+			continue
+		}
+		stake := tx.Vout[transaction.VoteTxOutputIndex]
+		voter := hex.EncodeToString(stake.PubKeyHash)
+		if oldVote, ok := e.votes[voter]; ok {
+			delete(e.voteUtxos, oldVote.utxoKey)
+		}
+		utxoKey := getVoteUtxoKey(tx.ID, transaction.VoteTxOutputIndex)
+		e.votes[voter] = &vote{stake.Contract, stake.Value, utxoKey}
+		e.voteUtxos[utxoKey] = voter
+		changed = true
+	}
+	return changed
+}
+
+//elect returns the candidates ordered by their total stake, and by address when the stakes are equal
+func (e *Election) elect() []string {
+	stakes := make(map[string]*common.Amount)
+	for _, v := range e.votes {
+		if _, ok := stakes[v.candidate]; !ok {
+			stakes[v.candidate] = common.NewAmount(0)
+		}
+		stakes[v.candidate] = stakes[v.candidate].Add(v.stake)
+	}
+
+	candidates := []string{}
+	for candidate := range stakes {
+		if e.slashed[candidate] {
+			continue
+		}
+		if account.NewTransactionAccountByAddress(account.NewAddress(candidate)).IsValid() {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if cmp := stakes[candidates[i]].Cmp(stakes[candidates[j]]); cmp != 0 {
+			return cmp > 0
+		}
+		return candidates[i] < candidates[j]
+	})
+
+	if len(candidates) > e.maxProducers {
+		candidates = candidates[:e.maxProducers]
+	}
+	return candidates
+}
+
+func getVoteUtxoKey(txid []byte, vout int) string {
+	return hex.EncodeToString(txid) + "_" + strconv.Itoa(vout)
+}
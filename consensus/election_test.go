@@ -0,0 +1,328 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeChain struct {
+	blocks       []*block.Block
+	prunedHeight uint64
+}
+
+func (chain *fakeChain) GetMaxHeight() uint64 {
+	return chain.blocks[len(chain.blocks)-1].GetHeight()
+}
+
+func (chain *fakeChain) GetBlockByHeight(height uint64) (*block.Block, error) {
+	if height >= uint64(len(chain.blocks)) || height < chain.prunedHeight {
+		return nil, errors.New("block not found")
+	}
+	return chain.blocks[height], nil
+}
+
+//addBlocks appends count blocks to the chain. The input transactions are included in the first block
+func (chain *fakeChain) addBlocks(count int, txs ...*transaction.Transaction) {
+	for i := 0; i < count; i++ {
+		var parent *block.Block
+		if len(chain.blocks) > 0 {
+			parent = chain.blocks[len(chain.blocks)-1]
+		}
+		blkTxs := []*transaction.Transaction{}
+		if i == 0 {
+			blkTxs = txs
+		}
+		blk := block.NewBlock(blkTxs, parent, "")
+		if parent == nil {
+			blk.SetHeight(0)
+		}
+		blk.SetHash(lblock.CalculateHash(blk, nil))
+		chain.blocks = append(chain.blocks, blk)
+	}
+}
+
+func fakeVoteTx(voter *account.Account, candidate string, stake uint64, spent ...*transaction.Transaction) *transaction.Transaction {
+	vin := []transactionbase.TXInput{{Txid: util.GenerateRandomAoB(4), Vout: 0, PubKey: voter.GetKeyPair().GetPublicKey()}}
+	for _, tx := range spent {
+		vin = append(vin, transactionbase.TXInput{Txid: tx.ID, Vout: 0, PubKey: voter.GetKeyPair().GetPublicKey()})
+	}
+	tx := &transaction.Transaction{
+		Vin:      vin,
+		Vout:     []transactionbase.TXOutput{{Value: common.NewAmount(stake), PubKeyHash: voter.GetPubKeyHash(), Contract: candidate}},
+		Tip:      common.NewAmount(0),
+		GasLimit: common.NewAmount(0),
+		GasPrice: common.NewAmount(0),
+		Type:     transaction.TxTypeVote,
+	}
+	tx.ID = tx.Hash()
+	return tx
+}
+
+func fakeSpendTx(owner *account.Account, spent *transaction.Transaction) *transaction.Transaction {
+	tx := &transaction.Transaction{
+		Vin:      []transactionbase.TXInput{{Txid: spent.ID, Vout: 0, PubKey: owner.GetKeyPair().GetPublicKey()}},
+		Vout:     []transactionbase.TXOutput{{Value: common.NewAmount(1), PubKeyHash: owner.GetPubKeyHash()}},
+		Tip:      common.NewAmount(0),
+		GasLimit: common.NewAmount(0),
+		GasPrice: common.NewAmount(0),
+		Type:     transaction.TxTypeNormal,
+	}
+	tx.ID = tx.Hash()
+	return tx
+}
+
+func TestElection_GetProducersAtHeight(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD", "1MeSBgufmzwpiJNLemUe1emxAussBnz7a7"}
+	candidates := []string{"1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct", "dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB", "dRxukNqeADQrAvnHD52BVNdGg6Bgmyuaw4"}
+	voters := []*account.Account{account.NewAccount(), account.NewAccount(), account.NewAccount(), account.NewAccount()}
+
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 2, NewDynastySchedule(nil, initialProducers))
+
+	//epoch 0
+	chain.addBlocks(5)
+	vote0 := fakeVoteTx(voters[0], candidates[0], 10)
+	vote1 := fakeVoteTx(voters[1], candidates[1], 20)
+	vote2 := fakeVoteTx(voters[2], candidates[2], 15)
+	invalidVote := fakeVoteTx(voters[3], "invalid", 100)
+	chain.addBlocks(5, vote0, vote1, vote2, invalidVote)
+
+	//epoch 1
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(0))
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(19))
+	//epoch 2 is elected by the votes in epoch 0
+	assert.Equal(t, []string{candidates[1], candidates[2]}, election.GetProducersAtHeight(20))
+
+	//voter 0 replaces the vote with a larger stake; equal stakes are ordered by address
+	chain.addBlocks(10, fakeVoteTx(voters[0], candidates[0], 15, vote0))
+	assert.Equal(t, []string{candidates[1], candidates[0]}, election.GetProducersAtHeight(30))
+
+	//spending the stake withdraws the vote
+	chain.addBlocks(10, fakeSpendTx(voters[1], vote1))
+	assert.Equal(t, []string{candidates[0], candidates[2]}, election.GetProducersAtHeight(40))
+	chain.addBlocks(10, fakeSpendTx(voters[2], vote2))
+	assert.Equal(t, []string{candidates[0]}, election.GetProducersAtHeight(50))
+
+	//epochs elected earlier are not affected by later votes
+	assert.Equal(t, []string{candidates[1], candidates[2]}, election.GetProducersAtHeight(20))
+}
+
+func TestElection_Reorg(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidate := "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 1, NewDynastySchedule(nil, initialProducers))
+	chain.addBlocks(1)
+	chain.addBlocks(19, fakeVoteTx(account.NewAccount(), candidate, 10))
+	assert.Equal(t, []string{candidate}, election.GetProducersAtHeight(20))
+
+	//the block with the vote is replaced by a fork without votes
+	chain.blocks = chain.blocks[:1]
+	chain.addBlocks(19)
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(20))
+}
+
+func TestElection_Checkpoint(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidates := []string{"1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct", "dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB"}
+	voters := []*account.Account{account.NewAccount(), account.NewAccount()}
+
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 2, NewDynastySchedule(nil, initialProducers))
+	chain.addBlocks(1)
+	vote0 := fakeVoteTx(voters[0], candidates[0], 10)
+	chain.addBlocks(14, vote0)
+	chain.addBlocks(10, fakeVoteTx(voters[1], candidates[1], 20))
+
+	checkpoint, err := election.GetCheckpoint(24)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 25, checkpoint.GetHeight())
+	assert.Equal(t, chain.blocks[24].GetHash(), checkpoint.GetHash())
+	db := storage.NewRamStorage()
+	assert.Nil(t, checkpoint.Save(db))
+	checkpoint, err = LoadElectionCheckpoint(db)
+	assert.Nil(t, err)
+
+	//the election resumes from the checkpoint without the blocks below it
+	chain.prunedHeight = 24
+	chain.addBlocks(15, fakeSpendTx(voters[0], vote0))
+	resumed := NewElection(chain, 10, 2, NewDynastySchedule(nil, initialProducers))
+	resumed.SetCheckpoint(checkpoint)
+	assert.Equal(t, []string{candidates[0]}, resumed.GetProducersAtHeight(20))
+	assert.Equal(t, []string{candidates[1], candidates[0]}, resumed.GetProducersAtHeight(30))
+	assert.Equal(t, []string{candidates[1]}, resumed.GetProducersAtHeight(40))
+
+	chain.prunedHeight = 0
+	assert.Equal(t, election.GetProducersAtHeight(40), resumed.GetProducersAtHeight(40))
+}
+
+func TestElection_SaveCheckpoint(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidate := "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 1, NewDynastySchedule(nil, initialProducers))
+	chain.addBlocks(1)
+	chain.addBlocks(24, fakeVoteTx(account.NewAccount(), candidate, 10))
+
+	db := storage.NewRamStorage()
+	assert.Nil(t, election.SaveCheckpoint(db, 14))
+	checkpoint, err := LoadElectionCheckpoint(db)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 15, checkpoint.GetHeight())
+
+	//the bodies of the blocks in the checkpoint are not needed any more, also after a restart
+	chain.prunedHeight = 15
+	assert.Equal(t, []string{candidate}, election.GetProducersAtHeight(30))
+	resumed := NewElection(chain, 10, 1, NewDynastySchedule(nil, initialProducers))
+	resumed.SetCheckpoint(checkpoint)
+	assert.Equal(t, []string{candidate}, resumed.GetProducersAtHeight(30))
+
+	//the votes below the checkpoint cannot be tallied again
+	_, err = resumed.HasVotes(10)
+	assert.Equal(t, ErrTallyBelowCheckpoint, err)
+}
+
+func TestElectionCheckpoint_VoteHeightsAndStakes(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidate := "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+	voters := []*account.Account{account.NewAccount(), account.NewAccount()}
+
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 1, NewDynastySchedule(nil, initialProducers))
+	chain.addBlocks(3)
+	vote0 := fakeVoteTx(voters[0], candidate, 10)
+	chain.addBlocks(4, vote0)
+	vote1 := fakeVoteTx(voters[1], candidate, 20)
+	chain.addBlocks(4, vote1)
+	chain.addBlocks(4, fakeSpendTx(voters[0], vote0))
+
+	checkpoint, err := election.GetCheckpoint(14)
+	assert.Nil(t, err)
+	assert.Equal(t, []uint64{3, 7, 11}, checkpoint.GetVoteHeights())
+
+	//the votes are tallied again from the blocks at the vote heights alone
+	sparse := &fakeChain{}
+	for _, blk := range chain.blocks {
+		sparse.blocks = append(sparse.blocks, block.NewHeaderOnlyBlock(lblock.NewSignedHeader(blk, nil)))
+	}
+	for _, height := range checkpoint.GetVoteHeights() {
+		sparse.blocks[height] = chain.blocks[height]
+	}
+	retallied, err := NewElection(sparse, 10, 1, NewDynastySchedule(nil, initialProducers)).GetCheckpoint(14)
+	assert.Nil(t, err)
+	assert.Equal(t, checkpoint.votes, retallied.votes)
+	assert.Equal(t, checkpoint.GetVoteHeights(), retallied.GetVoteHeights())
+
+	//only the stake of the standing vote has to be unspent
+	stake := &utxo.UTXO{TXOutput: vote1.Vout[0], Txid: vote1.ID, TxIndex: 0, UtxoType: utxo.UtxoNormal}
+	assert.Nil(t, checkpoint.VerifyStakes([]*utxo.UTXO{stake}))
+	assert.Equal(t, ErrStakeNotFound, checkpoint.VerifyStakes(nil))
+	stake.Value = common.NewAmount(1)
+	assert.Equal(t, ErrStakeNotFound, checkpoint.VerifyStakes([]*utxo.UTXO{stake}))
+}
+
+func TestElection_EmptyTallyFallsBackToSchedule(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 1, NewDynastySchedule(nil, initialProducers))
+
+	//the tally window is not on chain yet
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(20))
+
+	chain.addBlocks(30)
+	producers := election.GetProducersAtHeight(25)
+	assert.Equal(t, initialProducers, producers)
+
+	//the returned list is a copy
+	producers[0] = "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(25))
+
+	//scheduled changes apply while nobody is elected
+	scheduledProducers := []string{"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7"}
+	assert.Nil(t, election.schedule.AddChange(&DynastyChange{Height: 28, Producers: scheduledProducers}))
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(27))
+	assert.Equal(t, scheduledProducers, election.GetProducersAtHeight(28))
+}
+
+func TestDPOS_getDynastyAtHeight(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidate := "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+	chain := &fakeChain{}
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty(initialProducers, 1, defaultTimeBetweenBlk))
+	assert.Equal(t, dpos.GetDynasty(), dpos.getDynastyAtHeight(20))
+
+	dpos.SetChain(chain)
+	dpos.SetElection(NewElection(chain, 10, 1, NewDynastySchedule(nil, initialProducers)))
+	chain.addBlocks(10, fakeVoteTx(account.NewAccount(), candidate, 10))
+	chain.addBlocks(9)
+	assert.Equal(t, []string{candidate}, dpos.getDynastyAtHeight(20).GetProducers())
+	assert.Equal(t, candidate, dpos.getDynastyAtHeight(20).ProducerAtATime(0))
+
+	dpos.updateDynasty()
+	assert.Equal(t, initialProducers, dpos.GetProducers())
+	chain.addBlocks(1)
+	dpos.updateDynasty()
+	assert.Equal(t, []string{candidate}, dpos.GetProducers())
+}
+
+func TestElection_SlashDoubleMintingProducer(t *testing.T) {
+	initialProducers := []string{producerAddr, "121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidate := "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+	evidence := lblock.NewEvidence(
+		fakeSignedBlock(t, 10, 50, producerAddr, producerKey),
+		fakeSignedBlock(t, 10, 51, producerAddr, producerKey),
+		nil,
+	)
+	evidenceTx, err := ltransaction.NewEvidenceTx(evidence)
+	assert.Nil(t, err)
+
+	//the slashed producer is not elected regardless of its votes
+	chain := &fakeChain{}
+	election := NewElection(chain, 10, 2, NewDynastySchedule(nil, initialProducers))
+	chain.addBlocks(1)
+	chain.addBlocks(4, fakeVoteTx(account.NewAccount(), producerAddr, 30), fakeVoteTx(account.NewAccount(), candidate, 10))
+	chain.addBlocks(5, &evidenceTx)
+	chain.addBlocks(10)
+	assert.Equal(t, []string{candidate}, election.GetProducersAtHeight(20))
+
+	//the slashed producer is removed from the scheduled dynasty
+	chain = &fakeChain{}
+	election = NewElection(chain, 10, 2, NewDynastySchedule(nil, initialProducers))
+	chain.addBlocks(1)
+	chain.addBlocks(19, &evidenceTx)
+	assert.Equal(t, initialProducers, election.GetProducersAtHeight(19))
+	assert.Equal(t, initialProducers[1:], election.GetProducersAtHeight(20))
+}
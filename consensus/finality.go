@@ -0,0 +1,235 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"encoding/binary"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/consensus/blocksigner"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/hashicorp/golang-lru"
+	logger "github.com/sirupsen/logrus"
+)
+
+const preCommitCacheSize = 256
+
+var lastPreCommitHeightKey = []byte("lastPreCommitHeight")
+
+var (
+	ErrPreCommitNotProducer = errors.New("precommit: signer is not a producer of the dynasty at the block height")
+)
+
+// FinalizedChain is the main chain whose blocks are made irreversible by finality certificates
+type FinalizedChain interface {
+	ChainReader
+	GetLIBHeight() uint64
+	Finalize(cert *block.FinalityCertificate) error
+}
+
+// preCommitSet collects the precommit signatures of a block
+type preCommitSet struct {
+	height     uint64
+	signatures map[string]hash.Hash
+	finalized  bool
+}
+
+// FinalityGadget finalizes blocks explicitly. The producers sign a precommit for every new block on their main chain and
+// gossip it. A block becomes irreversible once the precommits of the quorum of its dynasty are collected, and the
+// signatures are kept by the chain as the finality certificate of the block. The quorum is more than 2/3 of the
+// dynasty, or more than the quorum ratio if it is higher.
+type FinalityGadget struct {
+	chain            FinalizedChain
+	getDynasty       func(height uint64) *Dynasty
+	producerAddr     string
+	signer           blocksigner.BlockSigner
+	db               storage.Storage
+	lastSignedHeight uint64
+	preCommits       *lru.Cache
+	preCommitHandler func(preCommit *block.PreCommit)
+	quorumRatio      float64
+	mutex            sync.Mutex
+}
+
+//NewFinalityGadget returns a finality gadget that looks up the dynasty in charge of each block with getDynasty. The
+//height of the last precommit of the local producer is kept in the database, so that the producer does not precommit
+//another block at the same height after a restart
+func NewFinalityGadget(chain FinalizedChain, getDynasty func(height uint64) *Dynasty, db storage.Storage) *FinalityGadget {
+	preCommits, err := lru.New(preCommitCacheSize)
+	if err != nil {
+		logger.Panic(err)
+	}
+	gadget := &FinalityGadget{
+		chain:      chain,
+		getDynasty: getDynasty,
+		db:         db,
+		preCommits: preCommits,
+	}
+	if rawBytes, err := db.Get(lastPreCommitHeightKey); err == nil && len(rawBytes) == 8 {
+		gadget.lastSignedHeight = binary.BigEndian.Uint64(rawBytes)
+	}
+	return gadget
+}
+
+//SetProducer sets the local producer and the signer of its precommits
+func (gadget *FinalityGadget) SetProducer(producerAddr string, signer blocksigner.BlockSigner) {
+	gadget.producerAddr = producerAddr
+	gadget.signer = signer
+}
+
+//SetQuorumRatio sets the share of the dynasty whose precommits finalize a block. It cannot lower the quorum below
+//more than 2/3 of the dynasty
+func (gadget *FinalityGadget) SetQuorumRatio(ratio float64) {
+	gadget.quorumRatio = ratio
+}
+
+//SetPreCommitHandler sets the function that is called with every precommit signed by the local producer
+func (gadget *FinalityGadget) SetPreCommitHandler(handler func(preCommit *block.PreCommit)) {
+	gadget.preCommitHandler = handler
+}
+
+//OnBlockAdded signs a precommit for the new tail block if the local producer is in charge of it. Blocks older than
+//a dynasty cycle are not signed, so that a synchronizing node does not vote on history
+func (gadget *FinalityGadget) OnBlockAdded(blk *block.Block) {
+	gadget.mutex.Lock()
+	set, ok := gadget.preCommits.Get(blk.GetHash().String())
+	gadget.mutex.Unlock()
+	if ok {
+		//the precommits may arrive before the block
+		gadget.tryFinalize(blk.GetHash(), set.(*preCommitSet))
+	}
+
+	if gadget.signer == nil || blk.GetHeight() == 0 {
+		return
+	}
+	dynasty := gadget.getDynasty(blk.GetHeight())
+	if dynasty.GetProducerIndex(gadget.producerAddr) < 0 {
+		return
+	}
+	if time.Now().Unix()-blk.GetTimestamp() > int64(dynasty.GetDynastyTime()) {
+		return
+	}
+
+	gadget.mutex.Lock()
+	//a producer never precommits two blocks at the same height
+	if blk.GetHeight() <= gadget.lastSignedHeight {
+		gadget.mutex.Unlock()
+		return
+	}
+	//the height is saved before the precommit is signed, so that a crash never leaves a signed height unrecorded
+	if err := gadget.db.Put(lastPreCommitHeightKey, util.UintToHex(blk.GetHeight())); err != nil {
+		gadget.mutex.Unlock()
+		logger.WithError(err).Warn("FinalityGadget: failed to save the height of the precommit.")
+		return
+	}
+	gadget.lastSignedHeight = blk.GetHeight()
+	gadget.mutex.Unlock()
+
+	preCommit, err := gadget.signer.SignPreCommit(blk.GetHash(), blk.GetHeight())
+	if err != nil {
+		logger.WithError(err).Warn("FinalityGadget: failed to sign the precommit.")
+		return
+	}
+	if _, err := gadget.AddPreCommit(preCommit); err != nil {
+		logger.WithError(err).Warn("FinalityGadget: failed to add the local precommit.")
+		return
+	}
+	if gadget.preCommitHandler != nil {
+		gadget.preCommitHandler(preCommit)
+	}
+}
+
+//AddPreCommit verifies and collects the precommit, and finalizes its block once the quorum is reached.
+//It returns false if the precommit is already collected
+func (gadget *FinalityGadget) AddPreCommit(preCommit *block.PreCommit) (bool, error) {
+	if preCommit.Height == 0 || preCommit.Height <= gadget.chain.GetLIBHeight() {
+		return false, nil
+	}
+
+	signer, err := preCommit.GetSigner()
+	if err != nil {
+		return false, err
+	}
+	if gadget.getDynasty(preCommit.Height).GetProducerIndex(signer) < 0 {
+		return false, ErrPreCommitNotProducer
+	}
+
+	gadget.mutex.Lock()
+	key := preCommit.BlockHash.String()
+	var set *preCommitSet
+	if value, ok := gadget.preCommits.Get(key); ok {
+		set = value.(*preCommitSet)
+	} else {
+		set = &preCommitSet{height: preCommit.Height, signatures: make(map[string]hash.Hash)}
+		gadget.preCommits.Add(key, set)
+	}
+	if _, ok := set.signatures[signer]; ok || set.height != preCommit.Height {
+		gadget.mutex.Unlock()
+		return false, nil
+	}
+	set.signatures[signer] = preCommit.Signature
+	gadget.mutex.Unlock()
+
+	gadget.tryFinalize(preCommit.BlockHash, set)
+	return true, nil
+}
+
+//tryFinalize finalizes the block if the precommits reach the quorum of its dynasty
+func (gadget *FinalityGadget) tryFinalize(blockHash hash.Hash, set *preCommitSet) {
+	numOfProducers := len(gadget.getDynasty(set.height).GetProducers())
+
+	gadget.mutex.Lock()
+	if set.finalized || !block.IsFinalityQuorum(len(set.signatures), numOfProducers, gadget.quorumRatio) {
+		gadget.mutex.Unlock()
+		return
+	}
+	signers := []string{}
+	for signer := range set.signatures {
+		signers = append(signers, signer)
+	}
+	sort.Strings(signers)
+	cert := &block.FinalityCertificate{BlockHash: blockHash, Height: set.height}
+	for _, signer := range signers {
+		cert.Signatures = append(cert.Signatures, set.signatures[signer])
+	}
+	gadget.mutex.Unlock()
+
+	//the block may not be on the main chain yet
+	if err := gadget.chain.Finalize(cert); err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height": set.height,
+			"hash":   blockHash.String(),
+		}).Debug("FinalityGadget: cannot finalize the block.")
+		return
+	}
+
+	gadget.mutex.Lock()
+	set.finalized = true
+	gadget.mutex.Unlock()
+	logger.WithFields(logger.Fields{
+		"height": set.height,
+		"hash":   blockHash.String(),
+	}).Info("FinalityGadget: finalized the block.")
+}
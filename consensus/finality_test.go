@@ -0,0 +1,159 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+var finalityProducers = []struct {
+	address string
+	key     string
+}{
+	{producerAddr, producerKey},
+	{"dQEooMsqp23RkPsvZXj3XbsRh9BUyGz2S9", "bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa7e"},
+	{"dastXXWLe5pxbRYFhcyUq8T3wb5srWkHKa", "300c0338c4b0d49edc66113e3584e04c6b907f9ded711d396d522aae6a79be1a"},
+}
+
+type fakeFinalizedChain struct {
+	fakeChain
+	lib   uint64
+	certs []*block.FinalityCertificate
+}
+
+func (chain *fakeFinalizedChain) GetLIBHeight() uint64 {
+	return chain.lib
+}
+
+func (chain *fakeFinalizedChain) Finalize(cert *block.FinalityCertificate) error {
+	blk, err := chain.GetBlockByHeight(cert.Height)
+	if err != nil || !blk.GetHash().Equals(cert.BlockHash) {
+		return errors.New("block is not on chain")
+	}
+	chain.certs = append(chain.certs, cert)
+	chain.lib = cert.Height
+	return nil
+}
+
+func TestFinalityGadget_Finalize(t *testing.T) {
+	producers := []string{}
+	for _, producer := range finalityProducers {
+		producers = append(producers, producer.address)
+	}
+	dynasty := NewDynasty(producers, len(producers), defaultTimeBetweenBlk)
+	chain := &fakeFinalizedChain{}
+	chain.addBlocks(2)
+
+	db := storage.NewRamStorage()
+	gadget := NewFinalityGadget(chain, func(height uint64) *Dynasty { return dynasty }, db)
+	gadget.SetProducer(finalityProducers[0].address, newTestSigner(t, finalityProducers[0].key))
+	broadcasted := []*block.PreCommit{}
+	gadget.SetPreCommitHandler(func(preCommit *block.PreCommit) {
+		broadcasted = append(broadcasted, preCommit)
+	})
+
+	blk := chain.blocks[1]
+	newPreCommit := func(index int, blk *block.Block) *block.PreCommit {
+		preCommit, err := block.NewPreCommit(blk.GetHash(), blk.GetHeight(), finalityProducers[index].key)
+		assert.Nil(t, err)
+		return preCommit
+	}
+
+	//the local producer precommits the new block only once
+	gadget.OnBlockAdded(blk)
+	gadget.OnBlockAdded(blk)
+	assert.Equal(t, 1, len(broadcasted))
+	assert.Equal(t, blk.GetHash(), broadcasted[0].BlockHash)
+
+	//nor after a restart
+	restarted := NewFinalityGadget(chain, func(height uint64) *Dynasty { return dynasty }, db)
+	restarted.SetProducer(finalityProducers[0].address, newTestSigner(t, finalityProducers[0].key))
+	restarted.SetPreCommitHandler(func(preCommit *block.PreCommit) {
+		broadcasted = append(broadcasted, preCommit)
+	})
+	restarted.OnBlockAdded(blk)
+	assert.Equal(t, 1, len(broadcasted))
+
+	isNew, err := gadget.AddPreCommit(newPreCommit(1, blk))
+	assert.Nil(t, err)
+	assert.True(t, isNew)
+	isNew, err = gadget.AddPreCommit(newPreCommit(1, blk))
+	assert.Nil(t, err)
+	assert.False(t, isNew)
+	//2 of 3 producers is not enough
+	assert.Equal(t, 0, len(chain.certs))
+
+	_, err = gadget.AddPreCommit(newPreCommit(2, blk))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), chain.GetLIBHeight())
+	assert.Equal(t, 1, len(chain.certs))
+	assert.Nil(t, chain.certs[0].Verify(producers, 0))
+
+	//precommits below the irreversible block are ignored
+	isNew, err = gadget.AddPreCommit(newPreCommit(0, chain.blocks[1]))
+	assert.Nil(t, err)
+	assert.False(t, isNew)
+
+	_, err = gadget.AddPreCommit(&block.PreCommit{BlockHash: blk.GetHash(), Height: 2, Signature: broadcasted[0].Signature})
+	assert.NotNil(t, err)
+}
+
+func TestFinalityGadget_PreCommitsBeforeBlock(t *testing.T) {
+	producers := []string{}
+	for _, producer := range finalityProducers {
+		producers = append(producers, producer.address)
+	}
+	dynasty := NewDynasty(producers, len(producers), defaultTimeBetweenBlk)
+	chain := &fakeFinalizedChain{}
+	chain.addBlocks(3)
+	blk := chain.blocks[2]
+	chain.blocks = chain.blocks[:2]
+
+	gadget := NewFinalityGadget(chain, func(height uint64) *Dynasty { return dynasty }, storage.NewRamStorage())
+	for _, producer := range finalityProducers {
+		preCommit, err := block.NewPreCommit(blk.GetHash(), blk.GetHeight(), producer.key)
+		assert.Nil(t, err)
+		_, err = gadget.AddPreCommit(preCommit)
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, uint64(0), chain.GetLIBHeight())
+
+	//the block is finalized once it is added to the chain
+	chain.blocks = append(chain.blocks, blk)
+	gadget.OnBlockAdded(blk)
+	assert.Equal(t, uint64(2), chain.GetLIBHeight())
+}
+
+func TestFinalityGadget_RejectNonProducer(t *testing.T) {
+	dynasty := NewDynasty([]string{finalityProducers[0].address}, 1, defaultTimeBetweenBlk)
+	chain := &fakeFinalizedChain{}
+	chain.addBlocks(2)
+	gadget := NewFinalityGadget(chain, func(height uint64) *Dynasty { return dynasty }, storage.NewRamStorage())
+
+	preCommit, err := block.NewPreCommit(chain.blocks[1].GetHash(), 1, finalityProducers[1].key)
+	assert.Nil(t, err)
+	_, err = gadget.AddPreCommit(preCommit)
+	assert.Equal(t, ErrPreCommitNotProducer, err)
+	assert.Equal(t, uint64(0), chain.GetLIBHeight())
+}
@@ -0,0 +1,85 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"errors"
+
+	"github.com/dappley/go-dappley/core/block"
+)
+
+var (
+	ErrForkParentNotOnChain = errors.New("fork: the parent of the fork is not on the chain")
+	ErrForkNotLinked        = errors.New("fork: the block is not the child of the block below it in the fork")
+	ErrForkBlockNotFound    = errors.New("fork: the block is not found on the fork")
+)
+
+// forkChain is the main chain up to the parent of a fork followed by the blocks of the fork. The blocks of the fork are
+// checked on it against the dynasties that are elected and shuffled by their ancestors on the fork
+type forkChain struct {
+	chain        ChainReader
+	parentHeight uint64
+	blocks       map[uint64]*block.Block
+	maxHeight    uint64
+}
+
+//newForkChain returns the chain that continues the main chain with the fork blocks, which are ordered from the fork
+//head down to the child of the fork parent. The fork parent has to be on the main chain
+func newForkChain(chain ChainReader, forkBlks []*block.Block) (*forkChain, error) {
+	first := forkBlks[len(forkBlks)-1]
+	if first.GetHeight() == 0 {
+		return nil, ErrForkParentNotOnChain
+	}
+	parent, err := chain.GetBlockByHeight(first.GetHeight() - 1)
+	if err != nil || !parent.GetHash().Equals(first.GetPrevHash()) {
+		return nil, ErrForkParentNotOnChain
+	}
+
+	fork := &forkChain{
+		chain:        chain,
+		parentHeight: parent.GetHeight(),
+		blocks:       make(map[uint64]*block.Block),
+		maxHeight:    forkBlks[0].GetHeight(),
+	}
+	prevBlk := parent
+	for i := len(forkBlks) - 1; i >= 0; i-- {
+		blk := forkBlks[i]
+		if blk.GetHeight() != prevBlk.GetHeight()+1 || !blk.GetPrevHash().Equals(prevBlk.GetHash()) {
+			return nil, ErrForkNotLinked
+		}
+		fork.blocks[blk.GetHeight()] = blk
+		prevBlk = blk
+	}
+	return fork, nil
+}
+
+func (fork *forkChain) GetMaxHeight() uint64 {
+	return fork.maxHeight
+}
+
+func (fork *forkChain) GetBlockByHeight(height uint64) (*block.Block, error) {
+	if height <= fork.parentHeight {
+		return fork.chain.GetBlockByHeight(height)
+	}
+	blk, ok := fork.blocks[height]
+	if !ok {
+		return nil, ErrForkBlockNotFound
+	}
+	return blk, nil
+}
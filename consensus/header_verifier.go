@@ -0,0 +1,105 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"errors"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+)
+
+var (
+	ErrHeaderInvalidSignature = errors.New("header: cannot recover the signer from the signature")
+	ErrHeaderWrongProducer    = errors.New("header: the signer is not the producer in its time slot")
+	ErrHeaderNotInSlotStart   = errors.New("header: the header is not stamped at the start of its time slot")
+)
+
+// HeaderVerifier checks that block headers are signed by the producer in charge of their time slot without the
+// transactions of the blocks. The producers are elected by the votes in the blocks if an election is set, whose chain
+// has to provide the blocks with their transactions. Otherwise the chain only has to provide the headers.
+type HeaderVerifier struct {
+	dynasty  *Dynasty
+	schedule *DynastySchedule
+	election *Election
+	shuffler *SlotShuffler
+	forks    *block.Forks
+}
+
+//NewHeaderVerifier returns a verifier of the headers on top of the input chain. The producers are taken from the
+//schedule if it is set and from the dynasty otherwise
+func NewHeaderVerifier(dynasty *Dynasty, schedule *DynastySchedule, chain ChainReader) *HeaderVerifier {
+	return &HeaderVerifier{
+		dynasty:  dynasty,
+		schedule: schedule,
+		shuffler: NewSlotShuffler(chain),
+	}
+}
+
+//SetForks sets the heights from which the rules added after the launch of the network are enforced
+func (verifier *HeaderVerifier) SetForks(forks *block.Forks) {
+	verifier.forks = forks
+	verifier.shuffler.SetForks(forks)
+}
+
+//SetElection sets the election that derives the producers of each epoch from the votes on chain
+func (verifier *HeaderVerifier) SetElection(election *Election) {
+	verifier.election = election
+}
+
+//Verify checks the signature, the producer and the VRF proof of the header. The ancestors of the header have to be on
+//the chain of the verifier
+func (verifier *HeaderVerifier) Verify(header *block.SignedHeader) error {
+	dynasty := verifier.getDynastyAtHeight(header.Height)
+
+	timeBetweenBlk := int64(dynasty.GetTimeBetweenBlk())
+	if verifier.forks.IsSlotTimestampActive(header.Height) && timeBetweenBlk > 0 && header.Timestamp%timeBetweenBlk != 0 {
+		return ErrHeaderNotInSlotStart
+	}
+
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(header.Hash(), header.Signature)
+	if err != nil {
+		return ErrHeaderInvalidSignature
+	}
+	if ok, _ := account.IsValidPubKey(pubkey[1:]); !ok {
+		return ErrHeaderInvalidSignature
+	}
+
+	signer := account.NewTransactionAccountByPubKey(pubkey[1:]).GetAddress().String()
+	if signer != dynasty.ProducerAtATime(header.Timestamp) {
+		return ErrHeaderWrongProducer
+	}
+
+	return verifier.shuffler.VerifyBlock(block.NewHeaderOnlyBlock(header), dynasty.GetDynastyTime(), pubkey)
+}
+
+//getDynastyAtHeight returns the dynasty that is in charge of producing the block at the input height
+func (verifier *HeaderVerifier) getDynastyAtHeight(height uint64) *Dynasty {
+	var producers []string
+	switch {
+	case verifier.election != nil:
+		producers = verifier.election.GetProducersAtHeight(height)
+	case verifier.schedule != nil:
+		producers = verifier.schedule.GetProducersAtHeight(height)
+	default:
+		producers = verifier.dynasty.GetProducers()
+	}
+	return verifier.shuffler.ShuffleDynasty(NewDynasty(producers, verifier.dynasty.maxProducers, verifier.dynasty.timeBetweenBlk), height)
+}
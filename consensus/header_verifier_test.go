@@ -0,0 +1,127 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderVerifier_Verify(t *testing.T) {
+	dpos, chain, keys := newShuffledDPOS()
+	numOfProducers := int64(len(dpos.GetProducers()))
+
+	//the verifier only sees the headers of the blocks
+	headerChain := &fakeChain{blocks: []*block.Block{block.NewHeaderOnlyBlock(lblock.NewSignedHeader(chain.blocks[0], nil))}}
+	verifier := NewHeaderVerifier(dpos.GetDynasty(), nil, headerChain)
+
+	for slot := numOfProducers; slot < 4*numOfProducers; slot++ {
+		timestamp := slot * defaultTimeBetweenBlk
+		producer := dpos.getDynastyAtHeight(chain.GetMaxHeight() + 1).ProducerAtATime(timestamp)
+		blk := newSlotBlock(t, dpos, chain, slot, producer, keys[producer])
+		header := lblock.NewSignedHeader(blk, nil)
+		assert.Nil(t, verifier.Verify(header))
+
+		chain.blocks = append(chain.blocks, blk)
+		headerChain.blocks = append(headerChain.blocks, block.NewHeaderOnlyBlock(header))
+	}
+
+	slot := 4 * numOfProducers
+	timestamp := slot * defaultTimeBetweenBlk
+	producer := dpos.getDynastyAtHeight(chain.GetMaxHeight() + 1).ProducerAtATime(timestamp)
+	header := lblock.NewSignedHeader(newSlotBlock(t, dpos, chain, slot, producer, keys[producer]), nil)
+
+	//a header signed by another producer is rejected
+	for other, key := range keys {
+		if other != producer {
+			otherHeader := lblock.NewSignedHeader(newSlotBlock(t, dpos, chain, slot, other, key), nil)
+			assert.Equal(t, ErrHeaderWrongProducer, verifier.Verify(otherHeader))
+			break
+		}
+	}
+
+	//a tampered header does not recover the signer
+	tampered := *header
+	tampered.StateRoot = []byte("fakeroot")
+	assert.NotNil(t, verifier.Verify(&tampered))
+
+	tampered = *header
+	tampered.Timestamp++
+	assert.Equal(t, ErrHeaderNotInSlotStart, verifier.Verify(&tampered))
+
+	tampered = *header
+	tampered.Signature = []byte("fakesignature")
+	assert.Equal(t, ErrHeaderInvalidSignature, verifier.Verify(&tampered))
+}
+
+func TestDPOS_VerifyFork(t *testing.T) {
+	dpos, chain, keys := newShuffledDPOS()
+	numOfProducers := int64(len(dpos.GetProducers()))
+
+	//the fork is produced over several rounds, whose slot orders are shuffled by the blocks of the fork
+	fork := &fakeChain{blocks: append([]*block.Block{}, chain.blocks...)}
+	forkDpos := NewDPOS(nil)
+	forkDpos.SetDynasty(dpos.GetDynasty())
+	forkDpos.SetChain(fork)
+	for slot := numOfProducers; slot < 4*numOfProducers; slot++ {
+		timestamp := slot * defaultTimeBetweenBlk
+		producer := forkDpos.getDynastyAtHeight(fork.GetMaxHeight() + 1).ProducerAtATime(timestamp)
+		fork.blocks = append(fork.blocks, newSlotBlock(t, forkDpos, fork, slot, producer, keys[producer]))
+	}
+	var forkBlks []*block.Block
+	for i := len(fork.blocks) - 1; i > 0; i-- {
+		forkBlks = append(forkBlks, fork.blocks[i])
+	}
+	assert.Nil(t, dpos.VerifyFork(forkBlks))
+
+	//a fork block signed by another producer than the one of its slot is rejected
+	slot := 4 * numOfProducers
+	producer := forkDpos.getDynastyAtHeight(fork.GetMaxHeight() + 1).ProducerAtATime(slot * defaultTimeBetweenBlk)
+	for other, key := range keys {
+		if other != producer {
+			otherBlk := newSlotBlock(t, forkDpos, fork, slot, other, key)
+			assert.Equal(t, ErrHeaderWrongProducer, dpos.VerifyFork(append([]*block.Block{otherBlk}, forkBlks...)))
+			break
+		}
+	}
+
+	assert.Equal(t, ErrForkParentNotOnChain, dpos.VerifyFork(forkBlks[:len(forkBlks)-1]))
+	assert.Equal(t, ErrForkNotLinked, dpos.VerifyFork(append([]*block.Block{forkBlks[1]}, forkBlks...)))
+}
+
+func TestHeaderVerifier_getDynastyAtHeight(t *testing.T) {
+	initialProducers := []string{"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"}
+	candidate := "1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct"
+	chain := &fakeChain{}
+	schedule := NewDynastySchedule(nil, initialProducers)
+	verifier := NewHeaderVerifier(NewDynasty(initialProducers, 1, defaultTimeBetweenBlk), schedule, chain)
+
+	chain.addBlocks(10, fakeVoteTx(account.NewAccount(), candidate, 10))
+	chain.addBlocks(9)
+	assert.Equal(t, initialProducers, verifier.getDynastyAtHeight(20).GetProducers())
+
+	//the votes on chain elect the producers once the election is set
+	verifier.SetElection(NewElection(chain, 10, 1, schedule))
+	assert.Equal(t, initialProducers, verifier.getDynastyAtHeight(19).GetProducers())
+	assert.Equal(t, []string{candidate}, verifier.getDynastyAtHeight(20).GetProducers())
+}
@@ -0,0 +1,150 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"time"
+
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/logic/lblock"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	DPOSConsensusType        = "dpos"
+	InstantSealConsensusType = "instant"
+)
+
+// InstantSeal is a single-node consensus for development. The local producer seals a block as soon as
+// transactions arrive or a block is requested, and only blocks signed by the local producer are accepted.
+type InstantSeal struct {
+	producer    *blockproducerinfo.BlockProducerInfo
+	producerKey string
+	sealCh      chan bool
+	stopCh      chan bool
+	forks       *block.Forks
+}
+
+//NewInstantSeal returns a new InstantSeal instance
+func NewInstantSeal(producer *blockproducerinfo.BlockProducerInfo) *InstantSeal {
+	return &InstantSeal{
+		producer: producer,
+		sealCh:   make(chan bool, 1),
+		stopCh:   make(chan bool, 1),
+	}
+}
+
+//SetKey sets the producer key
+func (is *InstantSeal) SetKey(key string) {
+	is.producerKey = key
+}
+
+//SetForks sets the heights from which the rules added after the launch of the network are enforced
+func (is *InstantSeal) SetForks(forks *block.Forks) {
+	is.forks = forks
+}
+
+//GetProducerAddress returns the local producer's address
+func (is *InstantSeal) GetProducerAddress() string {
+	return is.producer.Beneficiary()
+}
+
+//Seal requests a new block. Requests made while a block is pending are sealed in the same block
+func (is *InstantSeal) Seal() {
+	select {
+	case is.sealCh <- true:
+	default:
+	}
+}
+
+//OnNewTransaction requests a new block for the transaction added to the transaction pool
+func (is *InstantSeal) OnNewTransaction(tx *transaction.Transaction) {
+	is.Seal()
+}
+
+//Stop stops the current produce block process
+func (is *InstantSeal) Stop() {
+	logger.Info("InstantSeal stops...")
+	is.stopCh <- true
+}
+
+//ProduceBlock waits for a seal request and produces a block immediately
+func (is *InstantSeal) ProduceBlock(ProduceBlockFunc func(process func(*block.Block), deadline deadline.Deadline)) {
+	select {
+	case <-is.sealCh:
+		dl := deadline.NewDeadline(time.Now().UnixNano()/deadline.NanoSecsInMilliSec + defaultMaxMintingTimeInMs)
+		ProduceBlockFunc(is.hashAndSign, dl)
+	case <-is.stopCh:
+	}
+}
+
+//IsProducedLocally returns if the local producer produced the block
+func (is *InstantSeal) IsProducedLocally(blk *block.Block) bool {
+	if blk != nil {
+		return is.producer.Produced(blk)
+	}
+	return false
+}
+
+//hashAndSign signs the block
+func (is *InstantSeal) hashAndSign(blk *block.Block) {
+	blk.SetHash(lblock.CalculateHash(blk, is.forks))
+	if !lblock.SignBlock(blk, is.producerKey) {
+		logger.Warn("InstantSeal: failed to sign the new block.")
+	}
+}
+
+//Validate checks that the block is signed by the local producer
+func (is *InstantSeal) Validate(blk *block.Block) bool {
+	if blk == nil || blk.GetHash() == nil || blk.GetSign() == nil {
+		logger.Warn("InstantSeal: block is not signed!")
+		return false
+	}
+
+	signer, err := lblock.NewSignedHeader(blk, is.forks).GetSigner()
+	if err != nil {
+		logger.WithError(err).Warn("InstantSeal: cannot recover the signer of the block!")
+		return false
+	}
+	if signer != is.producer.Beneficiary() {
+		logger.WithFields(logger.Fields{
+			"signer": signer,
+		}).Warn("InstantSeal: the block is not signed by the local producer.")
+		return false
+	}
+	return true
+}
+
+//GetMinConfirmationNum returns the minimum number of producers required
+func (is *InstantSeal) GetMinConfirmationNum() int {
+	return 1
+}
+
+//IsBypassingLibCheck returns if LIB check should be skipped
+func (is *InstantSeal) IsBypassingLibCheck() bool {
+	return true
+}
+
+//GetTotalProducersNum returns the total number of producers
+func (is *InstantSeal) GetTotalProducersNum() int {
+	return 1
+}
@@ -0,0 +1,68 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common/deadline"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/blockproducerinfo"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstantSeal_Validate(t *testing.T) {
+	seal := NewInstantSeal(blockproducerinfo.NewBlockProducerInfo(producerAddr))
+	seal.SetKey(producerKey)
+
+	assert.True(t, seal.Validate(fakeSignedBlock(t, 1, 100, producerAddr, producerKey)))
+	//blocks in the same slot are accepted
+	assert.True(t, seal.Validate(fakeSignedBlock(t, 2, 100, producerAddr, producerKey)))
+	assert.False(t, seal.Validate(fakeSignedBlock(t, 3, 101, "1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		"bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa7e")))
+	assert.False(t, seal.Validate(FakeNewBlockWithTimestamp(100, nil, nil)))
+	assert.False(t, seal.Validate(nil))
+
+	assert.True(t, seal.IsBypassingLibCheck())
+	assert.Equal(t, 1, seal.GetMinConfirmationNum())
+}
+
+func TestInstantSeal_ProduceBlock(t *testing.T) {
+	seal := NewInstantSeal(blockproducerinfo.NewBlockProducerInfo(producerAddr))
+	seal.SetKey(producerKey)
+
+	produced := 0
+	produceBlockFunc := func(process func(*block.Block), dl deadline.Deadline) {
+		produced++
+		blk := FakeNewBlockWithTimestamp(100, nil, nil)
+		process(blk)
+		assert.True(t, seal.Validate(blk))
+	}
+
+	//requests made before the block is produced are sealed in the same block
+	seal.Seal()
+	seal.OnNewTransaction(nil)
+	seal.ProduceBlock(produceBlockFunc)
+	assert.Equal(t, 1, produced)
+
+	//no block is produced after the consensus stops
+	seal.Stop()
+	seal.ProduceBlock(produceBlockFunc)
+	assert.Equal(t, 1, produced)
+}
@@ -0,0 +1,221 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/block"
+)
+
+const (
+	DefaultLivenessWindow = 100
+	MaxLivenessWindow     = 10000
+)
+
+// ProducerStats is the number of slots a producer filled and missed within a window
+type ProducerStats struct {
+	Producer string
+	Produced uint64
+	Missed   uint64
+}
+
+// slotRecord is the outcome of a time slot on the main chain
+type slotRecord struct {
+	slot     int64
+	producer string
+	produced bool
+}
+
+// LivenessTracker compares the producer expected in every time slot with the blocks on the main chain and
+// records which producers filled or missed their slots. The records are updated from the chain on query and
+// rebuilt when the tracked blocks are no longer on the main chain.
+type LivenessTracker struct {
+	chain         ChainReader
+	getDynasty    func(height uint64) *Dynasty
+	records       []*slotRecord
+	trackedHeight uint64
+	trackedHash   hash.Hash
+	lastSlot      int64
+	mutex         sync.Mutex
+}
+
+//NewLivenessTracker returns a tracker that looks up the dynasty in charge of each block with getDynasty
+func NewLivenessTracker(chain ChainReader, getDynasty func(height uint64) *Dynasty) *LivenessTracker {
+	return &LivenessTracker{
+		chain:      chain,
+		getDynasty: getDynasty,
+	}
+}
+
+//GetUptime returns the percentage of the slots filled by the producer
+func (stats *ProducerStats) GetUptime() float64 {
+	total := stats.Produced + stats.Missed
+	if total == 0 {
+		return 0
+	}
+	return float64(stats.Produced) * 100 / float64(total)
+}
+
+//GetProducerStats returns the stats of every producer over the last window slots before the input time, ordered
+//by address. The slots elapsed since the tail block are counted as missed by their producers
+func (tracker *LivenessTracker) GetProducerStats(now int64, window uint64) []*ProducerStats {
+	if window == 0 {
+		window = DefaultLivenessWindow
+	}
+	if window > MaxLivenessWindow {
+		window = MaxLivenessWindow
+	}
+
+	tracker.mutex.Lock()
+	defer tracker.mutex.Unlock()
+	tracker.update()
+
+	stats := make(map[string]*ProducerStats)
+	getStats := func(producer string) *ProducerStats {
+		if _, ok := stats[producer]; !ok {
+			stats[producer] = &ProducerStats{Producer: producer}
+		}
+		return stats[producer]
+	}
+
+	dynasty := tracker.getDynasty(tracker.trackedHeight + 1)
+	for _, producer := range dynasty.GetProducers() {
+		getStats(producer)
+	}
+
+	timeBetweenBlk := int64(dynasty.timeBetweenBlk)
+	nowSlot := now / timeBetweenBlk
+	startSlot := nowSlot - int64(window)
+
+	for _, record := range tracker.records {
+		if record.slot < startSlot || record.slot >= nowSlot {
+			continue
+		}
+		if record.produced {
+			getStats(record.producer).Produced++
+		} else {
+			getStats(record.producer).Missed++
+		}
+	}
+
+	//the current slot may still be filled, so only the slots that have passed are missed
+	firstPendingSlot := tracker.lastSlot + 1
+	if firstPendingSlot < startSlot {
+		firstPendingSlot = startSlot
+	}
+	if tracker.trackedHash != nil {
+		for slot := firstPendingSlot; slot < nowSlot; slot++ {
+			if producer := dynasty.ProducerAtATime(slot * timeBetweenBlk); producer != "" {
+				getStats(producer).Missed++
+			}
+		}
+	}
+
+	result := []*ProducerStats{}
+	for _, producerStats := range stats {
+		result = append(result, producerStats)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Producer < result[j].Producer
+	})
+	return result
+}
+
+//update records the slots of the blocks added to the main chain since the last update
+func (tracker *LivenessTracker) update() {
+	if tracker.chain == nil {
+		return
+	}
+	tailHeight := tracker.chain.GetMaxHeight()
+	if tracker.trackedHash != nil {
+		blk, err := tracker.chain.GetBlockByHeight(tracker.trackedHeight)
+		if tracker.trackedHeight > tailHeight || err != nil || !blk.GetHash().Equals(tracker.trackedHash) {
+			tracker.reset()
+		}
+	}
+
+	height := tracker.trackedHeight + 1
+	if tracker.trackedHash == nil {
+		//every block fills at least one slot, so the last blocks cover the largest window
+		height = 0
+		if tailHeight > MaxLivenessWindow {
+			height = tailHeight - MaxLivenessWindow
+		}
+	}
+
+	for ; height <= tailHeight; height++ {
+		blk, err := tracker.chain.GetBlockByHeight(height)
+		if err != nil {
+			tracker.reset()
+			return
+		}
+		tracker.applyBlock(blk)
+	}
+	tracker.trim()
+}
+
+//applyBlock records the slots skipped before the block as missed and the slot of the block as produced
+func (tracker *LivenessTracker) applyBlock(blk *block.Block) {
+	isFirstBlock := tracker.trackedHash == nil
+	tracker.trackedHeight = blk.GetHeight()
+	tracker.trackedHash = blk.GetHash()
+
+	dynasty := tracker.getDynasty(blk.GetHeight())
+	timeBetweenBlk := int64(dynasty.timeBetweenBlk)
+	slot := blk.GetTimestamp() / timeBetweenBlk
+	lastSlot := tracker.lastSlot
+	tracker.lastSlot = slot
+
+	//the genesis block is not produced in a slot and the chain may start long after it
+	if blk.GetHeight() == 0 {
+		return
+	}
+	if !isFirstBlock && tracker.trackedHeight > 1 {
+		if lastSlot < slot-MaxLivenessWindow {
+			lastSlot = slot - MaxLivenessWindow
+		}
+		for missedSlot := lastSlot + 1; missedSlot < slot; missedSlot++ {
+			if producer := dynasty.ProducerAtATime(missedSlot * timeBetweenBlk); producer != "" {
+				tracker.records = append(tracker.records, &slotRecord{missedSlot, producer, false})
+			}
+		}
+	}
+	tracker.records = append(tracker.records, &slotRecord{slot, blk.GetProducer(), true})
+}
+
+//trim drops the records that are too old to fall into any window
+func (tracker *LivenessTracker) trim() {
+	index := sort.Search(len(tracker.records), func(i int) bool {
+		return tracker.records[i].slot > tracker.lastSlot-MaxLivenessWindow
+	})
+	if index > 0 {
+		tracker.records = append([]*slotRecord{}, tracker.records[index:]...)
+	}
+}
+
+//reset clears all records
+func (tracker *LivenessTracker) reset() {
+	tracker.records = nil
+	tracker.trackedHeight = 0
+	tracker.trackedHash = nil
+	tracker.lastSlot = 0
+}
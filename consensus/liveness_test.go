@@ -0,0 +1,117 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/stretchr/testify/assert"
+)
+
+var livenessProducers = []string{
+	"121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD",
+	"1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+	"1LCn8D5W7DLV1CbKE3buuJgNJjSeoBw2ct",
+}
+
+//newLivenessChain returns a chain with a block in each input slot produced by the producer in charge of the slot
+func newLivenessChain(dynasty *Dynasty, slots ...int64) *fakeChain {
+	genesis := block.NewBlockWithTimestamp(nil, nil, 0, "")
+	genesis.SetHeight(0)
+	genesis.SetHash(lblock.CalculateHash(genesis, nil))
+	chain := &fakeChain{blocks: []*block.Block{genesis}}
+	for _, slot := range slots {
+		chain.addSlotBlock(dynasty, slot)
+	}
+	return chain
+}
+
+func (chain *fakeChain) addSlotBlock(dynasty *Dynasty, slot int64) {
+	timestamp := slot * int64(dynasty.timeBetweenBlk)
+	parent := chain.blocks[len(chain.blocks)-1]
+	blk := block.NewBlockWithTimestamp(nil, parent, timestamp, dynasty.ProducerAtATime(timestamp))
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	chain.blocks = append(chain.blocks, blk)
+}
+
+func getStatsMap(statsList []*ProducerStats) map[string]ProducerStats {
+	result := make(map[string]ProducerStats)
+	for _, stats := range statsList {
+		result[stats.Producer] = *stats
+	}
+	return result
+}
+
+func TestLivenessTracker_GetProducerStats(t *testing.T) {
+	dynasty := NewDynasty(livenessProducers, len(livenessProducers), defaultTimeBetweenBlk)
+	tbb := int64(defaultTimeBetweenBlk)
+	//slots 1 and 2 before the first block are not counted, slots 5 and 7 are missed
+	chain := newLivenessChain(dynasty, 3, 4, 6, 8)
+	tracker := NewLivenessTracker(chain, func(height uint64) *Dynasty { return dynasty })
+
+	//the stats are ordered by address
+	assert.Equal(t, []*ProducerStats{
+		{livenessProducers[0], 2, 0},
+		{livenessProducers[2], 1, 1},
+		{livenessProducers[1], 1, 1},
+	}, tracker.GetProducerStats(9*tbb, 0))
+
+	//only the slots 6 to 8 fall into a window of 3 slots
+	statsMap := getStatsMap(tracker.GetProducerStats(9*tbb, 3))
+	assert.Equal(t, ProducerStats{livenessProducers[0], 1, 0}, statsMap[livenessProducers[0]])
+	assert.Equal(t, ProducerStats{livenessProducers[1], 0, 1}, statsMap[livenessProducers[1]])
+	assert.Equal(t, ProducerStats{livenessProducers[2], 1, 0}, statsMap[livenessProducers[2]])
+
+	//the slots passed since the tail block are missed, the current slot is still pending
+	statsMap = getStatsMap(tracker.GetProducerStats(11*tbb+1, 0))
+	assert.Equal(t, ProducerStats{livenessProducers[0], 2, 1}, statsMap[livenessProducers[0]])
+	assert.Equal(t, ProducerStats{livenessProducers[1], 1, 2}, statsMap[livenessProducers[1]])
+	assert.Equal(t, ProducerStats{livenessProducers[2], 1, 1}, statsMap[livenessProducers[2]])
+
+	//new blocks are picked up on query
+	chain.addSlotBlock(dynasty, 9)
+	statsMap = getStatsMap(tracker.GetProducerStats(10*tbb, 0))
+	assert.Equal(t, ProducerStats{livenessProducers[0], 3, 0}, statsMap[livenessProducers[0]])
+}
+
+func TestLivenessTracker_Reorg(t *testing.T) {
+	dynasty := NewDynasty(livenessProducers, len(livenessProducers), defaultTimeBetweenBlk)
+	tbb := int64(defaultTimeBetweenBlk)
+	chain := newLivenessChain(dynasty, 3, 4, 5)
+	tracker := NewLivenessTracker(chain, func(height uint64) *Dynasty { return dynasty })
+
+	statsMap := getStatsMap(tracker.GetProducerStats(6*tbb, 0))
+	assert.Equal(t, ProducerStats{livenessProducers[2], 1, 0}, statsMap[livenessProducers[2]])
+
+	//the block in slot 5 is replaced by a block in slot 7, which makes slots 5 and 6 missed
+	chain.blocks = chain.blocks[:len(chain.blocks)-1]
+	chain.addSlotBlock(dynasty, 7)
+	statsMap = getStatsMap(tracker.GetProducerStats(8*tbb, 0))
+	assert.Equal(t, ProducerStats{livenessProducers[0], 1, 1}, statsMap[livenessProducers[0]])
+	assert.Equal(t, ProducerStats{livenessProducers[1], 2, 0}, statsMap[livenessProducers[1]])
+	assert.Equal(t, ProducerStats{livenessProducers[2], 0, 1}, statsMap[livenessProducers[2]])
+}
+
+func TestProducerStats_GetUptime(t *testing.T) {
+	assert.Equal(t, float64(0), (&ProducerStats{}).GetUptime())
+	assert.Equal(t, float64(75), (&ProducerStats{Produced: 3, Missed: 1}).GetUptime())
+	assert.Equal(t, float64(100), (&ProducerStats{Produced: 2}).GetUptime())
+}
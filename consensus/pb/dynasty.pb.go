@@ -0,0 +1,224 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/consensus/pb/dynasty.proto
+
+package consensuspb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type DynastyChange struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height    uint64   `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Producers []string `protobuf:"bytes,2,rep,name=producers,proto3" json:"producers,omitempty"`
+}
+
+func (x *DynastyChange) Reset() {
+	*x = DynastyChange{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynastyChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynastyChange) ProtoMessage() {}
+
+func (x *DynastyChange) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynastyChange.ProtoReflect.Descriptor instead.
+func (*DynastyChange) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DynastyChange) GetHeight() uint64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *DynastyChange) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+type DynastySchedule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Changes []*DynastyChange `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+}
+
+func (x *DynastySchedule) Reset() {
+	*x = DynastySchedule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DynastySchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynastySchedule) ProtoMessage() {}
+
+func (x *DynastySchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynastySchedule.ProtoReflect.Descriptor instead.
+func (*DynastySchedule) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DynastySchedule) GetChanges() []*DynastyChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_consensus_pb_dynasty_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDesc = []byte{
+	0x0a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x79, 0x6e,
+	0x61, 0x73, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x6f, 0x6e, 0x73,
+	0x65, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x62, 0x22, 0x45, 0x0a, 0x0d, 0x44, 0x79, 0x6e, 0x61, 0x73,
+	0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x22, 0x47,
+	0x0a, 0x0f, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x12, 0x34, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x62,
+	0x2e, 0x44, 0x79, 0x6e, 0x61, 0x73, 0x74, 0x79, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescData = file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_goTypes = []interface{}{
+	(*DynastyChange)(nil),   // 0: consensuspb.DynastyChange
+	(*DynastySchedule)(nil), // 1: consensuspb.DynastySchedule
+}
+var file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_depIdxs = []int32{
+	0, // 0: consensuspb.DynastySchedule.changes:type_name -> consensuspb.DynastyChange
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_init() }
+func file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_init() {
+	if File_github_com_dappley_go_dappley_consensus_pb_dynasty_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynastyChange); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DynastySchedule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_consensus_pb_dynasty_proto = out.File
+	file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_consensus_pb_dynasty_proto_depIdxs = nil
+}
@@ -0,0 +1,367 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/consensus/pb/election.proto
+
+package consensuspb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Vote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Voter     string `protobuf:"bytes,1,opt,name=voter,proto3" json:"voter,omitempty"`
+	Candidate string `protobuf:"bytes,2,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Stake     []byte `protobuf:"bytes,3,opt,name=stake,proto3" json:"stake,omitempty"`
+	UtxoKey   string `protobuf:"bytes,4,opt,name=utxo_key,json=utxoKey,proto3" json:"utxo_key,omitempty"`
+}
+
+func (x *Vote) Reset() {
+	*x = Vote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Vote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vote) ProtoMessage() {}
+
+func (x *Vote) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vote.ProtoReflect.Descriptor instead.
+func (*Vote) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vote) GetVoter() string {
+	if x != nil {
+		return x.Voter
+	}
+	return ""
+}
+
+func (x *Vote) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
+	}
+	return ""
+}
+
+func (x *Vote) GetStake() []byte {
+	if x != nil {
+		return x.Stake
+	}
+	return nil
+}
+
+func (x *Vote) GetUtxoKey() string {
+	if x != nil {
+		return x.UtxoKey
+	}
+	return ""
+}
+
+type ElectedProducers struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Epoch     uint64   `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Producers []string `protobuf:"bytes,2,rep,name=producers,proto3" json:"producers,omitempty"`
+}
+
+func (x *ElectedProducers) Reset() {
+	*x = ElectedProducers{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectedProducers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectedProducers) ProtoMessage() {}
+
+func (x *ElectedProducers) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectedProducers.ProtoReflect.Descriptor instead.
+func (*ElectedProducers) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ElectedProducers) GetEpoch() uint64 {
+	if x != nil {
+		return x.Epoch
+	}
+	return 0
+}
+
+func (x *ElectedProducers) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+type ElectionCheckpoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TallyHeight uint64              `protobuf:"varint,1,opt,name=tally_height,json=tallyHeight,proto3" json:"tally_height,omitempty"`
+	TallyHash   []byte              `protobuf:"bytes,2,opt,name=tally_hash,json=tallyHash,proto3" json:"tally_hash,omitempty"`
+	Votes       []*Vote             `protobuf:"bytes,3,rep,name=votes,proto3" json:"votes,omitempty"`
+	Slashed     []string            `protobuf:"bytes,4,rep,name=slashed,proto3" json:"slashed,omitempty"`
+	Elected     []*ElectedProducers `protobuf:"bytes,5,rep,name=elected,proto3" json:"elected,omitempty"`
+	VoteHeights []uint64            `protobuf:"varint,6,rep,packed,name=vote_heights,json=voteHeights,proto3" json:"vote_heights,omitempty"`
+}
+
+func (x *ElectionCheckpoint) Reset() {
+	*x = ElectionCheckpoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElectionCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionCheckpoint) ProtoMessage() {}
+
+func (x *ElectionCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionCheckpoint.ProtoReflect.Descriptor instead.
+func (*ElectionCheckpoint) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ElectionCheckpoint) GetTallyHeight() uint64 {
+	if x != nil {
+		return x.TallyHeight
+	}
+	return 0
+}
+
+func (x *ElectionCheckpoint) GetTallyHash() []byte {
+	if x != nil {
+		return x.TallyHash
+	}
+	return nil
+}
+
+func (x *ElectionCheckpoint) GetVotes() []*Vote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *ElectionCheckpoint) GetSlashed() []string {
+	if x != nil {
+		return x.Slashed
+	}
+	return nil
+}
+
+func (x *ElectionCheckpoint) GetElected() []*ElectedProducers {
+	if x != nil {
+		return x.Elected
+	}
+	return nil
+}
+
+func (x *ElectionCheckpoint) GetVoteHeights() []uint64 {
+	if x != nil {
+		return x.VoteHeights
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_consensus_pb_election_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDesc = []byte{
+	0x0a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0b, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x62, 0x22, 0x6b, 0x0a, 0x04, 0x56, 0x6f, 0x74, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x6f, 0x74, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x05, 0x73, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x75, 0x74,
+	0x78, 0x6f, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x74,
+	0x78, 0x6f, 0x4b, 0x65, 0x79, 0x22, 0x46, 0x0a, 0x10, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x12,
+	0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x22, 0xf5, 0x01,
+	0x0a, 0x12, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x61, 0x6c, 0x6c, 0x79, 0x5f, 0x68, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x74, 0x61, 0x6c, 0x6c,
+	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x6c, 0x6c, 0x79,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x74, 0x61, 0x6c,
+	0x6c, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x6e, 0x73, 0x75,
+	0x73, 0x70, 0x62, 0x2e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x73, 0x6c, 0x61, 0x73, 0x68, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x07, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x63, 0x6f, 0x6e,
+	0x73, 0x65, 0x6e, 0x73, 0x75, 0x73, 0x70, 0x62, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x6f, 0x74, 0x65, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescData = file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_dappley_go_dappley_consensus_pb_election_proto_goTypes = []interface{}{
+	(*Vote)(nil),               // 0: consensuspb.Vote
+	(*ElectedProducers)(nil),   // 1: consensuspb.ElectedProducers
+	(*ElectionCheckpoint)(nil), // 2: consensuspb.ElectionCheckpoint
+}
+var file_github_com_dappley_go_dappley_consensus_pb_election_proto_depIdxs = []int32{
+	0, // 0: consensuspb.ElectionCheckpoint.votes:type_name -> consensuspb.Vote
+	1, // 1: consensuspb.ElectionCheckpoint.elected:type_name -> consensuspb.ElectedProducers
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_consensus_pb_election_proto_init() }
+func file_github_com_dappley_go_dappley_consensus_pb_election_proto_init() {
+	if File_github_com_dappley_go_dappley_consensus_pb_election_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Vote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectedProducers); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElectionCheckpoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_consensus_pb_election_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_consensus_pb_election_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_consensus_pb_election_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_consensus_pb_election_proto = out.File
+	file_github_com_dappley_go_dappley_consensus_pb_election_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_consensus_pb_election_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_consensus_pb_election_proto_depIdxs = nil
+}
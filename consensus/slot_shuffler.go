@@ -0,0 +1,198 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"strconv"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/consensus/blocksigner"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1/vrf/secp256k1VRF"
+	"github.com/dappley/go-dappley/util"
+	"github.com/hashicorp/golang-lru"
+	logger "github.com/sirupsen/logrus"
+)
+
+const roundSeedCacheSize = 64
+
+var vrfMessagePrefix = []byte("vrf")
+
+var (
+	ErrVRFProofMissing  = errors.New("vrf: block does not carry a vrf proof")
+	ErrParentNotOnChain = errors.New("vrf: the parent of the block is not on the chain")
+)
+
+// SlotShuffler randomizes the slot order of the producers in every dynasty round. Each block carries the VRF proof of
+// its producer for the round, and the VRF outputs committed in the blocks of a round seed the slot order of the next
+// round. The order of a round is thus unknown before the previous round is produced, and every node verifies it
+// from the chain. Blocks below the VRF height of the forks carry no proof and do not seed the slot order.
+type SlotShuffler struct {
+	chain ChainReader
+	forks *block.Forks
+	seeds *lru.Cache
+}
+
+//NewSlotShuffler returns a slot shuffler that reads the committed VRF outputs from the blocks on the input chain
+func NewSlotShuffler(chain ChainReader) *SlotShuffler {
+	seeds, err := lru.New(roundSeedCacheSize)
+	if err != nil {
+		logger.Panic(err)
+	}
+	return &SlotShuffler{chain: chain, seeds: seeds}
+}
+
+//SetForks sets the heights from which the blocks have to carry VRF proofs
+func (shuffler *SlotShuffler) SetForks(forks *block.Forks) {
+	shuffler.forks = forks
+}
+
+//IsParentOnChain returns true if the parent of the block is the block below it on the chain. The ancestors of the
+//block are then known and the slot order of the block is derived from them
+func (shuffler *SlotShuffler) IsParentOnChain(blk *block.Block) bool {
+	if shuffler.chain == nil || blk.GetHeight() == 0 {
+		return true
+	}
+	parent, err := shuffler.chain.GetBlockByHeight(blk.GetHeight() - 1)
+	return err == nil && parent.GetHash().Equals(blk.GetPrevHash())
+}
+
+//ShuffleDynasty returns a copy of the dynasty whose slot order in each round is seeded by the ancestors of the block
+//at the input height
+func (shuffler *SlotShuffler) ShuffleDynasty(dynasty *Dynasty, height uint64) *Dynasty {
+	shuffled := NewDynasty(dynasty.producers, dynasty.maxProducers, dynasty.timeBetweenBlk)
+	shuffled.roundSeed = func(round int64) hash.Hash {
+		return shuffler.GetRoundSeed(round, height, shuffled.dynastyTime)
+	}
+	return shuffled
+}
+
+//GetRoundSeed returns the hash of the VRF outputs committed in the previous round by the ancestors of the block at
+//the input height. It returns nil if the previous round has no VRF output, in which case the slot order is not shuffled
+func (shuffler *SlotShuffler) GetRoundSeed(round int64, height uint64, dynastyTime int) hash.Hash {
+	if shuffler.chain == nil || round <= 0 || height <= 1 || dynastyTime <= 0 {
+		return nil
+	}
+	roundStart := round * int64(dynastyTime)
+
+	//skip the blocks produced in the round itself
+	currHeight := height - 1
+	blk, err := shuffler.chain.GetBlockByHeight(currHeight)
+	for err == nil && currHeight > 0 && blk.GetTimestamp() >= roundStart {
+		currHeight--
+		blk, err = shuffler.chain.GetBlockByHeight(currHeight)
+	}
+	if err != nil || currHeight == 0 {
+		return nil
+	}
+
+	//the last block before the round identifies the blocks of the previous round
+	key := blk.GetHash().String() + ":" + strconv.FormatInt(round, 10)
+	if seed, ok := shuffler.seeds.Get(key); ok {
+		return seed.(hash.Hash)
+	}
+
+	outputs := [][]byte{}
+	for err == nil && currHeight > 0 && blk.GetTimestamp() >= roundStart-int64(dynastyTime) {
+		//the blocks on chain are verified already. The proofs of blocks below the VRF height are not verified
+		if !shuffler.forks.IsVRFActive(blk.GetHeight()) {
+			break
+		}
+		if output, proofErr := secp256k1VRF.ProofToIndex(blk.GetVRFProof()); proofErr == nil {
+			outputs = append([][]byte{output[:]}, outputs...)
+		}
+		currHeight--
+		blk, err = shuffler.chain.GetBlockByHeight(currHeight)
+	}
+
+	var seed hash.Hash
+	if len(outputs) > 0 {
+		sum := sha256.Sum256(bytes.Join(outputs, []byte{}))
+		seed = sum[:]
+	}
+	shuffler.seeds.Add(key, seed)
+	return seed
+}
+
+//ProveBlock evaluates the VRF of the producer key with the signer for the round of the block and puts the proof in
+//the block. Blocks below the VRF height are not proven. The block has to be hashed and signed afterwards
+func (shuffler *SlotShuffler) ProveBlock(blk *block.Block, dynastyTime int, signer blocksigner.BlockSigner) error {
+	if !shuffler.forks.IsVRFActive(blk.GetHeight()) {
+		return nil
+	}
+	proof, err := signer.ProveVRF(shuffler.getVRFMessage(blk, dynastyTime))
+	if err != nil {
+		return err
+	}
+	blk.SetVRFProof(proof)
+	return nil
+}
+
+//VerifyBlock checks that the VRF proof in the block is evaluated by the input public key for the round of the block.
+//The round seed is read from the ancestors of the block, so its parent has to be on the chain. Blocks below the VRF
+//height do not need a proof
+func (shuffler *SlotShuffler) VerifyBlock(blk *block.Block, dynastyTime int, pubKey []byte) error {
+	if !shuffler.forks.IsVRFActive(blk.GetHeight()) {
+		return nil
+	}
+	if !shuffler.IsParentOnChain(blk) {
+		return ErrParentNotOnChain
+	}
+	if len(blk.GetVRFProof()) == 0 {
+		return ErrVRFProofMissing
+	}
+	verifier, err := secp256k1VRF.NewVRFVerifierFromRawKey(pubKey)
+	if err != nil {
+		return err
+	}
+	_, err = verifier.ProofToHash(shuffler.getVRFMessage(blk, dynastyTime), blk.GetVRFProof())
+	return err
+}
+
+//getVRFMessage returns the message evaluated by the producer of the block. It changes every round with the round seed,
+//so that the outputs of a round cannot be computed in advance
+func (shuffler *SlotShuffler) getVRFMessage(blk *block.Block, dynastyTime int) []byte {
+	round := blk.GetTimestamp() / int64(dynastyTime)
+	data := bytes.Join(
+		[][]byte{
+			vrfMessagePrefix,
+			shuffler.GetRoundSeed(round, blk.GetHeight(), dynastyTime),
+			util.IntToHex(round),
+		},
+		[]byte{},
+	)
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+//shuffleProducers returns the producers permuted by the seed
+func shuffleProducers(producers []string, seed hash.Hash) []string {
+	shuffled := make([]string, len(producers))
+	copy(shuffled, producers)
+	for i := len(shuffled) - 1; i > 0; i-- {
+		h := sha256.Sum256(append(append([]byte{}, seed...), util.UintToHex(uint64(i))...))
+		j := binary.BigEndian.Uint64(h[:8]) % uint64(i+1)
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	}
+	return shuffled
+}
@@ -0,0 +1,194 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package consensus
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/stretchr/testify/assert"
+)
+
+var shuffledProducerKeys = []string{
+	producerKey,
+	"bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa7e",
+	"300c0338c4b0d49edc66113e3584e04c6b907f9ded711d396d522aae6a79be1a",
+}
+
+//newShuffledDPOS returns a dpos of the test producers on a chain with only the genesis block, and the keys of the
+//producers by address
+func newShuffledDPOS() (*DPOS, *fakeChain, map[string]string) {
+	keys := make(map[string]string)
+	producers := []string{}
+	for _, key := range shuffledProducerKeys {
+		producer := account.NewAccountByPrivateKey(key).GetAddress().String()
+		keys[producer] = key
+		producers = append(producers, producer)
+	}
+	genesis := block.NewBlockWithTimestamp(nil, nil, 0, "")
+	genesis.SetHeight(0)
+	genesis.SetHash(lblock.CalculateHash(genesis, nil))
+	chain := &fakeChain{blocks: []*block.Block{genesis}}
+
+	dpos := NewDPOS(nil)
+	dpos.SetDynasty(NewDynasty(producers, len(producers), defaultTimeBetweenBlk))
+	dpos.SetChain(chain)
+	return dpos, chain, keys
+}
+
+//newSlotBlock returns a block on top of the chain in the input slot that is proven and signed with the key
+func newSlotBlock(t *testing.T, dpos *DPOS, chain *fakeChain, slot int64, producer string, key string) *block.Block {
+	cbtx := ltransaction.NewCoinbaseTX(account.NewAddress(producer), "", chain.GetMaxHeight()+1, common.NewAmount(0))
+	parent := chain.blocks[len(chain.blocks)-1]
+	blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, parent, slot*defaultTimeBetweenBlk, producer)
+	assert.Nil(t, dpos.shuffler.ProveBlock(blk, dpos.GetDynasty().GetDynastyTime(), newTestSigner(t, key)))
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.True(t, lblock.SignBlock(blk, key))
+	return blk
+}
+
+func TestShuffleProducers(t *testing.T) {
+	producers := []string{"a", "b", "c", "d", "e"}
+	seed := hash.Hash("seed")
+
+	shuffled := shuffleProducers(producers, seed)
+	assert.Equal(t, shuffled, shuffleProducers(producers, seed))
+	assert.ElementsMatch(t, producers, shuffled)
+	assert.Equal(t, []string{"a", "b", "c", "d", "e"}, producers)
+	assert.NotEqual(t, shuffled, shuffleProducers(producers, hash.Hash("another seed")))
+}
+
+func TestSlotShuffler_ProveBlock(t *testing.T) {
+	dpos, chain, keys := newShuffledDPOS()
+	producer := dpos.GetProducers()[0]
+	dynastyTime := dpos.GetDynasty().GetDynastyTime()
+	//the public key recovered from a signature has the uncompressed point prefix
+	pubKey := func(key string) []byte {
+		return append([]byte{4}, account.NewAccountByPrivateKey(key).GetKeyPair().GetPublicKey()...)
+	}
+
+	blk := newSlotBlock(t, dpos, chain, 3, producer, keys[producer])
+	assert.Nil(t, dpos.shuffler.VerifyBlock(blk, dynastyTime, pubKey(keys[producer])))
+	assert.NotNil(t, dpos.shuffler.VerifyBlock(blk, dynastyTime, pubKey(shuffledProducerKeys[1])))
+
+	//the proof is bound to the round of the block
+	blk.SetTimestamp(blk.GetTimestamp() + int64(dynastyTime))
+	assert.NotNil(t, dpos.shuffler.VerifyBlock(blk, dynastyTime, pubKey(keys[producer])))
+
+	blk.SetVRFProof(nil)
+	assert.Equal(t, ErrVRFProofMissing, dpos.shuffler.VerifyBlock(blk, dynastyTime, pubKey(keys[producer])))
+}
+
+func TestDPOS_ValidateShuffledSlots(t *testing.T) {
+	dpos, chain, keys := newShuffledDPOS()
+	numOfProducers := int64(len(dpos.GetProducers()))
+
+	//the first round has no seed and follows the order of the dynasty
+	assert.Nil(t, dpos.shuffler.GetRoundSeed(1, 1, dpos.GetDynasty().GetDynastyTime()))
+	isShuffled := false
+	for slot := numOfProducers; slot < 6*numOfProducers; slot++ {
+		timestamp := slot * defaultTimeBetweenBlk
+		dynasty := dpos.getDynastyAtHeight(chain.GetMaxHeight() + 1)
+		expected := dynasty.ProducerAtATime(timestamp)
+		assert.True(t, dynasty.IsMyTurn(expected, timestamp))
+
+		//the producer of the slot in the fixed order is rejected if the slot is shuffled to another producer
+		fixed := dpos.GetProducers()[slot%numOfProducers]
+		if fixed != expected {
+			isShuffled = true
+			assert.False(t, dynasty.IsMyTurn(fixed, timestamp))
+			assert.False(t, dpos.Validate(newSlotBlock(t, dpos, chain, slot, fixed, keys[fixed])))
+		}
+
+		blk := newSlotBlock(t, dpos, chain, slot, expected, keys[expected])
+		assert.True(t, dpos.Validate(blk))
+		chain.blocks = append(chain.blocks, blk)
+	}
+	assert.True(t, isShuffled)
+	assert.NotNil(t, dpos.shuffler.GetRoundSeed(2, chain.GetMaxHeight()+1, dpos.GetDynasty().GetDynastyTime()))
+
+	//a block without VRF proof is rejected
+	slot := 6 * numOfProducers
+	producer := dpos.getDynastyAtHeight(chain.GetMaxHeight() + 1).ProducerAtATime(slot * defaultTimeBetweenBlk)
+	blk := newSlotBlock(t, dpos, chain, slot, producer, keys[producer])
+	blk.SetVRFProof(nil)
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.True(t, lblock.SignBlock(blk, keys[producer]))
+	assert.False(t, dpos.Validate(blk))
+}
+
+func TestDPOS_ValidateBelowVRFHeight(t *testing.T) {
+	dpos, chain, keys := newShuffledDPOS()
+	dpos.SetForks(&block.Forks{VRFHeight: 4})
+	numOfProducers := int64(len(dpos.GetProducers()))
+
+	//the blocks below the VRF height are not proven and do not seed the slot order
+	for slot := numOfProducers; slot < numOfProducers+3; slot++ {
+		producer := dpos.GetProducers()[slot%numOfProducers]
+		blk := newSlotBlock(t, dpos, chain, slot, producer, keys[producer])
+		assert.Empty(t, blk.GetVRFProof())
+		assert.True(t, dpos.Validate(blk))
+		chain.blocks = append(chain.blocks, blk)
+	}
+	assert.Nil(t, dpos.shuffler.GetRoundSeed(2, chain.GetMaxHeight()+1, dpos.GetDynasty().GetDynastyTime()))
+
+	//a block at the VRF height has to be proven
+	slot := numOfProducers + 3
+	producer := dpos.GetProducers()[slot%numOfProducers]
+	blk := newSlotBlock(t, dpos, chain, slot, producer, keys[producer])
+	assert.NotEmpty(t, blk.GetVRFProof())
+	assert.True(t, dpos.Validate(blk))
+	blk.SetVRFProof(nil)
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.True(t, lblock.SignBlock(blk, keys[producer]))
+	assert.False(t, dpos.Validate(blk))
+}
+
+func TestDPOS_ValidateForkBlock(t *testing.T) {
+	dpos, chain, keys := newShuffledDPOS()
+	numOfProducers := int64(len(dpos.GetProducers()))
+	for slot := numOfProducers; slot < 3*numOfProducers; slot++ {
+		producer := dpos.getDynastyAtHeight(chain.GetMaxHeight() + 1).ProducerAtATime(slot * defaultTimeBetweenBlk)
+		blk := newSlotBlock(t, dpos, chain, slot, producer, keys[producer])
+		chain.blocks = append(chain.blocks, blk)
+	}
+
+	//the parent of the fork block is not on the chain, so its slot order is unknown until the fork is merged
+	forkChain := &fakeChain{blocks: append([]*block.Block{}, chain.blocks[:len(chain.blocks)-1]...)}
+	slot := 3 * numOfProducers
+	producer := dpos.GetProducers()[0]
+	forkParent := newSlotBlock(t, dpos, forkChain, slot, producer, keys[producer])
+	forkChain.blocks = append(forkChain.blocks, forkParent)
+	forkBlk := newSlotBlock(t, dpos, forkChain, slot+1, producer, keys[producer])
+	assert.False(t, dpos.shuffler.IsParentOnChain(forkBlk))
+	assert.Equal(t, ErrParentNotOnChain, dpos.shuffler.VerifyBlock(forkBlk, dpos.GetDynasty().GetDynastyTime(), nil))
+	assert.True(t, dpos.Validate(forkBlk))
+
+	//a signer out of the dynasty is still rejected
+	outsiderKey := "0a1b2c3d4e5f60718293a4b5c6d7e8f90a1b2c3d4e5f60718293a4b5c6d7e8f9"
+	outsider := account.NewAccountByPrivateKey(outsiderKey).GetAddress().String()
+	forkBlk = newSlotBlock(t, dpos, forkChain, slot+2, outsider, outsiderKey)
+	assert.False(t, dpos.Validate(forkBlk))
+}
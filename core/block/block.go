@@ -69,6 +69,8 @@ func NewBlockByHash(preHash hash.Hash, producer string) *Block  {
 			[]byte{},
 			0,
 			producer,
+			nil,
+			nil,
 		},
 		transactions: []*transaction.Transaction{},
 	}
@@ -107,6 +109,14 @@ func (b *Block) GetProducer() string {
 	return b.header.producer
 }
 
+func (b *Block) GetVRFProof() []byte {
+	return b.header.vrfProof
+}
+
+func (b *Block) GetStateRoot() hash.Hash {
+	return b.header.stateRoot
+}
+
 func (b *Block) GetTransactions() []*transaction.Transaction {
 	return b.transactions
 }
@@ -131,6 +141,14 @@ func (b *Block) SetTimestamp(timestamp int64) {
 	b.header.timestamp = timestamp
 }
 
+func (b *Block) SetVRFProof(proof []byte) {
+	b.header.vrfProof = proof
+}
+
+func (b *Block) SetStateRoot(stateRoot hash.Hash) {
+	b.header.stateRoot = stateRoot
+}
+
 func (b *Block) SetTransactions(txs []*transaction.Transaction) {
 	b.transactions = txs
 }
@@ -14,6 +14,8 @@ type BlockHeader struct {
 	signature hash.Hash
 	height    uint64
 	producer  string
+	vrfProof  []byte
+	stateRoot hash.Hash
 }
 
 func NewBlockHeader(hash hash.Hash, prevHash hash.Hash, nonce int64, timeStamp int64, height uint64) *BlockHeader {
@@ -35,6 +37,8 @@ func (bh *BlockHeader) ToProto() proto.Message {
 		Signature:    bh.signature,
 		Height:       bh.height,
 		Producer:     bh.producer,
+		VrfProof:     bh.vrfProof,
+		StateRoot:    bh.stateRoot,
 	}
 }
 
@@ -49,4 +53,6 @@ func (bh *BlockHeader) FromProto(pb proto.Message) {
 	bh.signature = pb.(*blockpb.BlockHeader).GetSignature()
 	bh.height = pb.(*blockpb.BlockHeader).GetHeight()
 	bh.producer = pb.(*blockpb.BlockHeader).GetProducer()
+	bh.vrfProof = pb.(*blockpb.BlockHeader).GetVrfProof()
+	bh.stateRoot = pb.(*blockpb.BlockHeader).GetStateRoot()
 }
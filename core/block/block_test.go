@@ -73,6 +73,8 @@ func TestBlockHeader_Proto(t *testing.T) {
 		nil,
 		0,
 		"",
+		nil,
+		[]byte("stateRoot"),
 	}
 
 	pb := bh1.ToProto()
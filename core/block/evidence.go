@@ -0,0 +1,222 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package block
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	ErrEvidenceIncomplete       = errors.New("evidence: missing block header")
+	ErrEvidenceSameBlock        = errors.New("evidence: both headers belong to the same block")
+	ErrEvidenceInvalidSignature = errors.New("evidence: cannot recover the signer of the block header")
+	ErrEvidenceDifferentSigners = errors.New("evidence: block headers are signed by different producers")
+)
+
+// SignedHeader contains the block fields covered by the block hash together with the producer's signature,
+// so that the signer of a block can be proven without the transactions of the block
+type SignedHeader struct {
+	PrevHash  hash.Hash
+	TxsHash   hash.Hash
+	Timestamp int64
+	Nonce     int64
+	Producer  string
+	Height    uint64
+	Signature hash.Hash
+	VRFProof  []byte
+	StateRoot hash.Hash
+}
+
+// Evidence proves that a producer signed two different blocks. Its headers are ordered by block hash so that
+// the same equivocation always results in the same evidence
+type Evidence struct {
+	First  *SignedHeader
+	Second *SignedHeader
+}
+
+//CalculateHeaderHash returns the block hash of the input header fields. A block without vrf proof or state root keeps
+//the hash it had before these fields were added to the header
+func CalculateHeaderHash(prevHash hash.Hash, txsHash hash.Hash, timestamp int64, nonce int64, producer string, vrfProof []byte, stateRoot hash.Hash) hash.Hash {
+	data := bytes.Join(
+		[][]byte{
+			prevHash,
+			txsHash,
+			util.IntToHex(timestamp),
+			util.IntToHex(nonce),
+			[]byte(producer),
+			vrfProof,
+			stateRoot,
+		},
+		[]byte{},
+	)
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+//Hash returns the block hash of the header
+func (header *SignedHeader) Hash() hash.Hash {
+	return CalculateHeaderHash(header.PrevHash, header.TxsHash, header.Timestamp, header.Nonce, header.Producer, header.VRFProof, header.StateRoot)
+}
+
+//GetSigner returns the address of the producer that signed the header
+func (header *SignedHeader) GetSigner() (string, error) {
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(header.Hash(), header.Signature)
+	if err != nil {
+		return "", ErrEvidenceInvalidSignature
+	}
+	if ok, _ := account.IsValidPubKey(pubkey[1:]); !ok {
+		return "", ErrEvidenceInvalidSignature
+	}
+	return account.NewTransactionAccountByPubKey(pubkey[1:]).GetAddress().String(), nil
+}
+
+func (header *SignedHeader) ToProto() proto.Message {
+	return &blockpb.SignedHeader{
+		PreviousHash: header.PrevHash,
+		TxsHash:      header.TxsHash,
+		Timestamp:    header.Timestamp,
+		Nonce:        header.Nonce,
+		Producer:     header.Producer,
+		Height:       header.Height,
+		Signature:    header.Signature,
+		VrfProof:     header.VRFProof,
+		StateRoot:    header.StateRoot,
+	}
+}
+
+func (header *SignedHeader) FromProto(pb proto.Message) {
+	headerPb := pb.(*blockpb.SignedHeader)
+	header.PrevHash = headerPb.GetPreviousHash()
+	header.TxsHash = headerPb.GetTxsHash()
+	header.Timestamp = headerPb.GetTimestamp()
+	header.Nonce = headerPb.GetNonce()
+	header.Producer = headerPb.GetProducer()
+	header.Height = headerPb.GetHeight()
+	header.Signature = headerPb.GetSignature()
+	header.VRFProof = headerPb.GetVrfProof()
+	header.StateRoot = headerPb.GetStateRoot()
+}
+
+//NewHeaderOnlyBlock returns a block with the header fields of the signed header and no transactions. It stands for
+//the block on nodes that do not keep the transactions
+func NewHeaderOnlyBlock(header *SignedHeader) *Block {
+	return &Block{
+		header: &BlockHeader{
+			hash:      header.Hash(),
+			prevHash:  header.PrevHash,
+			nonce:     header.Nonce,
+			timestamp: header.Timestamp,
+			signature: header.Signature,
+			height:    header.Height,
+			producer:  header.Producer,
+			vrfProof:  header.VRFProof,
+			stateRoot: header.StateRoot,
+		},
+		transactions: []*transaction.Transaction{},
+	}
+}
+
+//NewEvidence returns the evidence of the two conflicting block headers
+func NewEvidence(header1, header2 *SignedHeader) *Evidence {
+	if bytes.Compare(header1.Hash(), header2.Hash()) > 0 {
+		header1, header2 = header2, header1
+	}
+	return &Evidence{First: header1, Second: header2}
+}
+
+//Verify checks that the two headers are different blocks signed by the same producer and returns the producer
+func (evidence *Evidence) Verify() (string, error) {
+	if evidence.First == nil || evidence.Second == nil {
+		return "", ErrEvidenceIncomplete
+	}
+	if bytes.Equal(evidence.First.Hash(), evidence.Second.Hash()) {
+		return "", ErrEvidenceSameBlock
+	}
+	signer1, err := evidence.First.GetSigner()
+	if err != nil {
+		return "", err
+	}
+	signer2, err := evidence.Second.GetSigner()
+	if err != nil {
+		return "", err
+	}
+	if signer1 != signer2 {
+		return "", ErrEvidenceDifferentSigners
+	}
+	return signer1, nil
+}
+
+//IsSameSlot returns if both blocks are produced in the same time slot
+func (evidence *Evidence) IsSameSlot(timeBetweenBlk int) bool {
+	if timeBetweenBlk <= 0 {
+		return false
+	}
+	return evidence.First.Timestamp/int64(timeBetweenBlk) == evidence.Second.Timestamp/int64(timeBetweenBlk)
+}
+
+func (evidence *Evidence) ToProto() proto.Message {
+	evidencePb := &blockpb.Evidence{}
+	if evidence.First != nil {
+		evidencePb.First = evidence.First.ToProto().(*blockpb.SignedHeader)
+	}
+	if evidence.Second != nil {
+		evidencePb.Second = evidence.Second.ToProto().(*blockpb.SignedHeader)
+	}
+	return evidencePb
+}
+
+func (evidence *Evidence) FromProto(pb proto.Message) {
+	evidencePb := pb.(*blockpb.Evidence)
+	evidence.First = nil
+	evidence.Second = nil
+	if evidencePb.GetFirst() != nil {
+		evidence.First = &SignedHeader{}
+		evidence.First.FromProto(evidencePb.GetFirst())
+	}
+	if evidencePb.GetSecond() != nil {
+		evidence.Second = &SignedHeader{}
+		evidence.Second.FromProto(evidencePb.GetSecond())
+	}
+}
+
+//Serialize returns the encoded evidence
+func (evidence *Evidence) Serialize() ([]byte, error) {
+	return proto.Marshal(evidence.ToProto())
+}
+
+//DeserializeEvidence decodes the evidence from the input bytes
+func DeserializeEvidence(d []byte) (*Evidence, error) {
+	evidencePb := &blockpb.Evidence{}
+	if err := proto.Unmarshal(d, evidencePb); err != nil {
+		return nil, err
+	}
+	evidence := &Evidence{}
+	evidence.FromProto(evidencePb)
+	return evidence, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package block
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	evidenceProducerAddr = "dPGZmHd73UpZhrM6uvgnzu49ttbLp4AzU8"
+	evidenceProducerKey  = "5a66b0fdb69c99935783059bb200e86e97b506ae443a62febd7d0750cd7fac55"
+	evidenceOtherKey     = "bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa7e"
+)
+
+func newTestSignedHeader(t *testing.T, timestamp int64, txsHash []byte, key string) *SignedHeader {
+	header := &SignedHeader{
+		PrevHash:  []byte("prevhash"),
+		TxsHash:   txsHash,
+		Timestamp: timestamp,
+		Producer:  evidenceProducerAddr,
+		Height:    10,
+	}
+	privData, err := hex.DecodeString(key)
+	assert.Nil(t, err)
+	header.Signature, err = secp256k1.Sign(header.Hash(), privData)
+	assert.Nil(t, err)
+	return header
+}
+
+func TestEvidence_Verify(t *testing.T) {
+	header1 := newTestSignedHeader(t, 100, []byte("txs1"), evidenceProducerKey)
+	header2 := newTestSignedHeader(t, 101, []byte("txs2"), evidenceProducerKey)
+
+	offender, err := NewEvidence(header1, header2).Verify()
+	assert.Nil(t, err)
+	assert.Equal(t, evidenceProducerAddr, offender)
+
+	//the same equivocation results in the same evidence
+	assert.Equal(t, NewEvidence(header1, header2), NewEvidence(header2, header1))
+
+	_, err = NewEvidence(header1, header1).Verify()
+	assert.Equal(t, ErrEvidenceSameBlock, err)
+
+	_, err = NewEvidence(header1, newTestSignedHeader(t, 101, []byte("txs2"), evidenceOtherKey)).Verify()
+	assert.Equal(t, ErrEvidenceDifferentSigners, err)
+
+	//a header modified after signing does not prove its signer
+	tampered := newTestSignedHeader(t, 101, []byte("txs2"), evidenceProducerKey)
+	tampered.Signature = header1.Signature
+	_, err = NewEvidence(header1, tampered).Verify()
+	assert.NotNil(t, err)
+
+	_, err = (&Evidence{First: header1}).Verify()
+	assert.Equal(t, ErrEvidenceIncomplete, err)
+}
+
+func TestEvidence_IsSameSlot(t *testing.T) {
+	evidence := NewEvidence(
+		newTestSignedHeader(t, 100, []byte("txs1"), evidenceProducerKey),
+		newTestSignedHeader(t, 104, []byte("txs2"), evidenceProducerKey),
+	)
+	assert.True(t, evidence.IsSameSlot(5))
+	assert.False(t, evidence.IsSameSlot(3))
+	assert.False(t, evidence.IsSameSlot(0))
+}
+
+func TestEvidence_Serialize(t *testing.T) {
+	evidence := NewEvidence(
+		newTestSignedHeader(t, 100, []byte("txs1"), evidenceProducerKey),
+		newTestSignedHeader(t, 101, []byte("txs2"), evidenceProducerKey),
+	)
+	rawBytes, err := evidence.Serialize()
+	assert.Nil(t, err)
+
+	deserialized, err := DeserializeEvidence(rawBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, evidence, deserialized)
+
+	_, err = DeserializeEvidence([]byte("invalid"))
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,200 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package block
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/account"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+)
+
+var preCommitPrefix = []byte("precommit")
+
+var (
+	ErrPreCommitInvalidSignature     = errors.New("precommit: cannot recover the signer of the precommit")
+	ErrCertificateUnknownSigner      = errors.New("finality certificate: signer is not a producer of the dynasty")
+	ErrCertificateDuplicateSigner    = errors.New("finality certificate: duplicate signer")
+	ErrCertificateNotEnoughSignature = errors.New("finality certificate: not signed by more than 2/3 of the dynasty")
+)
+
+// PreCommit is the vote of a producer to finalize the block with the hash at the height
+type PreCommit struct {
+	BlockHash hash.Hash
+	Height    uint64
+	Signature hash.Hash
+}
+
+// FinalityCertificate proves that a block is irreversible with the precommit signatures of more than 2/3 of the
+// producers in charge of the block
+type FinalityCertificate struct {
+	BlockHash  hash.Hash
+	Height     uint64
+	Signatures []hash.Hash
+}
+
+//CalculatePreCommitHash returns the hash signed by the producers to precommit the block
+func CalculatePreCommitHash(blockHash hash.Hash, height uint64) hash.Hash {
+	data := bytes.Join(
+		[][]byte{
+			preCommitPrefix,
+			blockHash,
+			util.UintToHex(height),
+		},
+		[]byte{},
+	)
+	h := sha256.Sum256(data)
+	return h[:]
+}
+
+//NewPreCommit returns the precommit of the block signed with the input private key
+func NewPreCommit(blockHash hash.Hash, height uint64, key string) (*PreCommit, error) {
+	privData, err := hex.DecodeString(key)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := secp256k1.Sign(CalculatePreCommitHash(blockHash, height), privData)
+	if err != nil {
+		return nil, err
+	}
+	return &PreCommit{BlockHash: blockHash, Height: height, Signature: signature}, nil
+}
+
+//GetSigner returns the address of the producer that signed the precommit
+func (preCommit *PreCommit) GetSigner() (string, error) {
+	return recoverPreCommitSigner(preCommit.BlockHash, preCommit.Height, preCommit.Signature)
+}
+
+func (preCommit *PreCommit) ToProto() proto.Message {
+	return &blockpb.PreCommit{
+		BlockHash: preCommit.BlockHash,
+		Height:    preCommit.Height,
+		Signature: preCommit.Signature,
+	}
+}
+
+func (preCommit *PreCommit) FromProto(pb proto.Message) {
+	preCommitPb := pb.(*blockpb.PreCommit)
+	preCommit.BlockHash = preCommitPb.GetBlockHash()
+	preCommit.Height = preCommitPb.GetHeight()
+	preCommit.Signature = preCommitPb.GetSignature()
+}
+
+//Verify checks that the certificate is signed by the quorum of the input producers for the input ratio
+func (cert *FinalityCertificate) Verify(producers []string, ratio float64) error {
+	isProducer := make(map[string]bool)
+	for _, producer := range producers {
+		isProducer[producer] = true
+	}
+
+	signed := make(map[string]bool)
+	for _, signature := range cert.Signatures {
+		signer, err := recoverPreCommitSigner(cert.BlockHash, cert.Height, signature)
+		if err != nil {
+			return err
+		}
+		if !isProducer[signer] {
+			return ErrCertificateUnknownSigner
+		}
+		if signed[signer] {
+			return ErrCertificateDuplicateSigner
+		}
+		signed[signer] = true
+	}
+
+	if !IsFinalityQuorum(len(signed), len(producers), ratio) {
+		return ErrCertificateNotEnoughSignature
+	}
+	return nil
+}
+
+func (cert *FinalityCertificate) ToProto() proto.Message {
+	signatures := make([][]byte, len(cert.Signatures))
+	for i, signature := range cert.Signatures {
+		signatures[i] = signature
+	}
+	return &blockpb.FinalityCertificate{
+		BlockHash:  cert.BlockHash,
+		Height:     cert.Height,
+		Signatures: signatures,
+	}
+}
+
+func (cert *FinalityCertificate) FromProto(pb proto.Message) {
+	certPb := pb.(*blockpb.FinalityCertificate)
+	cert.BlockHash = certPb.GetBlockHash()
+	cert.Height = certPb.GetHeight()
+	cert.Signatures = []hash.Hash{}
+	for _, signature := range certPb.GetSignatures() {
+		cert.Signatures = append(cert.Signatures, signature)
+	}
+}
+
+//Serialize returns the encoded certificate
+func (cert *FinalityCertificate) Serialize() ([]byte, error) {
+	return proto.Marshal(cert.ToProto())
+}
+
+//DeserializeFinalityCertificate decodes the certificate from the input bytes
+func DeserializeFinalityCertificate(d []byte) (*FinalityCertificate, error) {
+	certPb := &blockpb.FinalityCertificate{}
+	if err := proto.Unmarshal(d, certPb); err != nil {
+		return nil, err
+	}
+	cert := &FinalityCertificate{}
+	cert.FromProto(certPb)
+	return cert, nil
+}
+
+//IsFinalityQuorum returns if the number of signers reaches the quorum of the producers for the input ratio
+func IsFinalityQuorum(numOfSigners int, numOfProducers int, ratio float64) bool {
+	return numOfProducers > 0 && numOfSigners >= GetFinalityQuorum(numOfProducers, ratio)
+}
+
+//GetFinalityQuorum returns the number of producers that have to sign a block to finalize it. The quorum is more than
+//the ratio of the producers, and more than 2/3 of them at least so that two conflicting quorums share an honest
+//producer. It is capped at the number of producers
+func GetFinalityQuorum(numOfProducers int, ratio float64) int {
+	quorum := numOfProducers*2/3 + 1
+	if quorumByRatio := int(float64(numOfProducers)*ratio) + 1; quorumByRatio > quorum {
+		quorum = quorumByRatio
+	}
+	if quorum > numOfProducers {
+		return numOfProducers
+	}
+	return quorum
+}
+
+func recoverPreCommitSigner(blockHash hash.Hash, height uint64, signature hash.Hash) (string, error) {
+	pubkey, err := secp256k1.RecoverECDSAPublicKey(CalculatePreCommitHash(blockHash, height), signature)
+	if err != nil {
+		return "", ErrPreCommitInvalidSignature
+	}
+	if ok, _ := account.IsValidPubKey(pubkey[1:]); !ok {
+		return "", ErrPreCommitInvalidSignature
+	}
+	return account.NewTransactionAccountByPubKey(pubkey[1:]).GetAddress().String(), nil
+}
@@ -0,0 +1,105 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package block
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/stretchr/testify/assert"
+)
+
+var finalityProducers = []struct {
+	address string
+	key     string
+}{
+	{"dPGZmHd73UpZhrM6uvgnzu49ttbLp4AzU8", "5a66b0fdb69c99935783059bb200e86e97b506ae443a62febd7d0750cd7fac55"},
+	{"dQEooMsqp23RkPsvZXj3XbsRh9BUyGz2S9", "bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa7e"},
+	{"dastXXWLe5pxbRYFhcyUq8T3wb5srWkHKa", "300c0338c4b0d49edc66113e3584e04c6b907f9ded711d396d522aae6a79be1a"},
+}
+
+func TestPreCommit_GetSigner(t *testing.T) {
+	preCommit, err := NewPreCommit(hash.Hash("blockhash"), 10, finalityProducers[0].key)
+	assert.Nil(t, err)
+	signer, err := preCommit.GetSigner()
+	assert.Nil(t, err)
+	assert.Equal(t, finalityProducers[0].address, signer)
+
+	//the signature only proves the vote for the signed height
+	preCommit.Height = 11
+	signer, err = preCommit.GetSigner()
+	assert.NotEqual(t, finalityProducers[0].address, signer)
+
+	_, err = NewPreCommit(hash.Hash("blockhash"), 10, "invalid")
+	assert.NotNil(t, err)
+}
+
+func TestFinalityCertificate_Verify(t *testing.T) {
+	producers := []string{}
+	signatures := []hash.Hash{}
+	for _, producer := range finalityProducers {
+		producers = append(producers, producer.address)
+		preCommit, err := NewPreCommit(hash.Hash("blockhash"), 10, producer.key)
+		assert.Nil(t, err)
+		signatures = append(signatures, preCommit.Signature)
+	}
+
+	cert := &FinalityCertificate{BlockHash: hash.Hash("blockhash"), Height: 10, Signatures: signatures}
+	assert.Nil(t, cert.Verify(producers, 0))
+
+	//2 of 3 producers is not more than 2/3
+	cert.Signatures = signatures[:2]
+	assert.Equal(t, ErrCertificateNotEnoughSignature, cert.Verify(producers, 0))
+	assert.Nil(t, cert.Verify(producers[:2], 0))
+
+	cert.Signatures = []hash.Hash{signatures[0], signatures[0], signatures[1]}
+	assert.Equal(t, ErrCertificateDuplicateSigner, cert.Verify(producers, 0))
+
+	cert.Signatures = signatures
+	assert.Equal(t, ErrCertificateUnknownSigner, cert.Verify(producers[:2], 0))
+
+	cert.Height = 11
+	assert.NotNil(t, cert.Verify(producers, 0))
+}
+
+func TestGetFinalityQuorum(t *testing.T) {
+	assert.Equal(t, 5, GetFinalityQuorum(6, 0))
+	assert.Equal(t, 3, GetFinalityQuorum(3, 0))
+	//the ratio cannot lower the quorum below more than 2/3
+	assert.Equal(t, 5, GetFinalityQuorum(6, 0.5))
+	assert.Equal(t, 6, GetFinalityQuorum(6, 0.9))
+	//the quorum cannot require more signers than there are producers
+	assert.Equal(t, 6, GetFinalityQuorum(6, 1))
+
+	assert.False(t, IsFinalityQuorum(5, 6, 0.9))
+	assert.True(t, IsFinalityQuorum(6, 6, 0.9))
+	assert.False(t, IsFinalityQuorum(0, 0, 0))
+}
+
+func TestFinalityCertificate_Serialize(t *testing.T) {
+	preCommit, err := NewPreCommit(hash.Hash("blockhash"), 10, finalityProducers[0].key)
+	assert.Nil(t, err)
+	cert := &FinalityCertificate{BlockHash: hash.Hash("blockhash"), Height: 10, Signatures: []hash.Hash{preCommit.Signature}}
+
+	rawBytes, err := cert.Serialize()
+	assert.Nil(t, err)
+	deserialized, err := DeserializeFinalityCertificate(rawBytes)
+	assert.Nil(t, err)
+	assert.Equal(t, cert, deserialized)
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package block
+
+// Forks holds the heights from which the rules added after the launch of a network are enforced. A network that
+// starts from a new genesis block enforces every rule from height 0, while an existing network sets the heights above
+// its tail so that the blocks already on its chain stay valid. A nil Forks enforces every rule.
+type Forks struct {
+	VRFHeight           uint64
+	SlotTimestampHeight uint64
+	StateRootHeight     uint64
+	MerkleRootHeight    uint64
+	// ChainID is the chain id that the transactions of a network whose genesis block has no chain id are signed for from
+	// ChainIDHeight on
+	ChainID       uint64
+	ChainIDHeight uint64
+}
+
+//IsVRFActive returns true if the block at the input height has to carry the VRF proof of its producer
+func (forks *Forks) IsVRFActive(height uint64) bool {
+	return forks == nil || height >= forks.VRFHeight
+}
+
+//IsSlotTimestampActive returns true if the block at the input height has to be stamped at the start of its time slot
+//and in a later slot than its parent
+func (forks *Forks) IsSlotTimestampActive(height uint64) bool {
+	return forks == nil || height >= forks.SlotTimestampHeight
+}
+
+//IsStateRootActive returns true if the block at the input height has to carry the state root after its transactions.
+//The genesis block never carries it, so that its hash does not depend on the fork
+func (forks *Forks) IsStateRootActive(height uint64) bool {
+	return height > 0 && (forks == nil || height >= forks.StateRootHeight)
+}
+
+//IsMerkleRootActive returns true if the transactions of the block at the input height are hashed into a Merkle root.
+//The genesis block is always hashed by the legacy rule, so that its hash does not depend on the fork
+func (forks *Forks) IsMerkleRootActive(height uint64) bool {
+	return height > 0 && (forks == nil || height >= forks.MerkleRootHeight)
+}
+
+//GetChainID returns the chain id that the transactions in the block at the input height are signed for. The chain id of
+//the genesis block holds from height 0, while a network whose genesis block has none uses the configured chain id from
+//its activation height on
+func (forks *Forks) GetChainID(genesisChainID uint64, height uint64) uint64 {
+	if genesisChainID > 0 || forks == nil || height < forks.ChainIDHeight {
+		return genesisChainID
+	}
+	return forks.ChainID
+}
@@ -32,12 +32,15 @@ import (
 
 const BlockCacheLRUCacheLimit = 1024
 const ForkCacheLRUCacheLimit = 128
+const FutureBlockLimit = 64
 
 type BlockPool struct {
-	blkCache       *lru.Cache //cache of full blks
-	root           *common.TreeNode
-	orphans        map[string]*common.TreeNode
-	forkHeadsMutex *sync.RWMutex
+	blkCache        *lru.Cache //cache of full blks
+	root            *common.TreeNode
+	orphans         map[string]*common.TreeNode
+	forkHeadsMutex  *sync.RWMutex
+	futureBlks      map[string]*block.Block //blks stamped ahead of the local clock
+	futureBlksMutex *sync.Mutex
 }
 
 func NewBlockPool(rootBlk *block.Block) *BlockPool {
@@ -48,9 +51,11 @@ func NewBlockPool(rootBlk *block.Block) *BlockPool {
 	}
 
 	pool := &BlockPool{
-		root:           node,
-		orphans:        make(map[string]*common.TreeNode),
-		forkHeadsMutex: &sync.RWMutex{},
+		root:            node,
+		orphans:         make(map[string]*common.TreeNode),
+		forkHeadsMutex:  &sync.RWMutex{},
+		futureBlks:      make(map[string]*block.Block),
+		futureBlksMutex: &sync.Mutex{},
 	}
 	pool.blkCache, _ = lru.New(BlockCacheLRUCacheLimit)
 
@@ -93,6 +98,44 @@ func (pool *BlockPool) GetForkHead(blk *block.Block) *block.Block {
 	return node.(*common.TreeNode).GetRoot().GetValue().(*block.Block)
 }
 
+//AddFutureBlock keeps the block that is stamped ahead of the local clock until its time slot arrives. It returns false
+//if the block is already kept or the pool is full
+func (pool *BlockPool) AddFutureBlock(blk *block.Block) bool {
+	if blk == nil {
+		return false
+	}
+
+	pool.futureBlksMutex.Lock()
+	defer pool.futureBlksMutex.Unlock()
+
+	key := blk.GetHash().String()
+	if _, ok := pool.futureBlks[key]; ok || len(pool.futureBlks) >= FutureBlockLimit {
+		return false
+	}
+	pool.futureBlks[key] = blk
+	return true
+}
+
+//RemoveFutureBlock removes the future block with the input hash and returns if it was kept in the pool
+func (pool *BlockPool) RemoveFutureBlock(blkHash hash.Hash) bool {
+	pool.futureBlksMutex.Lock()
+	defer pool.futureBlksMutex.Unlock()
+
+	key := blkHash.String()
+	if _, ok := pool.futureBlks[key]; !ok {
+		return false
+	}
+	delete(pool.futureBlks, key)
+	return true
+}
+
+//GetNumOfFutureBlocks returns the number of future blocks kept in the pool
+func (pool *BlockPool) GetNumOfFutureBlocks() int {
+	pool.futureBlksMutex.Lock()
+	defer pool.futureBlksMutex.Unlock()
+	return len(pool.futureBlks)
+}
+
 //SetRootBlock updates the last irreversible block
 func (pool *BlockPool) SetRootBlock(rootBlk *block.Block) {
 
@@ -401,3 +401,25 @@ func deserializeBlockPool(s string, rootBlkHash string) (*BlockPool, map[string]
 
 	return bp, blocks
 }
+
+func TestBlockPool_FutureBlocks(t *testing.T) {
+	bp := NewBlockPool(nil)
+
+	blk := block.NewBlockWithRawInfo(hash.Hash("future"), []byte{0}, 0, 0, 1, nil)
+	assert.True(t, bp.AddFutureBlock(blk))
+	assert.False(t, bp.AddFutureBlock(blk))
+	assert.False(t, bp.AddFutureBlock(nil))
+	assert.Equal(t, 1, bp.GetNumOfFutureBlocks())
+
+	//future blocks are not part of the forks
+	assert.Equal(t, 0, bp.blkCache.Len())
+
+	for i := 1; i < FutureBlockLimit; i++ {
+		assert.True(t, bp.AddFutureBlock(block.NewBlockWithRawInfo(hash.Hash(strconv.Itoa(i)), []byte{0}, 0, 0, 1, nil)))
+	}
+	assert.False(t, bp.AddFutureBlock(block.NewBlockWithRawInfo(hash.Hash("full"), []byte{0}, 0, 0, 1, nil)))
+
+	assert.True(t, bp.RemoveFutureBlock(blk.GetHash()))
+	assert.False(t, bp.RemoveFutureBlock(blk.GetHash()))
+	assert.Equal(t, FutureBlockLimit-1, bp.GetNumOfFutureBlocks())
+}
@@ -0,0 +1,173 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package history
+
+import (
+	"sort"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	historypb "github.com/dappley/go-dappley/core/history/pb"
+	"github.com/dappley/go-dappley/crypto/byteutils"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+)
+
+var historyKeyPrefix = []byte("addr_history_")
+
+type EntryType int
+
+const (
+	EntryReceived EntryType = iota
+	EntrySpent
+)
+
+// Entry records an output received or spent by an address on the main chain. A received entry refers to an output of
+// its own transaction, while a spent entry refers to the output of a previous transaction spent by the transaction
+type Entry struct {
+	Txid        []byte
+	BlockHeight uint64
+	Type        EntryType
+	OutputTxid  []byte
+	OutputIndex int
+	Value       *common.Amount
+}
+
+func (entry *Entry) ToProto() proto.Message {
+	return &historypb.HistoryEntry{
+		Txid:        entry.Txid,
+		BlockHeight: entry.BlockHeight,
+		Type:        uint32(entry.Type),
+		OutputTxid:  entry.OutputTxid,
+		OutputIndex: uint32(entry.OutputIndex),
+		Amount:      entry.Value.Bytes(),
+	}
+}
+
+func (entry *Entry) FromProto(pb proto.Message) {
+	entryPb := pb.(*historypb.HistoryEntry)
+	entry.Txid = entryPb.GetTxid()
+	entry.BlockHeight = entryPb.GetBlockHeight()
+	entry.Type = EntryType(entryPb.GetType())
+	entry.OutputTxid = entryPb.GetOutputTxid()
+	entry.OutputIndex = int(entryPb.GetOutputIndex())
+	entry.Value = common.NewAmountFromBytes(entryPb.GetAmount())
+}
+
+//GetNumOfEntries returns the number of history entries of the address in database
+func GetNumOfEntries(pubKeyHash account.PubKeyHash, db storage.Storage) uint64 {
+	value, err := db.Get(getCountKey(pubKeyHash))
+	if err != nil {
+		return 0
+	}
+	return byteutils.Uint64(value)
+}
+
+//GetEntry returns the history entry of the address at the input sequence number from database
+func GetEntry(pubKeyHash account.PubKeyHash, seq uint64, db storage.Storage) (*Entry, error) {
+	value, err := db.Get(getEntryKey(pubKeyHash, seq))
+	if err != nil {
+		return nil, err
+	}
+	entryPb := &historypb.HistoryEntry{}
+	if err := proto.Unmarshal(value, entryPb); err != nil {
+		return nil, err
+	}
+	entry := &Entry{}
+	entry.FromProto(entryPb)
+	return entry, nil
+}
+
+//AppendEntries saves the entries after the existing history entries of the address. The entries are expected in the
+//order of the chain
+func AppendEntries(pubKeyHash account.PubKeyHash, entries []*Entry, db storage.Storage) error {
+	count := GetNumOfEntries(pubKeyHash, db)
+	for _, entry := range entries {
+		rawBytes, err := proto.Marshal(entry.ToProto())
+		if err != nil {
+			return err
+		}
+		if err := db.Put(getEntryKey(pubKeyHash, count), rawBytes); err != nil {
+			return err
+		}
+		count++
+	}
+	return db.Put(getCountKey(pubKeyHash), util.UintToHex(count))
+}
+
+//TruncateEntries removes the history entries of the address in the blocks higher than the input height
+func TruncateEntries(pubKeyHash account.PubKeyHash, height uint64, db storage.Storage) error {
+	count := GetNumOfEntries(pubKeyHash, db)
+	for count > 0 {
+		entry, err := GetEntry(pubKeyHash, count-1, db)
+		if err != nil {
+			return err
+		}
+		if entry.BlockHeight <= height {
+			break
+		}
+		if err := db.Del(getEntryKey(pubKeyHash, count-1)); err != nil {
+			return err
+		}
+		count--
+	}
+	if count == 0 {
+		return db.Del(getCountKey(pubKeyHash))
+	}
+	return db.Put(getCountKey(pubKeyHash), util.UintToHex(count))
+}
+
+//DeleteEntries removes all history entries of the address
+func DeleteEntries(pubKeyHash account.PubKeyHash, db storage.Storage) error {
+	count := GetNumOfEntries(pubKeyHash, db)
+	for seq := uint64(0); seq < count; seq++ {
+		if err := db.Del(getEntryKey(pubKeyHash, seq)); err != nil {
+			return err
+		}
+	}
+	return db.Del(getCountKey(pubKeyHash))
+}
+
+//FindEntry returns the sequence number of the first history entry of the address in a block at or above the input
+//height. It returns the number of entries if there is none
+func FindEntry(pubKeyHash account.PubKeyHash, height uint64, db storage.Storage) (uint64, error) {
+	var findErr error
+	count := GetNumOfEntries(pubKeyHash, db)
+	seq := sort.Search(int(count), func(i int) bool {
+		entry, err := GetEntry(pubKeyHash, uint64(i), db)
+		if err != nil {
+			findErr = err
+			return true
+		}
+		return entry.BlockHeight >= height
+	})
+	if findErr != nil {
+		return 0, findErr
+	}
+	return uint64(seq), nil
+}
+
+func getCountKey(pubKeyHash account.PubKeyHash) []byte {
+	return append(append([]byte{}, historyKeyPrefix...), pubKeyHash...)
+}
+
+func getEntryKey(pubKeyHash account.PubKeyHash, seq uint64) []byte {
+	return append(append(getCountKey(pubKeyHash), '_'), util.UintToHex(seq)...)
+}
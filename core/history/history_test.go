@@ -0,0 +1,74 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package history
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestEntry(txid string, height uint64, entryType EntryType) *Entry {
+	return &Entry{
+		Txid:        []byte(txid),
+		BlockHeight: height,
+		Type:        entryType,
+		OutputTxid:  []byte(txid),
+		Value:       common.NewAmount(height),
+	}
+}
+
+func TestAppendAndTruncateEntries(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	pubKeyHash := account.PubKeyHash([]byte("pubKeyHash"))
+
+	assert.Equal(t, uint64(0), GetNumOfEntries(pubKeyHash, db))
+	entries := []*Entry{
+		newTestEntry("tx1", 1, EntryReceived),
+		newTestEntry("tx2", 3, EntrySpent),
+		newTestEntry("tx3", 3, EntryReceived),
+	}
+	assert.Nil(t, AppendEntries(pubKeyHash, entries[:1], db))
+	assert.Nil(t, AppendEntries(pubKeyHash, entries[1:], db))
+	assert.Equal(t, uint64(3), GetNumOfEntries(pubKeyHash, db))
+	for i, entry := range entries {
+		retrieved, err := GetEntry(pubKeyHash, uint64(i), db)
+		assert.Nil(t, err)
+		assert.Equal(t, entry, retrieved)
+	}
+
+	seq, err := FindEntry(pubKeyHash, 2, db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), seq)
+	seq, err = FindEntry(pubKeyHash, 4, db)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(3), seq)
+
+	assert.Nil(t, TruncateEntries(pubKeyHash, 2, db))
+	assert.Equal(t, uint64(1), GetNumOfEntries(pubKeyHash, db))
+	_, err = GetEntry(pubKeyHash, 1, db)
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+
+	assert.Nil(t, TruncateEntries(pubKeyHash, 0, db))
+	assert.Equal(t, uint64(0), GetNumOfEntries(pubKeyHash, db))
+}
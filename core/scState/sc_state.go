@@ -1,6 +1,7 @@
 package scState
 
 import (
+	"strings"
 	"sync"
 
 	"github.com/dappley/go-dappley/common/hash"
@@ -15,23 +16,38 @@ type ChangeLog struct {
 	log map[string]map[string]string
 }
 
+// ScState is the storage of the smart contracts. Every item of a contract is kept under its own key in the database
+// and is only read when a contract touches it. The items written since the state was loaded form the write set that
+// is saved with the next block, so that the cost of a block scales with the items it touches.
 type ScState struct {
+	db     storage.Storage
 	states map[string]map[string]string
+	dirty  map[string]map[string]bool
+	loaded map[string]bool
 	events []*Event
 	mutex  *sync.RWMutex
 }
 
 const (
-	scStateLogKey = "scLog"
-	scStateMapKey = "scState"
+	scStateLogKey        = "scLog"
+	scStateItemKeyPrefix = "scItem_"
+	//legacyScStateMapKey is the key of the whole contract storage serialized in one value by earlier versions
+	legacyScStateMapKey = "scState"
 )
 
 func NewChangeLog() *ChangeLog {
 	return &ChangeLog{make(map[string]map[string]string)}
 }
 
+//NewScState returns an empty state that is kept in memory only
 func NewScState() *ScState {
-	return &ScState{make(map[string]map[string]string), make([]*Event, 0), &sync.RWMutex{}}
+	return &ScState{
+		states: make(map[string]map[string]string),
+		dirty:  make(map[string]map[string]bool),
+		loaded: make(map[string]bool),
+		events: make([]*Event, 0),
+		mutex:  &sync.RWMutex{},
+	}
 }
 
 func (ss *ScState) GetEvents() []*Event { return ss.events }
@@ -63,21 +79,16 @@ func (ss *ScState) serialize() []byte {
 func (ss *ScState) Get(address, key string) string {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
-	if len(ss.states[address]) == 0 {
-		return ""
-	}
-	return ss.states[address][key]
+	return ss.get(address, key)
 }
 
 //GetByValue gets an item in scStorage by the value
 func (ss *ScState) GetByValue(address, value string) string {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
-	if len(ss.states[address]) == 0 {
-		return ""
-	}
+	ss.loadAddress(address)
 	for key, val := range ss.states[address] {
-		if val == value {
+		if val != "" && val == value {
 			return key
 		}
 	}
@@ -88,12 +99,7 @@ func (ss *ScState) GetByValue(address, value string) string {
 func (ss *ScState) Set(address, key, value string) int {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
-
-	if len(ss.states[address]) == 0 {
-		ls := make(map[string]string)
-		ss.states[address] = ls
-	}
-	ss.states[address][key] = value
+	ss.set(address, key, value)
 	return 0
 }
 
@@ -101,58 +107,224 @@ func (ss *ScState) Set(address, key, value string) int {
 func (ss *ScState) Del(pubKeyHash, key string) int {
 	ss.mutex.Lock()
 	defer ss.mutex.Unlock()
-	if len(ss.states[pubKeyHash]) == 0 {
-		return 1
-	}
-	if ss.states[pubKeyHash][key] == "" {
+	if ss.get(pubKeyHash, key) == "" {
 		return 1
 	}
-
-	delete(ss.states[pubKeyHash], key)
+	ss.set(pubKeyHash, key, "")
 	return 0
 }
 
-//GetStorageByAddress gets a storage map by address
+//GetStorageByAddress returns a copy of all items of the contract
 func (ss *ScState) GetStorageByAddress(address string) map[string]string {
-	if len(ss.states[address]) == 0 {
-		//initializes the map with dummy data
-		ss.states[address] = map[string]string{"init": "i"}
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	ss.loadAddress(address)
+	storageMap := make(map[string]string)
+	for key, value := range ss.states[address] {
+		if value != "" {
+			storageMap[key] = value
+		}
 	}
-	return ss.states[address]
+	return storageMap
+}
+
+//get returns the item from the items read or written before, or from the database
+func (ss *ScState) get(address, key string) string {
+	if value, ok := ss.states[address][key]; ok {
+		return value
+	}
+	if ss.db == nil || ss.loaded[address] {
+		return ""
+	}
+	rawBytes, err := ss.db.Get(getItemKey(address, key))
+	if err != nil {
+		rawBytes = nil
+	}
+	ss.cache(address, key, string(rawBytes))
+	return string(rawBytes)
+}
+
+//set writes the item into the write set. An empty value removes the item
+func (ss *ScState) set(address, key, value string) {
+	ss.cache(address, key, value)
+	if ss.dirty[address] == nil {
+		ss.dirty[address] = make(map[string]bool)
+	}
+	ss.dirty[address][key] = true
+}
+
+func (ss *ScState) cache(address, key, value string) {
+	if ss.states[address] == nil {
+		ss.states[address] = make(map[string]string)
+	}
+	ss.states[address][key] = value
+}
+
+//loadAddress reads all items of the contract from the database. The items read or written before are kept
+func (ss *ScState) loadAddress(address string) {
+	if ss.db == nil || ss.loaded[address] {
+		return
+	}
+	prefix := getItemKey(address, "")
+	it := ss.db.NewPrefixIterator(prefix)
+	defer it.Release()
+	for it.Next() {
+		key := string(it.Key()[len(prefix):])
+		if _, ok := ss.states[address][key]; !ok {
+			ss.cache(address, key, string(it.Value()))
+		}
+	}
+	ss.loaded[address] = true
+}
+
+func getItemKey(address, key string) []byte {
+	return []byte(scStateItemKeyPrefix + address + "_" + key)
 }
 
-func GetScStateKey(blkHash hash.Hash) []byte {
-	return []byte(scStateMapKey)
+//parseItemKey returns the address and the key of the item saved under the database key
+func parseItemKey(itemKey []byte) (string, string, bool) {
+	if !strings.HasPrefix(string(itemKey), scStateItemKeyPrefix) {
+		return "", "", false
+	}
+	s := strings.SplitN(string(itemKey[len(scStateItemKeyPrefix):]), "_", 2)
+	if len(s) != 2 {
+		return "", "", false
+	}
+	return s[0], s[1], true
 }
 
-//LoadScStateFromDatabase loads states from database
+//LoadScStateFromDatabase returns the state saved in the database. The items are read when they are used
 func LoadScStateFromDatabase(db storage.Storage) *ScState {
+	ss := NewScState()
+	ss.db = db
+	return ss
+}
 
-	rawBytes, err := db.Get([]byte(scStateMapKey))
+//MigrateLegacyState moves the contract storage serialized in one value by earlier versions to keyed items
+func MigrateLegacyState(db storage.Storage) error {
+	rawBytes, err := db.Get([]byte(legacyScStateMapKey))
+	if err != nil || len(rawBytes) == 0 {
+		return nil
+	}
 
-	if err != nil && err.Error() == storage.ErrKeyInvalid.Error() || len(rawBytes) == 0 {
-		return NewScState()
+	legacyState := deserializeScState(rawBytes)
+	batch := db.NewBatch()
+	numItems := 0
+	for address, items := range legacyState.states {
+		for key, value := range items {
+			if value == "" {
+				continue
+			}
+			if err := batch.Put(getItemKey(address, key), []byte(value)); err != nil {
+				return err
+			}
+			numItems++
+		}
 	}
-	return deserializeScState(rawBytes)
+	if err := batch.Del([]byte(legacyScStateMapKey)); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	logger.WithFields(logger.Fields{
+		"num_items": numItems,
+	}).Info("ScState: converted the contract storage to keyed items.")
+	return nil
 }
 
-//SaveToDatabase saves states to database directly
+//ClearDatabase removes all items and changelogs of the contract storage from the database in chunks of bounded size.
+//It returns the number of removed keys
+func ClearDatabase(db storage.Storage) (int, error) {
+	batch := storage.NewChunkedBatch(db, storage.DefaultChunkLen)
+	numOfKey := 0
+	for _, prefix := range []string{scStateItemKeyPrefix, scStateLogKey} {
+		it := db.NewPrefixIterator([]byte(prefix))
+		for it.Next() {
+			if err := batch.Del(append([]byte{}, it.Key()...)); err != nil {
+				it.Release()
+				return 0, err
+			}
+			numOfKey++
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return 0, err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return 0, err
+	}
+	return numOfKey, nil
+}
+
+//SaveToDatabase writes the items written since the state was loaded into the database, without a changelog
 func (ss *ScState) SaveToDatabase(db storage.Storage) error {
-	return db.Put([]byte(scStateMapKey), ss.serialize())
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+
+	batch := db.NewBatch()
+	if err := ss.addWritesToBatch(batch); err != nil {
+		return err
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	ss.onSaved(db)
+	return nil
 }
 
+//ToProto returns all items of the state, including the items in the database that have not been read
 func (ss *ScState) ToProto() proto.Message {
-	scState := make(map[string]*scstatepb.State)
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
 
-	for key, val := range ss.states {
-		scState[key] = &scstatepb.State{State: val}
+	items := make(map[string]map[string]string)
+	if ss.db != nil {
+		it := ss.db.NewPrefixIterator([]byte(scStateItemKeyPrefix))
+		for it.Next() {
+			if address, key, ok := parseItemKey(it.Key()); ok {
+				if items[address] == nil {
+					items[address] = make(map[string]string)
+				}
+				items[address][key] = string(it.Value())
+			}
+		}
+		it.Release()
+	}
+	for address, addressItems := range ss.states {
+		for key, value := range addressItems {
+			if items[address] == nil {
+				items[address] = make(map[string]string)
+			}
+			items[address][key] = value
+		}
+	}
+
+	scState := make(map[string]*scstatepb.State)
+	for address, addressItems := range items {
+		state := make(map[string]string)
+		for key, value := range addressItems {
+			if value != "" {
+				state[key] = value
+			}
+		}
+		if len(state) > 0 {
+			scState[address] = &scstatepb.State{State: state}
+		}
 	}
 	return &scstatepb.ScState{States: scState}
 }
 
+//FromProto writes the items into the state
 func (ss *ScState) FromProto(pb proto.Message) {
-	for key, val := range pb.(*scstatepb.ScState).States {
-		ss.states[key] = val.State
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	for address, val := range pb.(*scstatepb.ScState).States {
+		for key, value := range val.State {
+			ss.set(address, key, value)
+		}
 	}
 }
 
@@ -171,23 +343,84 @@ func (cl *ChangeLog) FromProto(pb proto.Message) {
 	}
 }
 
-// Save data with change logs
+//Save writes the items written since the state was loaded into the database. The previous values of the items are
+//saved as the changelog of the block, so that the state can be reverted to the parent of the block
 func (ss *ScState) Save(db storage.Storage, blkHash hash.Hash) error {
-	scStateOld := LoadScStateFromDatabase(db)
-	change := NewChangeLog()
-	change.log = scStateOld.findChangedValue(ss)
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	if len(ss.dirty) == 0 {
+		return nil
+	}
 
-	err := db.Put([]byte(scStateLogKey+blkHash.String()), change.serializeChangeLog())
-	if err != nil {
+	batch := db.NewBatch()
+	changelog := ss.getWriteSetChangeLog(db)
+	if len(changelog.log) > 0 {
+		if err := batch.Put([]byte(scStateLogKey+blkHash.String()), changelog.serializeChangeLog()); err != nil {
+			return err
+		}
+	}
+	if err := ss.addWritesToBatch(batch); err != nil {
 		return err
 	}
-
-	err = db.Put([]byte(scStateMapKey), ss.serialize())
-	if err != nil {
+	if batch.Len() == 0 {
+		return nil
+	}
+	if err := batch.Write(); err != nil {
 		return err
 	}
+	ss.onSaved(db)
+	return nil
+}
 
-	return err
+//getWriteSetChangeLog returns the values in the database of the items in the write set that are changed
+func (ss *ScState) getWriteSetChangeLog(db storage.Storage) *ChangeLog {
+	changelog := NewChangeLog()
+	for address, keys := range ss.dirty {
+		for key := range keys {
+			rawBytes, err := db.Get(getItemKey(address, key))
+			if err != nil {
+				rawBytes = nil
+			}
+			if string(rawBytes) == ss.states[address][key] {
+				continue
+			}
+			if changelog.log[address] == nil {
+				changelog.log[address] = make(map[string]string)
+			}
+			changelog.log[address][key] = string(rawBytes)
+		}
+	}
+	return changelog
+}
+
+func (ss *ScState) addWritesToBatch(batch storage.Batch) error {
+	for address, keys := range ss.dirty {
+		for key := range keys {
+			var err error
+			if value := ss.states[address][key]; value == "" {
+				err = batch.Del(getItemKey(address, key))
+			} else {
+				err = batch.Put(getItemKey(address, key), []byte(value))
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//onSaved empties the write set after it is saved into the database, which the state reads from afterwards. The state
+//reads from the storage under an overlay, as the overlay is only a buffer of the writes to it
+func (ss *ScState) onSaved(db storage.Storage) {
+	if overlay, ok := db.(*storage.Overlay); ok {
+		db = overlay.GetStorage()
+	}
+	ss.dirty = make(map[string]map[string]bool)
+	if ss.db != db {
+		ss.db = db
+		ss.loaded = make(map[string]bool)
+	}
 }
 
 func (ss *ScState) RevertState(db storage.Storage, prevHash hash.Hash) error {
@@ -195,70 +428,51 @@ func (ss *ScState) RevertState(db storage.Storage, prevHash hash.Hash) error {
 	if len(changelog) < 1 {
 		return nil
 	}
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
 	ss.revertState(changelog)
-	//err := deleteLog(db, prevHash)
-	//if err != nil {
-	//	return err
-	//}
-
 	return nil
 }
 
-func (ss *ScState) findChangedValue(newState *ScState) map[string]map[string]string {
-	change := make(map[string]map[string]string)
-
-	for address, newMap := range newState.states {
-		if oldMap, ok := ss.states[address]; !ok {
-			change[address] = nil
-		} else {
-			ls := make(map[string]string)
-			for key, value := range oldMap {
-				if newValue, ok := newMap[key]; ok {
-					if newValue != value {
-						ls[key] = value
-					}
-				} else {
-					ls[key] = value
-				}
-			}
+//GetChanges returns the items of the new state that differ from the receiver with their new values, keyed by address.
+//A removed item has an empty value. Only the items written in the new state are compared
+func (ss *ScState) GetChanges(newState *ScState) map[string]map[string]string {
+	ss.mutex.Lock()
+	defer ss.mutex.Unlock()
+	if ss != newState {
+		newState.mutex.Lock()
+		defer newState.mutex.Unlock()
+	}
 
-			for key, value := range newMap {
-				if oldMap[key] != value {
-					ls[key] = oldMap[key]
-				}
+	changes := make(map[string]map[string]string)
+	for address, keys := range newState.dirty {
+		for key := range keys {
+			value := newState.states[address][key]
+			if ss.get(address, key) == value {
+				continue
 			}
-
-			if len(ls) > 0 {
-				change[address] = ls
+			if changes[address] == nil {
+				changes[address] = make(map[string]string)
 			}
+			changes[address][key] = value
 		}
 	}
-
-	for address, oldMap := range ss.states {
-		if _, ok := newState.states[address]; !ok {
-			change[address] = oldMap
-		}
-	}
-
-	return change
+	return changes
 }
 
+//revertState writes the previous values in the changelog. A contract without previous values was created in the block
+//by earlier versions, and all its items are removed
 func (ss *ScState) revertState(changelog map[string]map[string]string) {
 	for address, pair := range changelog {
 		if pair == nil {
-			delete(ss.states, address)
-		} else {
-			if _, ok := ss.states[address]; !ok {
-				ss.states[address] = pair
-			} else {
-				for key, value := range pair {
-					if value != "" {
-						ss.states[address][key] = value
-					} else {
-						delete(ss.states[address], key)
-					}
-				}
+			ss.loadAddress(address)
+			for key := range ss.states[address] {
+				ss.set(address, key, "")
 			}
+			continue
+		}
+		for key, value := range pair {
+			ss.set(address, key, value)
 		}
 	}
 }
@@ -276,8 +490,9 @@ func getChangeLog(db storage.Storage, prevHash hash.Hash) map[string]map[string]
 	return change
 }
 
-func deleteLog(db storage.Storage, prevHash hash.Hash) error {
-	err := db.Del([]byte(scStateLogKey + prevHash.String()))
+//DeleteLog adds the removal of the changelog saved with the block of the input hash to the batch
+func DeleteLog(batch storage.Batch, blkHash hash.Hash) error {
+	err := batch.Del([]byte(scStateLogKey + blkHash.String()))
 	return err
 }
 
@@ -301,15 +516,27 @@ func (cl *ChangeLog) serializeChangeLog() []byte {
 }
 
 func (scState *ScState) DeepCopy() *ScState {
-	newScState := &ScState{make(map[string]map[string]string), make([]*Event, 0), &sync.RWMutex{}}
+	scState.mutex.Lock()
+	defer scState.mutex.Unlock()
 
+	newScState := NewScState()
+	newScState.db = scState.db
 	for address, addressState := range scState.states {
 		newAddressState := make(map[string]string)
 		for key, value := range addressState {
 			newAddressState[key] = value
 		}
-
-		newScState.states[address] = addressState
+		newScState.states[address] = newAddressState
+	}
+	for address, keys := range scState.dirty {
+		newKeys := make(map[string]bool)
+		for key := range keys {
+			newKeys[key] = true
+		}
+		newScState.dirty[address] = newKeys
+	}
+	for address := range scState.loaded {
+		newScState.loaded[address] = true
 	}
 
 	for _, event := range scState.events {
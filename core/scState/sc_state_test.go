@@ -4,6 +4,7 @@ import (
 	"encoding/hex"
 	"testing"
 
+	"github.com/dappley/go-dappley/common/hash"
 	scstatepb "github.com/dappley/go-dappley/core/scState/pb"
 	"github.com/dappley/go-dappley/storage"
 	proto "github.com/golang/protobuf/proto"
@@ -12,9 +13,7 @@ import (
 
 func TestScState_Serialize(t *testing.T) {
 	ss := NewScState()
-	ls := make(map[string]string)
-	ls["key1"] = "value1"
-	ss.states["addr1"] = ls
+	ss.Set("addr1", "key1", "value1")
 	rawBytes := ss.serialize()
 	ssRet := deserializeScState(rawBytes)
 	assert.Equal(t, ss.states, ssRet.states)
@@ -22,9 +21,7 @@ func TestScState_Serialize(t *testing.T) {
 
 func TestScState_Get(t *testing.T) {
 	ss := NewScState()
-	ls := make(map[string]string)
-	ls["key1"] = "value1"
-	ss.states["addr1"] = ls
+	ss.Set("addr1", "key1", "value1")
 	assert.Equal(t, "value1", ss.Get("addr1", "key1"))
 }
 
@@ -36,11 +33,10 @@ func TestScState_Set(t *testing.T) {
 
 func TestScState_Del(t *testing.T) {
 	ss := NewScState()
-	ls := make(map[string]string)
-	ls["key1"] = "value1"
-	ss.states["addr1"] = ls
-	ss.Del("addr1", "key1")
+	ss.Set("addr1", "key1", "value1")
+	assert.Equal(t, 0, ss.Del("addr1", "key1"))
 	assert.Equal(t, "", ss.Get("addr1", "key1"))
+	assert.Equal(t, 1, ss.Del("addr1", "key1"))
 }
 
 func TestScState_LoadFromDatabase(t *testing.T) {
@@ -77,122 +73,147 @@ func TestScState_FromProto(t *testing.T) {
 	assert.Equal(t, ss1, ss)
 }
 func TestScState_RevertState(t *testing.T) {
-
 	ss := NewScState()
-	ls := make(map[string]string)
-	ls["key1"] = "value1"
-	ss.states["addr1"] = ls
-
-	changeLog1 := make(map[string]map[string]string)
-	changeLog2 := make(map[string]map[string]string)
-	changeLog3 := make(map[string]map[string]string)
-
-	changePair1 := make(map[string]string)
-	changePair2 := make(map[string]string)
-	changePair3 := make(map[string]string)
-	changePair4 := make(map[string]string)
-
-	expect1 := make(map[string]map[string]string)
-	expect2 := make(map[string]map[string]string)
-	expect3 := make(map[string]map[string]string)
-
-	changePair1["key1"] = "2"
-	changePair2["key3"] = "3"
-	changePair3["key4"] = "4"
-	changePair4["key1"] = "2"
-	changePair4["key4"] = "4"
+	ss.Set("addr1", "key1", "value1")
+
+	ss.revertState(map[string]map[string]string{"addr1": {"key1": "2"}})
+	assert.Equal(t, "2", ss.Get("addr1", "key1"))
+
+	ss.revertState(map[string]map[string]string{
+		"addr1": {"key4": "4"},
+		"addr2": {"key3": "3"},
+	})
+	assert.Equal(t, map[string]string{"key1": "2", "key4": "4"}, ss.GetStorageByAddress("addr1"))
+	assert.Equal(t, map[string]string{"key3": "3"}, ss.GetStorageByAddress("addr2"))
+
+	//an empty value removes the item and a contract without previous values is removed
+	ss.revertState(map[string]map[string]string{
+		"addr1": {"key4": ""},
+		"addr2": nil,
+	})
+	assert.Equal(t, map[string]string{"key1": "2"}, ss.GetStorageByAddress("addr1"))
+	assert.Empty(t, ss.GetStorageByAddress("addr2"))
+}
 
-	expect1["addr1"] = changePair1
+func TestScState_SaveAndRevert(t *testing.T) {
+	db := storage.NewRamStorage()
+	ss := LoadScStateFromDatabase(db)
+	ss.Set("addr1", "key1", "value1")
+	ss.Set("addr1", "key2", "value2")
+	assert.Nil(t, ss.Save(db, hash.Hash("blk1")))
+	assert.Equal(t, map[string]map[string]string{"addr1": {"key1": "", "key2": ""}}, getChangeLog(db, hash.Hash("blk1")))
+
+	//the changelog of a block only holds the items the block changes
+	ss = LoadScStateFromDatabase(db)
+	ss.Set("addr1", "key1", "4")
+	ss.Set("addr1", "key2", "value2")
+	ss.Del("addr1", "key3")
+	ss.Set("addr2", "key1", "value1")
+	assert.Nil(t, ss.Save(db, hash.Hash("blk2")))
+	assert.Equal(t, map[string]map[string]string{
+		"addr1": {"key1": "value1"},
+		"addr2": {"key1": ""},
+	}, getChangeLog(db, hash.Hash("blk2")))
+
+	ss = LoadScStateFromDatabase(db)
+	assert.Equal(t, "4", ss.Get("addr1", "key1"))
+	assert.Equal(t, "value1", ss.Get("addr2", "key1"))
+
+	assert.Nil(t, ss.RevertState(db, hash.Hash("blk2")))
+	assert.Equal(t, "value1", ss.Get("addr1", "key1"))
+	assert.Equal(t, "", ss.Get("addr2", "key1"))
+	assert.Nil(t, ss.SaveToDatabase(db))
+	_, err := db.Get(getItemKey("addr2", "key1"))
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+
+	//a block without changes saves nothing
+	ss = LoadScStateFromDatabase(db)
+	ss.Set("addr1", "key2", "value2")
+	assert.Nil(t, ss.Save(db, hash.Hash("blk3")))
+	_, err = db.Get([]byte(scStateLogKey + hash.Hash("blk3").String()))
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+}
 
-	expect2["addr1"] = changePair4
-	expect2["addr2"] = changePair2
+func TestScState_LazyLoad(t *testing.T) {
+	db := storage.NewRamStorage()
+	ss := NewScState()
+	ss.Set("addr1", "key1", "value1")
+	ss.Set("addr1", "key2", "value2")
+	ss.Set("addr2", "key1", "value3")
+	assert.Nil(t, ss.SaveToDatabase(db))
 
-	changeLog1["addr1"] = changePair1
-	ss.revertState(changeLog1)
-	assert.Equal(t, expect1, ss.states)
+	ss = LoadScStateFromDatabase(db)
+	assert.Empty(t, ss.states)
+	assert.Equal(t, "value1", ss.Get("addr1", "key1"))
+	assert.Equal(t, map[string]map[string]string{"addr1": {"key1": "value1"}}, ss.states)
+
+	ss.Set("addr1", "key3", "value4")
+	assert.Equal(t, "key2", ss.GetByValue("addr1", "value2"))
+	assert.Equal(t, map[string]string{"key1": "value1", "key2": "value2", "key3": "value4"}, ss.GetStorageByAddress("addr1"))
+
+	//the proto holds the items in the database and the written items
+	ss.Del("addr2", "key1")
+	expected := NewScState()
+	expected.Set("addr1", "key1", "value1")
+	expected.Set("addr1", "key2", "value2")
+	expected.Set("addr1", "key3", "value4")
+	assert.Equal(t, expected.ToProto(), ss.ToProto())
+}
 
-	changeLog2["addr2"] = changePair2
-	changeLog2["addr1"] = changePair3
-	ss.revertState(changeLog2)
-	assert.Equal(t, expect2, ss.states)
+func TestScState_MigrateLegacyState(t *testing.T) {
+	db := storage.NewRamStorage()
+	legacyState := NewScState()
+	legacyState.Set("addr1", "key1", "value1")
+	legacyState.Set("addr2", "key1", "value2")
+	assert.Nil(t, db.Put([]byte(legacyScStateMapKey), legacyState.serialize()))
 
-	changeLog3["addr2"] = nil
-	changeLog3["addr1"] = nil
-	ss.revertState(changeLog3)
-	assert.Equal(t, expect3, ss.states)
-	assert.Equal(t, 0, len(ss.states))
+	assert.Nil(t, MigrateLegacyState(db))
+	ss := LoadScStateFromDatabase(db)
+	assert.Equal(t, "value1", ss.Get("addr1", "key1"))
+	assert.Equal(t, "value2", ss.Get("addr2", "key1"))
+	_, err := db.Get([]byte(legacyScStateMapKey))
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+}
 
+func TestClearDatabase(t *testing.T) {
+	db := storage.NewRamStorage()
+	ss := LoadScStateFromDatabase(db)
+	ss.Set("addr1", "key1", "value1")
+	assert.Nil(t, ss.Save(db, []byte("blk1")))
+	ss.Set("addr1", "key1", "value2")
+	ss.Set("addr2", "key1", "value3")
+	assert.Nil(t, ss.Save(db, []byte("blk2")))
+	assert.Nil(t, db.Put([]byte("other"), []byte("value")))
+
+	//the two items and the changelogs of both blocks are removed
+	numOfKey, err := ClearDatabase(db)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, numOfKey)
+	assert.Equal(t, "", LoadScStateFromDatabase(db).Get("addr1", "key1"))
+	_, err = db.Get([]byte("other"))
+	assert.Nil(t, err)
 }
 
-func TestScState_FindChangedValue(t *testing.T) {
-	newSS := NewScState()
+func TestScState_GetChanges(t *testing.T) {
 	oldSS := NewScState()
-
-	ls1 := make(map[string]string)
-	ls2 := make(map[string]string)
-	ls3 := make(map[string]string)
-
-	ls1["key1"] = "value1"
-	ls1["key2"] = "value2"
-	ls1["key3"] = "value3"
-
-	ls2["key1"] = "value1"
-	ls2["key2"] = "value2"
-	ls2["key3"] = "4"
-
-	ls3["key1"] = "value1"
-	ls3["key3"] = "4"
-
-	expect1 := make(map[string]map[string]string)
-	expect2 := make(map[string]map[string]string)
-	expect3 := make(map[string]map[string]string)
-	expect4 := make(map[string]map[string]string)
-	expect5 := make(map[string]map[string]string)
-	expect6 := make(map[string]map[string]string)
-
-	expect2["address1"] = nil
-	expect4["address1"] = map[string]string{
-		"key2": "value2",
-		"key3": "value3",
-	}
-
-	expect5["address1"] = map[string]string{
-		"key2": "value2",
-		"key3": "value3",
+	oldSS.Set("address1", "key1", "value1")
+	oldSS.Set("address1", "key2", "value2")
+	oldSS.Set("address2", "key1", "value1")
+
+	newSS := oldSS.DeepCopy()
+	assert.Empty(t, oldSS.GetChanges(newSS))
+
+	newSS.Set("address1", "key1", "4")
+	newSS.Del("address1", "key2")
+	newSS.Set("address3", "key1", "value1")
+	newSS.Del("address2", "key1")
+
+	expected := map[string]map[string]string{
+		"address1": {"key1": "4", "key2": ""},
+		"address2": {"key1": ""},
+		"address3": {"key1": "value1"},
 	}
-
-	expect5["address2"] = nil
-
-	expect6["address2"] = ls2
-
-	change1 := oldSS.findChangedValue(newSS)
-	assert.Equal(t, expect1, change1)
-
-	newSS.states["address1"] = ls1
-	change2 := oldSS.findChangedValue(newSS)
-	assert.Equal(t, 1, len(change2))
-	assert.Equal(t, expect2, change2)
-
-	oldSS.states["address1"] = ls1
-	change3 := oldSS.findChangedValue(newSS)
-	assert.Equal(t, 0, len(change3))
-	assert.Equal(t, expect3, change3)
-
-	newSS.states["address1"] = ls3
-	change4 := oldSS.findChangedValue(newSS)
-	assert.Equal(t, 1, len(change4))
-	assert.Equal(t, expect4, change4)
-
-	newSS.states["address2"] = ls2
-	change5 := oldSS.findChangedValue(newSS)
-	assert.Equal(t, 2, len(change5))
-	assert.Equal(t, expect5, change5)
-
-	oldSS.states["address2"] = ls2
-	oldSS.states["address1"] = ls3
-	delete(newSS.states, "address2")
-	change6 := oldSS.findChangedValue(newSS)
-	assert.Equal(t, 1, len(change6))
-	assert.Equal(t, expect6, change6)
-
+	assert.Equal(t, expected, oldSS.GetChanges(newSS))
+	//the copy does not share the storage of the contracts
+	assert.Equal(t, "value1", oldSS.Get("address1", "key1"))
 }
@@ -0,0 +1,289 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package state
+
+import (
+	"bytes"
+	"errors"
+	"sort"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/trie"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	transactionbasepb "github.com/dappley/go-dappley/core/transactionbase/pb"
+	cryptohash "github.com/dappley/go-dappley/crypto/hash"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+)
+
+var (
+	utxoKeyPrefix            = []byte("utxo")
+	contractStorageKeyPrefix = []byte("sc")
+	stateRootKeyPrefix       = []byte("stateroot")
+)
+
+var (
+	ErrUTXONotInState    = errors.New("state trie: spent utxo is not in the state")
+	ErrProofValueInvalid = errors.New("state trie: proved value does not match")
+	ErrStateRootNotFound = errors.New("state trie: the state root of the block is not saved")
+)
+
+// StateTrie commits the UTXO set and the contract storage of the blockchain into a Merkle-Patricia trie, whose root
+// hash is stored in the block header as the state root. The trie nodes are content addressed and never modified, so
+// the state of every block stays available under its own root.
+type StateTrie struct {
+	trie *trie.Trie
+}
+
+//NewStateTrie returns the state trie with the input root in the database. An empty root stands for the empty state
+func NewStateTrie(root hash.Hash, db storage.Storage) (*StateTrie, error) {
+	t, err := trie.NewTrie(root, db, false)
+	if err != nil {
+		return nil, err
+	}
+	return &StateTrie{trie: t}, nil
+}
+
+//SaveStateRoot saves the state root after the block with the input hash. The roots are kept for every block, including
+//the blocks that do not carry their state root in the header
+func SaveStateRoot(db storage.Storage, blkHash hash.Hash, root hash.Hash) error {
+	return db.Put(getStateRootKey(blkHash), root)
+}
+
+//GetStateRoot returns the saved state root after the block with the input hash
+func GetStateRoot(db storage.Storage, blkHash hash.Hash) (hash.Hash, error) {
+	root, err := db.Get(getStateRootKey(blkHash))
+	if err != nil {
+		return nil, ErrStateRootNotFound
+	}
+	return root, nil
+}
+
+//RootHash returns the root hash of the state
+func (st *StateTrie) RootHash() hash.Hash {
+	return st.trie.RootHash()
+}
+
+//PutUTXO adds the output of the transaction to the state
+func (st *StateTrie) PutUTXO(txid []byte, vout int, txout *transactionbase.TXOutput) error {
+	value, err := proto.Marshal(txout.ToProto())
+	if err != nil {
+		return err
+	}
+	_, err = st.trie.Put(getUTXOKey(txid, vout), value)
+	return err
+}
+
+//DelUTXO removes the output of the transaction from the state
+func (st *StateTrie) DelUTXO(txid []byte, vout int) error {
+	if _, err := st.trie.Del(getUTXOKey(txid, vout)); err != nil {
+		if err == trie.ErrNotFound || err == storage.ErrKeyInvalid {
+			return ErrUTXONotInState
+		}
+		return err
+	}
+	return nil
+}
+
+//PutContractStorage sets the value of the key in the storage of the contract
+func (st *StateTrie) PutContractStorage(address, key, value string) error {
+	_, err := st.trie.Put(getContractStorageKey(address, key), []byte(value))
+	return err
+}
+
+//GetContractStorage returns the value of the key in the storage of the contract, or an empty string if the key is
+//not in the state
+func (st *StateTrie) GetContractStorage(address, key string) (string, error) {
+	value, err := st.trie.Get(getContractStorageKey(address, key))
+	if err == trie.ErrNotFound || err == storage.ErrKeyInvalid {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+//DelContractStorage removes the key from the storage of the contract. It does nothing if the key is not in the state
+func (st *StateTrie) DelContractStorage(address, key string) error {
+	if _, err := st.trie.Del(getContractStorageKey(address, key)); err != nil && err != trie.ErrNotFound {
+		return err
+	}
+	return nil
+}
+
+//ApplyTransactions spends the inputs and adds the outputs of the transactions in order. The inputs are spent for the
+//same transactions as in the UTXO index
+func (st *StateTrie) ApplyTransactions(txs []*transaction.Transaction) error {
+	for _, tx := range txs {
+		adaptedTx := transaction.NewTxAdapter(tx)
+		if adaptedTx.IsNormal() || adaptedTx.IsContract() || adaptedTx.IsContractSend() || adaptedTx.IsVote() {
+			for _, vin := range tx.Vin {
+				if err := st.DelUTXO(vin.Txid, vin.Vout); err != nil {
+					return err
+				}
+			}
+		}
+		for i := range tx.Vout {
+			if err := st.PutUTXO(tx.ID, i, &tx.Vout[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+//ApplyContractStorage updates the contract storage of the state with the changes from the old storage to the new one.
+//The changes are keyed by contract address, and a missing value stands for a removed key
+func (st *StateTrie) ApplyContractStorage(changes map[string]map[string]string) error {
+	addresses := make([]string, 0, len(changes))
+	for address := range changes {
+		addresses = append(addresses, address)
+	}
+	sort.Strings(addresses)
+
+	for _, address := range addresses {
+		keys := make([]string, 0, len(changes[address]))
+		for key := range changes[address] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			value := changes[address][key]
+			var err error
+			if value == "" {
+				err = st.DelContractStorage(address, key)
+			} else {
+				err = st.PutContractStorage(address, key, value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func getStateRootKey(blkHash hash.Hash) []byte {
+	return append(append([]byte{}, stateRootKeyPrefix...), blkHash...)
+}
+
+//GetUTXOOutputs returns the outputs of all UTXOs in the state. The contract storage shares the trie with the UTXOs, so
+//the keys of the input contract storage, which is keyed by contract address, are skipped
+func (st *StateTrie) GetUTXOOutputs(contractStorage map[string]map[string]string) ([]*transactionbase.TXOutput, error) {
+	if len(st.trie.RootHash()) == 0 {
+		return nil, nil
+	}
+	storageKeys := make(map[string]bool)
+	for address, values := range contractStorage {
+		for key := range values {
+			storageKeys[string(getContractStorageKey(address, key))] = true
+		}
+	}
+
+	it, err := st.trie.Iterator(nil)
+	if err != nil {
+		return nil, err
+	}
+	txouts := []*transactionbase.TXOutput{}
+	for {
+		ok, err := it.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			break
+		}
+		if storageKeys[string(it.Key())] {
+			continue
+		}
+		txoutPb := &transactionbasepb.TXOutput{}
+		if err := proto.Unmarshal(it.Value(), txoutPb); err != nil {
+			return nil, err
+		}
+		txout := &transactionbase.TXOutput{}
+		txout.FromProto(txoutPb)
+		txouts = append(txouts, txout)
+	}
+	return txouts, nil
+}
+
+//ProveUTXO returns the Merkle proof of the output of the transaction in the state
+func (st *StateTrie) ProveUTXO(txid []byte, vout int) (trie.MerkleProof, error) {
+	return st.trie.Prove(getUTXOKey(txid, vout))
+}
+
+//ProveContractStorage returns the Merkle proof of the key in the storage of the contract
+func (st *StateTrie) ProveContractStorage(address, key string) (trie.MerkleProof, error) {
+	return st.trie.Prove(getContractStorageKey(address, key))
+}
+
+//VerifyUTXOProof checks that the output of the transaction is in the state with the input root
+func VerifyUTXOProof(root hash.Hash, txid []byte, vout int, txout *transactionbase.TXOutput, proof trie.MerkleProof) error {
+	value, err := proto.Marshal(txout.ToProto())
+	if err != nil {
+		return err
+	}
+	return verifyProof(root, getUTXOKey(txid, vout), value, proof)
+}
+
+//VerifyContractStorageProof checks that the key has the value in the storage of the contract in the state with the
+//input root
+func VerifyContractStorageProof(root hash.Hash, address, key, value string, proof trie.MerkleProof) error {
+	return verifyProof(root, getContractStorageKey(address, key), []byte(value), proof)
+}
+
+//verifyProof checks that the proof leads from the root to a leaf holding the value of the key
+func verifyProof(root hash.Hash, key []byte, value []byte, proof trie.MerkleProof) error {
+	if len(proof) == 0 {
+		return ErrProofValueInvalid
+	}
+	//the verifier only hashes the nodes of the proof, so that it does not need the state
+	verifier, err := trie.NewTrie(nil, storage.NewRamStorage(), false)
+	if err != nil {
+		return err
+	}
+	if err := verifier.Verify(root, key, proof); err != nil {
+		return err
+	}
+	leaf := proof[len(proof)-1]
+	if len(leaf) != 3 || !bytes.Equal(leaf[2], value) {
+		return ErrProofValueInvalid
+	}
+	return nil
+}
+
+func getUTXOKey(txid []byte, vout int) []byte {
+	return cryptohash.Sha3256(bytes.Join([][]byte{utxoKeyPrefix, txid, util.IntToHex(int64(vout))}, []byte{}))
+}
+
+func getContractStorageKey(address, key string) []byte {
+	return cryptohash.Sha3256(bytes.Join(
+		[][]byte{
+			contractStorageKeyPrefix,
+			util.IntToHex(int64(len(address))),
+			[]byte(address),
+			[]byte(key),
+		},
+		[]byte{},
+	))
+}
@@ -0,0 +1,166 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package state
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStateTrie_UTXO(t *testing.T) {
+	db := storage.NewRamStorage()
+	st, err := NewStateTrie(nil, db)
+	assert.Nil(t, err)
+	emptyRoot := st.RootHash()
+
+	ta := account.NewContractTransactionAccount()
+	txout := transactionbase.NewTXOutput(common.NewAmount(10), ta)
+	assert.Nil(t, st.PutUTXO([]byte("txid"), 0, txout))
+	root := st.RootHash()
+	assert.NotEqual(t, emptyRoot, root)
+
+	//the state of a root can be reopened from the database
+	reopened, err := NewStateTrie(root, db)
+	assert.Nil(t, err)
+	assert.Equal(t, root, reopened.RootHash())
+
+	assert.Equal(t, ErrUTXONotInState, st.DelUTXO([]byte("txid"), 1))
+	assert.Nil(t, st.DelUTXO([]byte("txid"), 0))
+	assert.Equal(t, ErrUTXONotInState, st.DelUTXO([]byte("txid"), 0))
+}
+
+func TestStateTrie_ApplyTransactions(t *testing.T) {
+	ta := account.NewContractTransactionAccount()
+	prevTx := transaction.Transaction{
+		ID:   []byte("prevtxid"),
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTXOutput(common.NewAmount(10), ta)},
+	}
+	tx := transaction.Transaction{
+		ID:   []byte("txid"),
+		Vin:  []transactionbase.TXInput{{Txid: []byte("prevtxid"), Vout: 0, Signature: []byte("sig"), PubKey: []byte("pubkey")}},
+		Vout: []transactionbase.TXOutput{*transactionbase.NewTXOutput(common.NewAmount(10), ta)},
+		Tip:  common.NewAmount(0),
+	}
+
+	st, err := NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+	assert.Equal(t, ErrUTXONotInState, st.ApplyTransactions([]*transaction.Transaction{&tx}))
+
+	st, err = NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+	assert.Nil(t, st.ApplyTransactions([]*transaction.Transaction{&prevTx, &tx}))
+
+	expected, err := NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+	assert.Nil(t, expected.PutUTXO(tx.ID, 0, &tx.Vout[0]))
+	assert.Equal(t, expected.RootHash(), st.RootHash())
+}
+
+func TestStateTrie_ApplyContractStorage(t *testing.T) {
+	st1, err := NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+	st2, err := NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+
+	changes := map[string]map[string]string{
+		"address1": {"key1": "value1", "key2": "value2"},
+		"address2": {"key1": "value1"},
+	}
+	assert.Nil(t, st1.ApplyContractStorage(changes))
+	value, err := st1.GetContractStorage("address1", "key2")
+	assert.Nil(t, err)
+	assert.Equal(t, "value2", value)
+	value, err = st1.GetContractStorage("address2", "key2")
+	assert.Nil(t, err)
+	assert.Equal(t, "", value)
+	assert.Nil(t, st2.PutContractStorage("address2", "key1", "value1"))
+	assert.Nil(t, st2.PutContractStorage("address1", "key1", "value1"))
+	assert.Nil(t, st2.PutContractStorage("address1", "key2", "value2"))
+	assert.Equal(t, st2.RootHash(), st1.RootHash())
+
+	//a removed key is deleted from the state, and removing a missing key is ignored
+	assert.Nil(t, st1.ApplyContractStorage(map[string]map[string]string{
+		"address1": {"key2": "", "key3": ""},
+	}))
+	assert.NotEqual(t, st2.RootHash(), st1.RootHash())
+	assert.Nil(t, st2.DelContractStorage("address1", "key2"))
+	assert.Equal(t, st2.RootHash(), st1.RootHash())
+}
+
+func TestStateTrie_GetUTXOOutputs(t *testing.T) {
+	st, err := NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+	txouts, err := st.GetUTXOOutputs(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, txouts)
+
+	owners := []*account.TransactionAccount{account.NewContractTransactionAccount(), account.NewContractTransactionAccount()}
+	assert.Nil(t, st.PutUTXO([]byte("txid1"), 0, transactionbase.NewTXOutput(common.NewAmount(10), owners[0])))
+	assert.Nil(t, st.PutUTXO([]byte("txid2"), 1, transactionbase.NewTXOutput(common.NewAmount(20), owners[1])))
+	contractStorage := map[string]map[string]string{"address1": {"key1": "value1"}}
+	assert.Nil(t, st.ApplyContractStorage(contractStorage))
+
+	//the contract storage is not returned as outputs
+	txouts, err = st.GetUTXOOutputs(contractStorage)
+	assert.Nil(t, err)
+	assert.Len(t, txouts, 2)
+	values := map[string]uint64{}
+	for _, txout := range txouts {
+		values[txout.PubKeyHash.String()] = txout.Value.Uint64()
+	}
+	assert.Equal(t, map[string]uint64{owners[0].GetPubKeyHash().String(): 10, owners[1].GetPubKeyHash().String(): 20}, values)
+}
+
+func TestStateTrie_Prove(t *testing.T) {
+	st, err := NewStateTrie(nil, storage.NewRamStorage())
+	assert.Nil(t, err)
+
+	ta := account.NewContractTransactionAccount()
+	txout := transactionbase.NewTXOutput(common.NewAmount(10), ta)
+	assert.Nil(t, st.PutUTXO([]byte("txid"), 0, txout))
+	assert.Nil(t, st.PutUTXO([]byte("txid"), 1, txout))
+	assert.Nil(t, st.PutContractStorage("address1", "key1", "value1"))
+	root := st.RootHash()
+
+	proof, err := st.ProveUTXO([]byte("txid"), 1)
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyUTXOProof(root, []byte("txid"), 1, txout, proof))
+	assert.NotNil(t, VerifyUTXOProof(root, []byte("txid"), 0, txout, proof))
+	otherTxout := transactionbase.NewTXOutput(common.NewAmount(11), ta)
+	assert.Equal(t, ErrProofValueInvalid, VerifyUTXOProof(root, []byte("txid"), 1, otherTxout, proof))
+
+	proof, err = st.ProveContractStorage("address1", "key1")
+	assert.Nil(t, err)
+	assert.Nil(t, VerifyContractStorageProof(root, "address1", "key1", "value1", proof))
+	assert.Equal(t, ErrProofValueInvalid, VerifyContractStorageProof(root, "address1", "key1", "value2", proof))
+	assert.NotNil(t, VerifyContractStorageProof(root, "address1", "key1", "value1", proof[:len(proof)-1]))
+
+	//the proof does not hold for another state
+	assert.Nil(t, st.PutContractStorage("address1", "key1", "value2"))
+	assert.NotNil(t, VerifyContractStorageProof(st.RootHash(), "address1", "key1", "value1", proof))
+
+	_, err = st.ProveContractStorage("address1", "key2")
+	assert.NotNil(t, err)
+}
@@ -42,6 +42,7 @@ var Subsidy = common.NewAmount(10000000000)
 
 const (
 	ContractTxouputIndex = 0
+	VoteTxOutputIndex    = 0
 	scheduleFuncName     = "dapp_schedule"
 	SCDestroyAddress     = "dRxukNqeADQrAvnHD52BVNdGg6Bgmyuaw4"
 )
@@ -62,6 +63,7 @@ var (
 	ErrNewUserPubKeyHash   = errors.New("transaction: create pubkeyhash error")
 	ErrNoGasChange         = errors.New("transaction: all of Gas have been consumed")
 	ErrInsufficientBalance = errors.New("Transaction: insufficient balance, cannot pay for GasLimit")
+	ErrChainIDMismatch     = errors.New("transaction: chain id does not match the network")
 )
 
 type TxType int
@@ -75,6 +77,8 @@ const (
 	TxTypeGasChange    TxType = 5
 	TxTypeReward       TxType = 6
 	TxTypeContractSend TxType = 7
+	TxTypeVote         TxType = 8
+	TxTypeEvidence     TxType = 9
 )
 
 type Transaction struct {
@@ -86,11 +90,14 @@ type Transaction struct {
 	GasPrice   *common.Amount
 	CreateTime int64
 	Type       TxType
+	ChainID    uint64
 }
 
+// TxIndex locates a transaction on the main chain by the hash and height of its block and its position in the block
 type TxIndex struct {
-	BlockId    []byte
-	BlockIndex int
+	BlockId     []byte
+	BlockHeight uint64
+	BlockIndex  int
 }
 
 //SendTxParam Transaction parameters
@@ -103,6 +110,7 @@ type SendTxParam struct {
 	GasLimit      *common.Amount
 	GasPrice      *common.Amount
 	Contract      string
+	ChainID       uint64
 }
 
 //
@@ -110,9 +118,9 @@ func SetSubsidy(amount int)  {
 	Subsidy = common.NewAmount(uint64(amount))
 }
 
-// NewSendTxParam Returns SendTxParam object
+// NewSendTxParam Returns SendTxParam object without chain id, which has to be set for a network with a chain id
 func NewSendTxParam(from account.Address, senderKeyPair *account.KeyPair, to account.Address, amount *common.Amount, tip *common.Amount, gasLimit *common.Amount, gasPrice *common.Amount, contract string) SendTxParam {
-	return SendTxParam{from, senderKeyPair, to, amount, tip, gasLimit, gasPrice, contract}
+	return SendTxParam{from, senderKeyPair, to, amount, tip, gasLimit, gasPrice, contract, 0}
 }
 
 // TotalCost returns total cost of utxo value in this transaction
@@ -190,6 +198,16 @@ func (tx *Transaction) IsContractSend() bool {
 	return tx.Type == TxTypeContractSend
 }
 
+// IsVote returns true if the transaction votes for a block producer candidate; false otherwise
+func (tx *Transaction) IsVote() bool {
+	return tx.Type == TxTypeVote
+}
+
+// IsEvidence returns true if the transaction carries the evidence of a double-minting producer; false otherwise
+func (tx *Transaction) IsEvidence() bool {
+	return tx.Type == TxTypeEvidence
+}
+
 //GetToHashBytes Get bytes for hash
 func (tx *Transaction) GetToHashBytes() []byte {
 	var tempBytes []byte
@@ -224,6 +242,9 @@ func (tx *Transaction) GetToHashBytes() []byte {
 	if tx.Type > TxTypeDefault {
 		tempBytes = append(tempBytes, byteutils.FromInt32(int32(tx.Type))...)
 	}
+	if tx.ChainID > 0 {
+		tempBytes = append(tempBytes, byteutils.FromUint64(tx.ChainID)...)
+	}
 
 	return tempBytes
 }
@@ -256,7 +277,7 @@ func (tx *Transaction) TrimmedCopy(withSignature bool) Transaction {
 		outputs = append(outputs, transactionbase.TXOutput{vout.Value, vout.PubKeyHash, vout.Contract})
 	}
 
-	txCopy := Transaction{tx.ID, inputs, outputs, tx.Tip, tx.GasLimit, tx.GasPrice, tx.CreateTime, tx.Type}
+	txCopy := Transaction{tx.ID, inputs, outputs, tx.Tip, tx.GasLimit, tx.GasPrice, tx.CreateTime, tx.Type, tx.ChainID}
 
 	return txCopy
 }
@@ -273,7 +294,7 @@ func (tx *Transaction) DeepCopy() Transaction {
 		outputs = append(outputs, transactionbase.TXOutput{vout.Value, vout.PubKeyHash, vout.Contract})
 	}
 
-	txCopy := Transaction{tx.ID, inputs, outputs, tx.Tip, tx.GasLimit, tx.GasPrice, tx.CreateTime, tx.Type}
+	txCopy := Transaction{tx.ID, inputs, outputs, tx.Tip, tx.GasLimit, tx.GasPrice, tx.CreateTime, tx.Type, tx.ChainID}
 
 	return txCopy
 }
@@ -367,6 +388,7 @@ func (tx *Transaction) String() string {
 	lines = append(lines, fmt.Sprintf("     GasLimit %d:", tx.GasLimit))
 	lines = append(lines, fmt.Sprintf("     GasPrice %d:", tx.GasPrice))
 	lines = append(lines, fmt.Sprintf("     Type %d:", tx.Type))
+	lines = append(lines, fmt.Sprintf("     ChainID %d:", tx.ChainID))
 	lines = append(lines, "\n")
 
 	return strings.Join(lines, "\n")
@@ -397,6 +419,7 @@ func (tx *Transaction) ToProto() proto.Message {
 		GasLimit: tx.GasLimit.Bytes(),
 		GasPrice: tx.GasPrice.Bytes(),
 		Type:     int32(tx.Type),
+		ChainId:  tx.ChainID,
 	}
 }
 
@@ -423,6 +446,7 @@ func (tx *Transaction) FromProto(pb proto.Message) {
 	tx.GasLimit = common.NewAmountFromBytes(pb.(*transactionpb.Transaction).GetGasLimit())
 	tx.GasPrice = common.NewAmountFromBytes(pb.(*transactionpb.Transaction).GetGasPrice())
 	tx.Type = TxType(int(pb.(*transactionpb.Transaction).GetType()))
+	tx.ChainID = pb.(*transactionpb.Transaction).GetChainId()
 }
 
 func (tx *Transaction) GetSize() int {
@@ -541,10 +565,15 @@ func (tx *Transaction) VerifyPublicKeyHash(prevUtxos []*utxo.UTXO) (bool, error)
 	return true, nil
 }
 
-func (tx *Transaction) Verify(prevUtxos []*utxo.UTXO) error {
+//Verify returns an error if the transaction does not spend the input UTXOs or is not signed for the network of the
+//chain id. Transactions without chain id are only valid on a network without chain id
+func (tx *Transaction) Verify(prevUtxos []*utxo.UTXO, chainID uint64) error {
 	if prevUtxos == nil {
 		return errors.New("Transaction: prevUtxos not found")
 	}
+	if tx.ChainID != chainID {
+		return ErrChainIDMismatch
+	}
 	result, err := tx.verifyID()
 	if !result {
 		return err
@@ -0,0 +1,74 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package transaction
+
+import (
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+)
+
+// generate the storage key of the transaction index in database
+func getIndexStorageKey(txid []byte) []byte {
+	return []byte("tx_index_" + string(txid))
+}
+
+//PutTxIndex saves the location of the transaction with the input id into database
+func PutTxIndex(txid []byte, txIndex *TxIndex, db storage.Storage) error {
+	bytes, err := proto.Marshal(txIndex.ToProto())
+	if err != nil {
+		return err
+	}
+	return db.Put(getIndexStorageKey(txid), bytes)
+}
+
+//GetTxIndex returns the location of the transaction with the input id from database
+func GetTxIndex(txid []byte, db storage.Storage) (*TxIndex, error) {
+	value, err := db.Get(getIndexStorageKey(txid))
+	if err != nil {
+		return nil, err
+	}
+	pb := &transactionpb.TransactionIndex{}
+	if err := proto.Unmarshal(value, pb); err != nil {
+		return nil, err
+	}
+	txIndex := &TxIndex{}
+	txIndex.FromProto(pb)
+	return txIndex, nil
+}
+
+//DeleteTxIndex removes the location of the transaction with the input id from database
+func DeleteTxIndex(txid []byte, db storage.Storage) error {
+	return db.Del(getIndexStorageKey(txid))
+}
+
+func (txIndex *TxIndex) ToProto() proto.Message {
+	return &transactionpb.TransactionIndex{
+		BlockId:     txIndex.BlockId,
+		BlockHeight: txIndex.BlockHeight,
+		BlockIndex:  int32(txIndex.BlockIndex),
+	}
+}
+
+func (txIndex *TxIndex) FromProto(pb proto.Message) {
+	txIndexPb := pb.(*transactionpb.TransactionIndex)
+	txIndex.BlockId = txIndexPb.GetBlockId()
+	txIndex.BlockHeight = txIndexPb.GetBlockHeight()
+	txIndex.BlockIndex = int(txIndexPb.GetBlockIndex())
+}
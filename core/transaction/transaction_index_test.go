@@ -0,0 +1,43 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package transaction
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTxIndexPutGetAndDelete(t *testing.T) {
+	db := storage.NewRamStorage()
+	txIndex := &TxIndex{BlockId: []byte("block"), BlockHeight: 10, BlockIndex: 2}
+
+	_, err := GetTxIndex(tx1.ID, db)
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+
+	assert.Nil(t, PutTxIndex(tx1.ID, txIndex, db))
+	retrieved, err := GetTxIndex(tx1.ID, db)
+	assert.Nil(t, err)
+	assert.Equal(t, txIndex, retrieved)
+
+	assert.Nil(t, DeleteTxIndex(tx1.ID, db))
+	_, err = GetTxIndex(tx1.ID, db)
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+}
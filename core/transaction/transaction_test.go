@@ -51,16 +51,18 @@ func GenerateFakeTxOutputs() []transactionbase.TXOutput {
 
 func TestTrimmedCopy(t *testing.T) {
 	var tx1 = Transaction{
-		ID:   util.GenerateRandomAoB(1),
-		Vin:  GenerateFakeTxInputs(),
-		Vout: GenerateFakeTxOutputs(),
-		Tip:  common.NewAmount(2),
+		ID:      util.GenerateRandomAoB(1),
+		Vin:     GenerateFakeTxInputs(),
+		Vout:    GenerateFakeTxOutputs(),
+		Tip:     common.NewAmount(2),
+		ChainID: 3,
 	}
 
 	t2 := tx1.TrimmedCopy(false)
 
 	assert.Equal(t, tx1.ID, t2.ID)
 	assert.Equal(t, tx1.Tip, t2.Tip)
+	assert.Equal(t, tx1.ChainID, t2.ChainID)
 	assert.Equal(t, tx1.Vout, t2.Vout)
 	for index, vin := range t2.Vin {
 		assert.Nil(t, vin.Signature)
@@ -72,10 +74,11 @@ func TestTrimmedCopy(t *testing.T) {
 
 func TestTransaction_Proto(t *testing.T) {
 	tx1 := Transaction{
-		ID:   util.GenerateRandomAoB(1),
-		Vin:  GenerateFakeTxInputs(),
-		Vout: GenerateFakeTxOutputs(),
-		Tip:  common.NewAmount(5),
+		ID:      util.GenerateRandomAoB(1),
+		Vin:     GenerateFakeTxInputs(),
+		Vout:    GenerateFakeTxOutputs(),
+		Tip:     common.NewAmount(5),
+		ChainID: 3,
 	}
 
 	pb := tx1.ToProto()
@@ -95,6 +98,24 @@ func TestTransaction_Proto(t *testing.T) {
 	assert.Equal(t, tx1, tx2)
 }
 
+func TestTransaction_HashChainID(t *testing.T) {
+	tx := Transaction{
+		Vin:      GenerateFakeTxInputs(),
+		Vout:     GenerateFakeTxOutputs(),
+		Tip:      common.NewAmount(1),
+		GasLimit: common.NewAmount(0),
+		GasPrice: common.NewAmount(0),
+	}
+	hashWithoutChainID := tx.Hash()
+
+	tx.ChainID = 1
+	hashOfChain1 := tx.Hash()
+	assert.NotEqual(t, hashWithoutChainID, hashOfChain1)
+
+	tx.ChainID = 2
+	assert.NotEqual(t, hashOfChain1, tx.Hash())
+}
+
 func TestTransaction_MatchRewards(t *testing.T) {
 
 	tests := []struct {
@@ -120,6 +141,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{"dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB": "1"},
 			true,
@@ -134,6 +156,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{"dXnq2R6SzRNUt7ZANAqyZc2P9ziF6vYekB": "1"},
 			false,
@@ -155,6 +178,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			nil,
 			false,
@@ -176,6 +200,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{"dXnq2R6SzRNUt7ZsNAqyZc2P9ziF6vYekB": "1"},
 			false,
@@ -197,6 +222,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{"dXnq2R6SzRNUt7ZsNAqyZc2P9ziF6vYekB": "1"},
 			false,
@@ -225,6 +251,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{
 				"dEcqjSgREFi9gTCbAWpEQ3kbPxgsBzzhWS": "4",
@@ -256,6 +283,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{
 				"dEcqjSgREFi9gTCbAWpEQ3kbPxgsBzzhWS": "4",
@@ -288,6 +316,7 @@ func TestTransaction_MatchRewards(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				TxTypeReward,
+				0,
 			},
 			map[string]string{
 				"dEcqjSgREFi9gTCbAWpEQ3kbPxgsBzzhWS": "4",
@@ -40,6 +40,9 @@ type UTXOCache struct {
 	utxo                *lru.Cache
 	lastUtxoKey         *lru.Cache
 	db                  storage.Storage
+	// parent is the cache below a cache layer, and writtenKeys are the keys written through the layer
+	parent      *UTXOCache
+	writtenKeys map[string]bool
 }
 
 func NewUTXOCache(db storage.Storage) *UTXOCache {
@@ -57,6 +60,33 @@ func NewUTXOCache(db storage.Storage) *UTXOCache {
 	return utxoCache
 }
 
+//WithStorage returns a cache layer on top of this cache that reads and writes the storage, e.g. an overlay of the
+//database of this cache. The layer caches the UTXOs on its own, so that this cache is not changed by writes that are
+//never committed. The layer has to be committed once the storage is
+func (utxoCache *UTXOCache) WithStorage(db storage.Storage) *UTXOCache {
+	if utxoCache == nil {
+		return nil
+	}
+	layer := NewUTXOCache(db)
+	layer.parent = utxoCache
+	layer.writtenKeys = make(map[string]bool)
+	return layer
+}
+
+//Commit removes the keys written through the cache layer from the cache below it, which then reads them from the
+//committed storage. It does nothing for a cache that is not a layer
+func (utxoCache *UTXOCache) Commit() {
+	if utxoCache == nil || utxoCache.parent == nil {
+		return
+	}
+	for key := range utxoCache.writtenKeys {
+		utxoCache.parent.utxo.Remove(key)
+		utxoCache.parent.lastUtxoKey.Remove(key)
+		utxoCache.parent.contractCreateCache.Remove(key)
+	}
+	utxoCache.writtenKeys = make(map[string]bool)
+}
+
 func (utxoCache *UTXOCache) AddUtxos(utxoTx *UTXOTx, pubkey string) error {
 	lastestUtxoKey := utxoCache.getLastUTXOKey(pubkey)
 	for key, utxo := range utxoTx.Indices {
@@ -181,6 +211,7 @@ func (utxoCache *UTXOCache) GetUTXOTx(pubKeyHash account.PubKeyHash) *UTXOTx {
 		if err != nil {
 			//todo: return err
 			logger.Error(err)
+			break
 		}
 		utxoTx.Indices[utxoKey] = utxo
 		utxoKey = util.Bytes2str(utxo.NextUtxoKey) //get previous utxo key
@@ -201,9 +232,17 @@ func (utxoCache *UTXOCache) Delete(pubKeyHash account.PubKeyHash) error {
 	if pubKeyHash == nil {
 		return account.ErrEmptyPublicKeyHash
 	}
+	utxoCache.markWritten(pubKeyHash.String())
 	return utxoCache.db.Del(pubKeyHash)
 }
 
+//markWritten records the key written through a cache layer
+func (utxoCache *UTXOCache) markWritten(key string) {
+	if utxoCache.writtenKeys != nil {
+		utxoCache.writtenKeys[key] = true
+	}
+}
+
 func (utxoCache *UTXOCache) putUTXOToDB(utxo *UTXO) error {
 	utxoBytes, err := proto.Marshal(utxo.ToProto().(*utxopb.Utxo))
 	if err != nil {
@@ -215,6 +254,7 @@ func (utxoCache *UTXOCache) putUTXOToDB(utxo *UTXO) error {
 		return err
 	}
 	utxoCache.utxo.Add(utxo.GetUTXOKey(), utxo)
+	utxoCache.markWritten(utxo.GetUTXOKey())
 	return nil
 }
 
@@ -244,6 +284,7 @@ func (utxoCache *UTXOCache) deleteUTXOFromDB(utxoKey string) error {
 		return err
 	}
 	utxoCache.utxo.Remove(utxoKey)
+	utxoCache.markWritten(utxoKey)
 	return nil
 }
 
@@ -254,6 +295,7 @@ func (utxoCache *UTXOCache) putLastUTXOKeyToDB(pubkey string, lastestUtxoKey []b
 		return err
 	}
 	utxoCache.lastUtxoKey.Add(pubkey, lastestUtxoKey)
+	utxoCache.markWritten(pubkey)
 	return nil
 }
 
@@ -264,6 +306,7 @@ func (utxoCache *UTXOCache) deleteLastUTXOKeyFromDB(pubkey string) error {
 		return err
 	}
 	utxoCache.lastUtxoKey.Remove(pubkey)
+	utxoCache.markWritten(pubkey)
 	return nil
 }
 
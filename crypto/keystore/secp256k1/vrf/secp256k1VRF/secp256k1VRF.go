@@ -219,6 +219,15 @@ func (pk *PublicKey) ProofToHash(m, proof []byte) (index [32]byte, err error) {
 	return sha256.Sum256(vrf), nil
 }
 
+// ProofToIndex outputs the index of a proof that has already been verified by ProofToHash.
+func ProofToIndex(proof []byte) (index [32]byte, err error) {
+	nilIndex := [32]byte{}
+	if got, want := len(proof), 64+65; got != want {
+		return nilIndex, ErrInvalidVRF
+	}
+	return sha256.Sum256(proof[64 : 64+65]), nil
+}
+
 // NewFromWrappedKey creates a VRF signer object from an encrypted private key.
 // The opaque private key must resolve to an `ecdsa.PrivateKey` in order to work.
 // func NewFromWrappedKey(ctx context.Context, wrapped proto.Message) (vrf.PrivateKey, error) {
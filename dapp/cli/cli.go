@@ -67,6 +67,9 @@ const (
 	cliEstimateGas       = "estimateGas"
 	cliGasPrice          = "gasPrice"
 	cliContractQuery     = "contractQuery"
+	cliVote              = "vote"
+	cliProducerStats     = "producerStats"
+	cliSealBlock         = "sealBlock"
 	cliHelp              = "help"
 )
 
@@ -91,6 +94,9 @@ const (
 	flagContractAddr     = "contractAddr"
 	flagKey              = "key"
 	flagValue            = "value"
+	flagCandidate        = "candidate"
+	flagActivationHeight = "height"
+	flagWindow           = "window"
 )
 
 type valueType int
@@ -125,6 +131,9 @@ var cmdList = []string{
 	cliEstimateGas,
 	cliGasPrice,
 	cliContractQuery,
+	cliVote,
+	cliProducerStats,
+	cliSealBlock,
 	cliHelp,
 }
 
@@ -155,12 +164,28 @@ var cmdFlagsMap = map[string][]flagPars{
 		valueTypeString,
 		"Address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
 	}},
-	cliaddProducer: {flagPars{
-		flagProducerAddr,
-		"",
-		valueTypeString,
-		"Producer's address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
-	}},
+	cliProducerStats: {
+		flagPars{
+			flagWindow,
+			uint64(0),
+			valueTypeUint64,
+			"Number of time slots the stats are counted over. Default to the last 100 slots",
+		},
+	},
+	cliaddProducer: {
+		flagPars{
+			flagProducerAddr,
+			"",
+			valueTypeString,
+			"Producer's address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagActivationHeight,
+			uint64(0),
+			valueTypeUint64,
+			"Block height from which the producer joins the dynasty. Default to the next epoch",
+		},
+	},
 	clisendFromMiner: {
 		flagPars{
 			flagAddressBalance,
@@ -307,6 +332,32 @@ var cmdFlagsMap = map[string][]flagPars{
 			"The data value storaged in contract address.",
 		},
 	},
+	cliVote: {
+		flagPars{
+			flagFromAddress,
+			"",
+			valueTypeString,
+			"Voter's account address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagCandidate,
+			"",
+			valueTypeString,
+			"Producer candidate's address. Eg. 1MeSBgufmzwpiJNLemUe1emxAussBnz7a7",
+		},
+		flagPars{
+			flagAmount,
+			0,
+			valueTypeInt,
+			"The amount of stake backing the vote. It stays locked in the voter's account until spent.",
+		},
+		flagPars{
+			flagTip,
+			uint64(0),
+			valueTypeUint64,
+			"Tip to miner.",
+		},
+	},
 }
 
 //map the callback function to each command
@@ -325,6 +376,9 @@ var cmdHandlers = map[string]commandHandlersWithType{
 	cliGasPrice:          {rpcService, gasPriceCommandHandler},
 	cliHelp:              {adminRpcService, helpCommandHandler},
 	cliContractQuery:     {rpcService, contractQueryCommandHandler},
+	cliVote:              {rpcService, voteCommandHandler},
+	cliProducerStats:     {adminRpcService, producerStatsCommandHandler},
+	cliSealBlock:         {adminRpcService, sealBlockCommandHandler},
 }
 
 type commandHandlersWithType struct {
@@ -514,6 +568,22 @@ func getBlocksCommandHandler(ctx context.Context, account interface{}, flags cmd
 	fmt.Println(string(blocks))
 }
 
+//getChainID requests the chain id that the transactions sent to the node have to be signed for. It prints the error
+//and returns false if the request fails
+func getChainID(ctx context.Context, c interface{}) (uint64, bool) {
+	response, err := c.(rpcpb.RpcServiceClient).RpcGetBlockchainInfo(ctx, &rpcpb.GetBlockchainInfoRequest{})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable:
+			fmt.Println("Error: server is not reachable!")
+		default:
+			fmt.Println("Error:", status.Convert(err).Message())
+		}
+		return 0, false
+	}
+	return response.GetChainId(), true
+}
+
 func getBlockchainInfoCommandHandler(ctx context.Context, account interface{}, flags cmdFlags) {
 	response, err := account.(rpcpb.RpcServiceClient).RpcGetBlockchainInfo(ctx, &rpcpb.GetBlockchainInfoRequest{})
 	if err != nil {
@@ -529,6 +599,7 @@ func getBlockchainInfoCommandHandler(ctx context.Context, account interface{}, f
 		"TailBlockHash": hex.EncodeToString(response.TailBlockHash),
 		"BlockHeight":   response.BlockHeight,
 		"Producers":     response.Producers,
+		"ChainID":       response.ChainId,
 	}
 
 	blockchainInfo, err := json.MarshalIndent(encodedResponse, "", "  ")
@@ -829,6 +900,41 @@ func getPeerInfoCommandHandler(ctx context.Context, account interface{}, flags c
 	fmt.Println(proto.MarshalTextString(response))
 }
 
+func producerStatsCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	response, err := c.(rpcpb.AdminServiceClient).RpcGetProducerStats(ctx, &rpcpb.GetProducerStatsRequest{
+		Window: *(flags[flagWindow].(*uint64)),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable:
+			fmt.Println("Error: server is not reachable!")
+		default:
+			fmt.Println("Error:", status.Convert(err).Message())
+		}
+		return
+	}
+
+	fmt.Printf("Producer stats over the last %d slots:\n", response.GetWindow())
+	for _, stats := range response.GetStats() {
+		fmt.Printf("%s  produced: %d  missed: %d  uptime: %.2f%%\n",
+			stats.GetAddress(), stats.GetProduced(), stats.GetMissed(), stats.GetUptime())
+	}
+}
+
+func sealBlockCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	_, err := c.(rpcpb.AdminServiceClient).RpcSealBlock(ctx, &rpcpb.SealBlockRequest{})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable:
+			fmt.Println("Error: server is not reachable!")
+		default:
+			fmt.Println("Error:", status.Convert(err).Message())
+		}
+		return
+	}
+	fmt.Println("Block is requested.")
+}
+
 func cliAddProducerCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
 	producerAddress := *(flags[flagProducerAddr].(*string))
 	if len(producerAddress) == 0 {
@@ -845,7 +951,8 @@ func cliAddProducerCommandHandler(ctx context.Context, c interface{}, flags cmdF
 	}
 
 	_, err := c.(rpcpb.AdminServiceClient).RpcAddProducer(ctx, &rpcpb.AddProducerRequest{
-		Address: producerAddress,
+		Address:          producerAddress,
+		ActivationHeight: *(flags[flagActivationHeight].(*uint64)),
 	})
 
 	if err != nil {
@@ -857,7 +964,7 @@ func cliAddProducerCommandHandler(ctx context.Context, c interface{}, flags cmdF
 		}
 		return
 	}
-	fmt.Println("Producer is added.")
+	fmt.Println("Producer is scheduled to be added.")
 }
 
 func sendCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
@@ -940,6 +1047,11 @@ func sendCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
 	}
 	sendTxParam := transaction.NewSendTxParam(account.NewAddress(*(flags[flagFromAddress].(*string))), senderAccount.GetKeyPair(),
 		account.NewAddress(*(flags[flagToAddress].(*string))), common.NewAmount(uint64(*(flags[flagAmount].(*int)))), tip, gasLimit, gasPrice, data)
+	chainID, ok := getChainID(ctx, c)
+	if !ok {
+		return
+	}
+	sendTxParam.ChainID = chainID
 	tx, err := ltransaction.NewUTXOTransaction(tx_utxos, sendTxParam)
 	sendTransactionRequest := &rpcpb.SendTransactionRequest{Transaction: tx.ToProto().(*transactionpb.Transaction)}
 	_, err = c.(rpcpb.RpcServiceClient).RpcSendTransaction(ctx, sendTransactionRequest)
@@ -961,6 +1073,87 @@ func sendCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
 	fmt.Println("Transaction is sent! Pending approval from network.")
 }
 
+func voteCommandHandler(ctx context.Context, c interface{}, flags cmdFlags) {
+	fromAddress := account.NewAddress(*(flags[flagFromAddress].(*string)))
+	candidateAddress := account.NewAddress(*(flags[flagCandidate].(*string)))
+	if !account.NewTransactionAccountByAddress(fromAddress).IsValid() {
+		fmt.Println("Error: 'from' address is not valid!")
+		return
+	}
+	if !account.NewTransactionAccountByAddress(candidateAddress).IsValid() {
+		fmt.Println("Error: 'candidate' address is not valid!")
+		return
+	}
+	amount := *(flags[flagAmount].(*int))
+	if amount <= 0 {
+		fmt.Println("Error: amount must be greater than zero!")
+		return
+	}
+	stake := common.NewAmount(uint64(amount))
+	tip := common.NewAmount(*(flags[flagTip].(*uint64)))
+
+	response, err := c.(rpcpb.RpcServiceClient).RpcGetUTXO(ctx, &rpcpb.GetUTXORequest{
+		Address: fromAddress.String(),
+	})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable:
+			fmt.Println("Error: server is not reachable!")
+		default:
+			fmt.Println("Error:", status.Convert(err).Message())
+		}
+		return
+	}
+	var inputUtxos []*utxo.UTXO
+	for _, u := range response.GetUtxos() {
+		uu := utxo.UTXO{}
+		uu.Value = common.NewAmountFromBytes(u.Amount)
+		uu.Txid = u.Txid
+		uu.PubKeyHash = account.PubKeyHash(u.PublicKeyHash)
+		uu.TxIndex = int(u.TxIndex)
+		inputUtxos = append(inputUtxos, &uu)
+	}
+	txUtxos, err := GetUTXOsfromAmount(inputUtxos, stake, tip, nil, nil)
+	if err != nil {
+		fmt.Println("Error:", err.Error())
+		return
+	}
+
+	am, err := logic.GetAccountManager(wallet.GetAccountFilePath())
+	if err != nil {
+		fmt.Println("Error:", err.Error())
+		return
+	}
+	voterAccount := am.GetAccountByAddress(fromAddress)
+	if voterAccount == nil {
+		fmt.Println("Error: invalid account address.")
+		return
+	}
+
+	sendTxParam := transaction.NewSendTxParam(fromAddress, voterAccount.GetKeyPair(), candidateAddress, stake, tip, nil, nil, "")
+	chainID, ok := getChainID(ctx, c)
+	if !ok {
+		return
+	}
+	sendTxParam.ChainID = chainID
+	tx, err := ltransaction.NewVoteTX(txUtxos, sendTxParam)
+	if err != nil {
+		fmt.Println("Error:", err.Error())
+		return
+	}
+	_, err = c.(rpcpb.RpcServiceClient).RpcSendTransaction(ctx, &rpcpb.SendTransactionRequest{Transaction: tx.ToProto().(*transactionpb.Transaction)})
+	if err != nil {
+		switch status.Code(err) {
+		case codes.Unavailable:
+			fmt.Println("Error: server is not reachable!")
+		default:
+			fmt.Println("Error:", status.Convert(err).Message())
+		}
+		return
+	}
+	fmt.Println("Vote is sent! Pending approval from network.")
+}
+
 func GetUTXOsfromAmount(inputUTXOs []*utxo.UTXO, amount *common.Amount, tip *common.Amount, gasLimit *common.Amount, gasPrice *common.Amount) ([]*utxo.UTXO, error) {
 	if tip != nil {
 		amount = amount.Add(tip)
@@ -1132,6 +1325,11 @@ func estimateGasCommandHandler(ctx context.Context, c interface{}, flags cmdFlag
 	}
 	sendTxParam := transaction.NewSendTxParam(account.NewAddress(*(flags[flagFromAddress].(*string))), senderAccount.GetKeyPair(),
 		account.NewAddress(*(flags[flagToAddress].(*string))), common.NewAmount(uint64(*(flags[flagAmount].(*int)))), tip, gasLimit, gasPrice, data)
+	chainID, ok := getChainID(ctx, c)
+	if !ok {
+		return
+	}
+	sendTxParam.ChainID = chainID
 	tx, err := ltransaction.NewUTXOTransaction(tx_utxos, sendTxParam)
 	estimateGasRequest := &rpcpb.EstimateGasRequest{Transaction: tx.ToProto().(*transactionpb.Transaction)}
 	gasResponse, err := c.(rpcpb.RpcServiceClient).RpcEstimateGas(ctx, estimateGasRequest)
@@ -19,7 +19,16 @@
 package main
 
 import (
+	"bytes"
+	"crypto/tls"
+	"encoding/hex"
 	"flag"
+	"io/ioutil"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
 	"github.com/dappley/go-dappley/core/transaction"
 
 	"github.com/dappley/go-dappley/core/blockchain"
@@ -30,11 +39,16 @@ import (
 
 	"github.com/dappley/go-dappley/common/log"
 	"github.com/dappley/go-dappley/logic/downloadmanager"
+	"github.com/dappley/go-dappley/logic/lightnode"
+	"github.com/dappley/go-dappley/logic/migration"
+	"github.com/dappley/go-dappley/logic/snapshot"
 	logger "github.com/sirupsen/logrus"
 
+	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/config"
 	configpb "github.com/dappley/go-dappley/config/pb"
 	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/consensus/blocksigner"
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/core/block"
 
@@ -45,8 +59,10 @@ import (
 
 	"github.com/dappley/go-dappley/metrics/logMetrics"
 	"github.com/dappley/go-dappley/network"
+	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/dappley/go-dappley/rpc"
 	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
 	"github.com/dappley/go-dappley/vm"
 	"github.com/spf13/viper"
 )
@@ -59,6 +75,12 @@ const (
 	size1kB         = 1024
 )
 
+//nodeConsensus is the consensus that validates and produces the blocks of the node
+type nodeConsensus interface {
+	blockproducer.Consensus
+	lblockchain.LIBPolicy
+}
+
 func main() {
 	defer log.CrashHandler()
 
@@ -75,6 +97,21 @@ func main() {
 
 	var genesisPath string
 	flag.StringVar(&genesisPath, "g", genesisFilePath, "Genesis Configuration File Path. Default to conf/genesis.conf")
+
+	var exportSnapshotPath string
+	flag.StringVar(&exportSnapshotPath, "exportSnapshot", "", "Export the state snapshot of the blockchain into the file and exit")
+	var snapshotHeight uint64
+	flag.Uint64Var(&snapshotHeight, "snapshotHeight", 0, "Height of the exported snapshot. Default to the LIB")
+	var importSnapshotPath string
+	flag.StringVar(&importSnapshotPath, "importSnapshot", "", "Import the state snapshot in the file before starting the node")
+	var snapshotHash string
+	flag.StringVar(&snapshotHash, "snapshotHash", "", "Trusted hash of the block of the imported snapshot in hex. Required to import a snapshot")
+	var migrationBackupPath string
+	flag.StringVar(&migrationBackupPath, "migrationBackup", "", "Back up the database to the path before migrating it to the current schema")
+	var reindex bool
+	flag.BoolVar(&reindex, "reindex", false, "Rebuild the UTXO index and the contract storage by replaying the blocks and exit")
+	var verify bool
+	flag.BoolVar(&verify, "verify", false, "Verify the blocks, the UTXO index and the contract storage and exit")
 	flag.Parse()
 
 	logger.Infof("Genesis conf file is %v,node conf file is %v", genesisPath, filePath)
@@ -86,6 +123,11 @@ func main() {
 		logger.Error("Cannot load genesis configurations from file! Exiting...")
 		return
 	}
+	genesis, err := loadGenesis(genesisConf, filepath.Dir(genesisPath))
+	if err != nil {
+		logger.WithError(err).Error("Invalid genesis configurations! Exiting...")
+		return
+	}
 
 	//load config file information
 	conf := &configpb.Config{}
@@ -96,37 +138,105 @@ func main() {
 	}
 
 	//setup
-	db := storage.OpenDatabase(conf.GetNodeConfig().GetDbPath())
+	db, err := storage.OpenStorage(conf.GetNodeConfig().GetDbEngine(), conf.GetNodeConfig().GetDbPath())
+	if err != nil {
+		logger.WithError(err).Error("Cannot open the database! Exiting...")
+		return
+	}
 	defer db.Close()
-	node, err := initNode(conf, db)
+	//the migrations that add an index reindex the blockchain
+	reindexDatabase := func(db storage.Storage) error {
+		return lblockchain.ReindexDatabase(db, initForks(genesisConf))
+	}
+	if err := migration.Migrate(db, conf.GetNodeConfig().GetDbEngine(), migrationBackupPath, reindexDatabase); err != nil {
+		logger.WithError(err).Error("Cannot migrate the database to the current schema! Exiting...")
+		return
+	}
+	if exportSnapshotPath != "" {
+		if err := exportSnapshot(genesisConf, genesis, db, exportSnapshotPath, snapshotHeight); err != nil {
+			logger.WithError(err).Error("Failed to export the snapshot!")
+		}
+		return
+	}
+	if importSnapshotPath != "" {
+		if err := importSnapshot(genesisConf, conf, genesis, db, importSnapshotPath, snapshotHash); err != nil {
+			logger.WithError(err).Error("Failed to import the snapshot! Exiting...")
+			return
+		}
+	}
+	if err := lblockchain.VerifyGenesis(db, genesis); err != nil {
+		logger.WithError(err).Error("The blockchain in the database does not match the genesis file! Exiting...")
+		return
+	}
+	if reindex {
+		if err := reindexChain(db); err != nil {
+			logger.WithError(err).Error("Failed to reindex the blockchain!")
+		}
+		return
+	}
+	if verify {
+		if err := verifyChain(genesisConf, conf, genesis, db); err != nil {
+			logger.WithError(err).Error("The blockchain is corrupted!")
+		}
+		return
+	}
+	node, err := initNode(conf, db, genesis)
 	if err != nil {
 		return
 	} else {
 		defer node.Stop()
 	}
 
+	if conf.GetNodeConfig().GetLightNode() {
+		runLightNode(genesisConf, conf, genesis, node, db)
+		return
+	}
+
 	//create blockchain
-	conss, _ := initConsensus(genesisConf, conf)
+	conss, _ := initConsensus(genesisConf, conf, genesis, db)
+	var blkConsensus nodeConsensus = conss
+	var seal *consensus.InstantSeal
+	if conf.GetConsensusConfig().GetType() == consensus.InstantSealConsensusType {
+		seal = initInstantSeal(conf)
+		seal.SetForks(initForks(genesisConf))
+		blkConsensus = seal
+	}
 	txPoolLimit := conf.GetNodeConfig().GetTxPoolLimit() * size1kB
 	nodeAddr := conf.GetNodeConfig().GetNodeAddress()
 	blkSizeLimit := conf.GetNodeConfig().GetBlkSizeLimit() * size1kB
 	scManager := vm.NewV8EngineManager(account.NewAddress(nodeAddr))
 	txPool := transactionpool.NewTransactionPool(node, txPoolLimit)
 	//utxo.NewPool()
-	bc, err := lblockchain.GetBlockchain(db, conss, txPool, scManager, int(blkSizeLimit))
+	bc, err := lblockchain.GetBlockchain(db, blkConsensus, txPool, scManager, int(blkSizeLimit))
 
 	var LIBBlk *block.Block = nil
 	if err != nil {
-		bc, err = logic.CreateBlockchain(account.NewAddress(genesisAddr), db, conss, txPool, scManager, int(blkSizeLimit))
-		if err != nil {
-			logger.Panic(err)
-		}
+		bc = lblockchain.CreateBlockchainWithGenesis(genesis, db, blkConsensus, txPool, scManager, int(blkSizeLimit))
 	} else {
 		LIBBlk, _ = bc.GetLIB()
 	}
 	bc.SetState(blockchain.BlockchainInit)
-
-	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(LIBBlk), node, conss)
+	bc.SetPruneDepth(conf.GetNodeConfig().GetPruneDepth())
+	bc.SetForks(initForks(genesisConf))
+	conss.SetChain(bc)
+	election := initElection(genesisConf, bc, conss.GetDynasty(), conss.GetDynastySchedule(), db)
+	conss.SetElection(election)
+	bc.SetElection(election)
+
+	bm := lblockchain.NewBlockchainManager(bc, blockchain.NewBlockPool(LIBBlk), node, blkConsensus)
+	if maxClockDrift := conf.GetNodeConfig().GetMaxClockDrift(); maxClockDrift > 0 {
+		bm.SetMaxClockDrift(int64(maxClockDrift))
+	}
+	conss.SetEvidenceHandler(bm.BroadcastEvidence)
+	lightnode.NewLightServer(node, bc)
+	if seal == nil {
+		finality := conss.EnableFinality(bc, db)
+		finality.SetPreCommitHandler(bm.BroadcastPreCommit)
+		bc.SetFinalityGadget(finality)
+	}
+	if seal != nil {
+		txPool.EventBus.SubscribeAsync(transactionpool.NewTransactionTopic, seal.OnNewTransaction, false)
+	}
 
 	if err != nil {
 		logger.WithError(err).Error("Failed to initialize the node! Exiting...")
@@ -141,12 +251,13 @@ func main() {
 	nodeConf := conf.GetNodeConfig()
 	server := rpc.NewGrpcServerWithMetrics(node, bm, defaultPassword, conss, &rpc.MetricsServiceConfig{
 		PollingInterval: nodeConf.GetMetricsPollingInterval(), TimeSeriesInterval: nodeConf.GetMetricsInterval()})
+	server.SetInstantSeal(seal)
 
 	server.Start(conf.GetNodeConfig().GetRpcPort())
 	defer server.Stop()
 
 	producer := blockproducerinfo.NewBlockProducerInfo(conf.GetConsensusConfig().GetMinerAddress())
-	blockProducer := blockproducer.NewBlockProducer(bm, conss, producer)
+	blockProducer := blockproducer.NewBlockProducer(bm, blkConsensus, producer)
 
 	downloadManager := downloadmanager.NewDownloadManager(node, bm, len(conss.GetProducers()), blockProducer)
 	downloadManager.Start()
@@ -171,26 +282,349 @@ func main() {
 	select {}
 }
 
-func initConsensus(conf *configpb.DynastyConfig, generalConf *configpb.Config) (*consensus.DPOS, *consensus.Dynasty) {
+func initConsensus(conf *configpb.DynastyConfig, generalConf *configpb.Config, genesis *lblockchain.Genesis, db storage.Storage) (*consensus.DPOS, *consensus.Dynasty) {
 	//set up consensus
 	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(generalConf.GetConsensusConfig().GetMinerAddress()))
-	dynasty := consensus.NewDynastyWithConfigProducers(conf.GetProducers(), (int)(conf.GetMaxProducers()))
+	dynasty := initDynasty(conf, genesis, db)
 	conss.SetDynasty(dynasty)
-	conss.SetKey(generalConf.GetConsensusConfig().GetPrivateKey())
+
+	//the consensus parameters in the genesis file have to be the same on all nodes
+	if conf.GetMaxMintingTimeMs() >= uint32(dynasty.GetTimeBetweenBlk())*1000 {
+		logger.WithFields(logger.Fields{
+			"max_minting_time_ms": conf.GetMaxMintingTimeMs(),
+			"time_between_blk":    dynasty.GetTimeBetweenBlk(),
+		}).Panic("The minting time in the genesis file must be shorter than the block time!")
+	}
+	//the ratio is also the quorum of the finality certificates, which needs more than 2/3 of the producers to be safe
+	if conf.GetLibConfirmationRatio() != 0 && (conf.GetLibConfirmationRatio() < 2.0/3 || conf.GetLibConfirmationRatio() > 1) {
+		logger.WithFields(logger.Fields{
+			"lib_confirmation_ratio": conf.GetLibConfirmationRatio(),
+		}).Panic("The LIB confirmation ratio in the genesis file must be between 2/3 and 1!")
+	}
+	//an epoch has to end with a full dynasty cycle, so that every elected producer gets the same number of slots
+	if conf.GetEpochLength() == 0 || conf.GetEpochLength()%uint64(dynasty.GetMaxProducers()) != 0 {
+		logger.WithFields(logger.Fields{
+			"epoch_length":  conf.GetEpochLength(),
+			"max_producers": dynasty.GetMaxProducers(),
+		}).Panic("The epoch length in the genesis file must be a multiple of the max producers!")
+	}
+	conss.SetMaxMintingTime(int64(conf.GetMaxMintingTimeMs()))
+	conss.SetLibConfirmationRatio(conf.GetLibConfirmationRatio())
+
+	conss.SetDynastySchedule(initDynastySchedule(conf, dynasty, db))
+	conss.SetForks(initForks(conf))
+	if signer := initBlockSigner(generalConf.GetConsensusConfig()); signer != nil {
+		conss.SetSigner(signer)
+	}
 	logger.WithFields(logger.Fields{
-		"miner_address": generalConf.GetConsensusConfig().GetMinerAddress(),
+		"miner_address":       generalConf.GetConsensusConfig().GetMinerAddress(),
+		"time_between_blk":    dynasty.GetTimeBetweenBlk(),
+		"max_producers":       dynasty.GetMaxProducers(),
+		"max_minting_time_ms": conss.GetMaxMintingTime(),
 	}).Info("Consensus is configured.")
 	return conss, dynasty
 }
 
-func initNode(conf *configpb.Config, db storage.Storage) (*network.Node, error) {
+//initForks returns the heights from which the rules added after the launch of the network are enforced
+func initForks(conf *configpb.DynastyConfig) *block.Forks {
+	return &block.Forks{
+		VRFHeight:           conf.GetForks().GetVrfHeight(),
+		SlotTimestampHeight: conf.GetForks().GetSlotTimestampHeight(),
+		StateRootHeight:     conf.GetForks().GetStateRootHeight(),
+		MerkleRootHeight:    conf.GetForks().GetMerkleRootHeight(),
+		ChainID:             conf.GetForks().GetChainId(),
+		ChainIDHeight:       conf.GetForks().GetChainIdHeight(),
+	}
+}
+
+//initDynasty returns the initial dynasty. The producers are the ones in the genesis block in the database, or in the
+//genesis block of a new blockchain. The genesis blocks of earlier versions carry no producers, which are then read
+//from the genesis file
+func initDynasty(genesisConf *configpb.DynastyConfig, genesis *lblockchain.Genesis, db storage.Storage) *consensus.Dynasty {
+	producers := genesisConf.GetProducers()
+	chainGenesis, err := lblockchain.GetGenesis(db)
+	if err == lblockchain.ErrBlockDoesNotExist {
+		chainGenesis, err = lblockchain.NewGenesisFromBlock(genesis.ToBlock())
+	}
+	if err == nil {
+		producers = chainGenesis.Producers
+	}
+	return consensus.NewDynastyWithConfigProducers(producers, (int)(genesisConf.GetMaxProducers()), (int)(genesisConf.GetTimeBetweenBlk()))
+}
+
+//initDynastySchedule returns the schedule of the dynasty. The dynasty changes in the genesis file are applied on every
+//start so that all nodes share the same schedule
+func initDynastySchedule(conf *configpb.DynastyConfig, dynasty *consensus.Dynasty, db storage.Storage) *consensus.DynastySchedule {
+	schedule := consensus.NewDynastySchedule(db, dynasty.GetProducers())
+	for _, changePb := range conf.GetDynastyChanges() {
+		change := &consensus.DynastyChange{}
+		change.FromProto(changePb)
+		if err := schedule.AddChange(change); err != nil {
+			logger.WithError(err).Panic("Failed to schedule the dynasty changes in the genesis file!")
+		}
+	}
+	return schedule
+}
+
+//initElection returns the election of the producers. A node bootstrapped from a snapshot has no blocks below the
+//snapshot and resumes the tally from the checkpoint in the snapshot
+func initElection(genesisConf *configpb.DynastyConfig, bc *lblockchain.Blockchain, dynasty *consensus.Dynasty, schedule *consensus.DynastySchedule, db storage.Storage) *consensus.Election {
+	election := consensus.NewElection(bc, genesisConf.GetEpochLength(), dynasty.GetMaxProducers(), schedule)
+	if checkpoint, err := consensus.LoadElectionCheckpoint(db); err == nil {
+		election.SetCheckpoint(checkpoint)
+	}
+	return election
+}
+
+//reindexChain rebuilds the UTXO index and the contract storage by replaying the blocks in the database
+func reindexChain(db storage.Storage) error {
+	bc, err := lblockchain.GetBlockchain(db, nil, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	return bc.Reindex()
+}
+
+//verifyChain checks the blocks in the database and their signatures unless the blocks are sealed instantly, and
+//replays the blocks to check the UTXO index and the contract storage
+func verifyChain(genesisConf *configpb.DynastyConfig, conf *configpb.Config, genesis *lblockchain.Genesis, db storage.Storage) error {
+	bc, err := lblockchain.GetBlockchain(db, nil, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	var verifier lblockchain.HeaderVerifier
+	if conf.GetConsensusConfig().GetType() != consensus.InstantSealConsensusType {
+		dynasty := initDynasty(genesisConf, genesis, db)
+		schedule := initDynastySchedule(genesisConf, dynasty, db)
+		headerVerifier := consensus.NewHeaderVerifier(dynasty, schedule, bc)
+		headerVerifier.SetForks(initForks(genesisConf))
+		headerVerifier.SetElection(initElection(genesisConf, bc, dynasty, schedule, db))
+		verifier = headerVerifier
+	}
+	if err := bc.Verify(verifier); err != nil {
+		return err
+	}
+	logger.WithFields(logger.Fields{
+		"height": bc.GetMaxHeight(),
+	}).Info("The blockchain is verified.")
+	return nil
+}
+
+//exportSnapshot writes the state of the blockchain after the block at the input height into the file. The snapshot is
+//taken at the LIB if the height is 0
+func exportSnapshot(genesisConf *configpb.DynastyConfig, genesis *lblockchain.Genesis, db storage.Storage, path string, height uint64) error {
+	bc, err := lblockchain.GetBlockchain(db, nil, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	if height == 0 {
+		height = bc.GetLIBHeight()
+	}
+	dynasty := initDynasty(genesisConf, genesis, db)
+	schedule := initDynastySchedule(genesisConf, dynasty, db)
+
+	s, err := snapshot.Export(bc, height, initElection(genesisConf, bc, dynasty, schedule, db))
+	if err != nil {
+		return err
+	}
+	if err := s.SaveToFile(path); err != nil {
+		return err
+	}
+	logger.WithFields(logger.Fields{
+		"path":   path,
+		"height": height,
+		"hash":   s.GetBlock().GetHash().String(),
+	}).Info("Snapshot is exported.")
+	return nil
+}
+
+//importSnapshot bootstraps the database from the snapshot in the file. The snapshot has to start from the genesis
+//block of the genesis file and its block has to match the trusted hash. The headers in the snapshot are verified
+//against the dynasty of the genesis block unless the blocks are sealed instantly
+func importSnapshot(genesisConf *configpb.DynastyConfig, conf *configpb.Config, genesis *lblockchain.Genesis, db storage.Storage, path string, trustedHash string) error {
+	if trustedHash == "" {
+		return snapshot.ErrTrustedHashMissing
+	}
+	blkHash, err := hex.DecodeString(trustedHash)
+	if err != nil {
+		return err
+	}
+	s, err := snapshot.LoadFromFile(path)
+	if err != nil {
+		return err
+	}
+
+	var dynasty *consensus.Dynasty
+	var schedule *consensus.DynastySchedule
+	if conf.GetConsensusConfig().GetType() != consensus.InstantSealConsensusType {
+		dynasty = initDynasty(genesisConf, genesis, db)
+		schedule = initDynastySchedule(genesisConf, dynasty, db)
+	}
+	return snapshot.Import(db, s, genesis.ToBlock(), blkHash, initForks(genesisConf), dynasty, schedule, genesisConf.GetEpochLength())
+}
+
+//runLightNode syncs and verifies the block headers only. The light node runs without the blockchain, the transaction
+//pool and the smart contract engine of a full node, and fetches the rest from full peers on demand. The blocks are
+//only fetched to tally the votes of the election. It runs until the process is interrupted
+func runLightNode(genesisConf *configpb.DynastyConfig, conf *configpb.Config, genesis *lblockchain.Genesis, node *network.Node, db storage.Storage) {
+	dynasty := initDynasty(genesisConf, genesis, db)
+	schedule := initDynastySchedule(genesisConf, dynasty, db)
+
+	chain := lightnode.NewHeaderChain(db, genesis.ToBlock())
+	verifier := consensus.NewHeaderVerifier(dynasty, schedule, chain)
+	verifier.SetForks(initForks(genesisConf))
+	chain.SetVerifier(verifier)
+	lightNode := lightnode.NewLightNode(node, chain)
+	lightNode.SetForks(initForks(genesisConf))
+	election := consensus.NewElection(lightNode, genesisConf.GetEpochLength(), dynasty.GetMaxProducers(), schedule)
+	if checkpoint, err := consensus.LoadElectionCheckpoint(db); err == nil {
+		election.SetCheckpoint(checkpoint)
+	}
+	verifier.SetElection(election)
+	lightNode.Start()
+	defer lightNode.Stop()
+
+	server := rpc.NewLightGrpcServer(lightNode, genesis.ChainID)
+	server.Start(conf.GetNodeConfig().GetRpcPort())
+	defer server.Stop()
+
+	logger.WithFields(logger.Fields{
+		"tail_height":      chain.GetMaxHeight(),
+		"time_between_blk": dynasty.GetTimeBetweenBlk(),
+		"max_producers":    dynasty.GetMaxProducers(),
+	}).Info("Light node is started. Only the block headers are synced.")
+
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+	<-sigCh
+	logger.Info("Light node is stopping...")
+}
+
+//initBlockSigner returns the signer of the local producer. A remote signer or an encrypted keystore keeps the producer
+//key out of the config file, and the plaintext private key is only used if neither is configured
+func initBlockSigner(conf *configpb.ConsensusConfig) blocksigner.BlockSigner {
+	var signer blocksigner.BlockSigner
+	var err error
+	switch {
+	case conf.GetRemoteSigner() != "":
+		var tlsConfig *tls.Config
+		if conf.GetRemoteSignerCert() != "" {
+			tlsConfig, err = blocksigner.LoadTLSConfig(conf.GetRemoteSignerCert(), conf.GetRemoteSignerKey(), conf.GetRemoteSignerCa())
+			if err != nil {
+				logger.WithError(err).Panic("Cannot load the TLS certificates of the remote signer!")
+			}
+		}
+		signer, err = blocksigner.NewRemoteSigner(conf.GetRemoteSigner(), tlsConfig)
+		if err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"remote_signer": conf.GetRemoteSigner(),
+			}).Panic("Cannot connect to the remote signer!")
+		}
+	case conf.GetKeystore() != "":
+		signer, err = blocksigner.LoadLocalSigner(conf.GetKeystore(), readKeystorePassphrase(conf.GetKeystorePassphraseFile()))
+		if err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"keystore": conf.GetKeystore(),
+			}).Panic("Cannot unlock the keystore of the producer!")
+		}
+	case conf.GetPrivateKey() != "":
+		signer, err = blocksigner.NewLocalSignerFromHex(conf.GetPrivateKey())
+		if err != nil {
+			logger.WithError(err).Panic("The private key of the producer is invalid!")
+		}
+	default:
+		return nil
+	}
+
+	if signer.GetAddress() != conf.GetMinerAddress() {
+		logger.WithFields(logger.Fields{
+			"miner_address":  conf.GetMinerAddress(),
+			"signer_address": signer.GetAddress(),
+		}).Warn("The signer does not sign for the miner address!")
+	}
+	return signer
+}
+
+//readKeystorePassphrase reads the keystore passphrase from the file, or prompts for it if no file is set
+func readKeystorePassphrase(path string) []byte {
+	if path == "" {
+		return []byte(util.Stdin.GetPassPhrase("Please input the passphrase of the producer keystore:", false))
+	}
+	passphrase, err := ioutil.ReadFile(path)
+	if err != nil {
+		logger.WithError(err).Panic("Cannot read the keystore passphrase file!")
+	}
+	return bytes.TrimSpace(passphrase)
+}
+
+func initInstantSeal(generalConf *configpb.Config) *consensus.InstantSeal {
+	seal := consensus.NewInstantSeal(blockproducerinfo.NewBlockProducerInfo(generalConf.GetConsensusConfig().GetMinerAddress()))
+	seal.SetKey(generalConf.GetConsensusConfig().GetPrivateKey())
+	logger.WithFields(logger.Fields{
+		"miner_address": generalConf.GetConsensusConfig().GetMinerAddress(),
+	}).Warn("Instant seal consensus is configured. Blocks are produced on demand by the local producer only.")
+	return seal
+}
+
+//loadGenesis returns the genesis in the genesis file. The chain id and the timestamp are the default ones if not set, and
+//the subsidy is paid to the default genesis address if there is no allocation
+func loadGenesis(genesisConf *configpb.DynastyConfig, dir string) (*lblockchain.Genesis, error) {
+	genesis := lblockchain.NewDefaultGenesis(account.NewAddress(genesisAddr), transaction.Subsidy)
+	if genesisConf.GetChainId() != 0 {
+		genesis.ChainID = genesisConf.GetChainId()
+	}
+	if genesisConf.GetGenesisTimestamp() != 0 {
+		genesis.Timestamp = genesisConf.GetGenesisTimestamp()
+	}
+	genesis.Producers = genesisConf.GetProducers()
+	if len(genesisConf.GetAllocations()) > 0 {
+		genesis.Allocations = nil
+	}
+	for _, allocation := range genesisConf.GetAllocations() {
+		amount, err := common.NewAmountFromString(allocation.GetAmount())
+		if err != nil {
+			return nil, err
+		}
+		genesis.Allocations = append(genesis.Allocations, &lblockchain.GenesisAllocation{
+			Address: account.NewAddress(allocation.GetAddress()),
+			Amount:  amount,
+		})
+	}
+	for _, contract := range genesisConf.GetContracts() {
+		sourcePath := contract.GetSourceFile()
+		if !filepath.IsAbs(sourcePath) {
+			sourcePath = filepath.Join(dir, sourcePath)
+		}
+		source, err := ioutil.ReadFile(sourcePath)
+		if err != nil {
+			return nil, err
+		}
+		genesis.Contracts = append(genesis.Contracts, &lblockchain.GenesisContract{
+			Address: account.NewAddress(contract.GetAddress()),
+			Source:  string(source),
+			Storage: contract.GetStorage(),
+		})
+	}
+	return genesis, genesis.Verify()
+}
+
+func initNode(conf *configpb.Config, db storage.Storage, genesis *lblockchain.Genesis) (*network.Node, error) {
 
 	nodeConfig := conf.GetNodeConfig()
 	seeds := nodeConfig.GetSeed()
 	port := nodeConfig.GetPort()
 	key := nodeConfig.GetKey()
 
-	node := network.NewNode(db, seeds)
+	//only peers with the same genesis block are connected. The genesis block in the database has been verified against
+	//the genesis file, and differs from it only if it predates the genesis file
+	genesisHash := genesis.ToBlock().GetHash()
+	if chainGenesisHash, err := lblockchain.GetGenesisHash(db); err == nil {
+		genesisHash = chainGenesisHash
+	}
+	peerConfig := networkmodel.PeerConnectionConfig{}
+	peerConfig.SetGenesisHash(genesisHash)
+
+	node := network.NewNodeWithConfig(db, peerConfig, seeds)
 	err := node.Start(int(port), key)
 	if err != nil {
 		logger.Error(err)
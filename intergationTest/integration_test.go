@@ -674,7 +674,7 @@ func CreateProducer(producerAddr, addr account.Address, db *storage.RamStorage,
 	bpConsensus.On("ProduceBlock", mock.Anything).Run(func(args mock.Arguments) {
 		args.Get(0).(func(process func(*block.Block), deadline deadline.Deadline))(
 			func(blk *block.Block) {
-				hash := lblock.CalculateHash(blk)
+				hash := lblock.CalculateHash(blk, nil)
 				blk.SetHash(hash)
 			},
 			deadline.NewUnlimitedDeadline(),
@@ -700,7 +700,7 @@ func TestDoubleMint(t *testing.T) {
 
 	dynasty := consensus.NewDynasty([]string{validProducerAddr}, len([]string{validProducerAddr}), 15)
 	producerHash := validProducerAccount.GetPubKeyHash()
-	tx := &transaction.Transaction{nil, []transactionbase.TXInput{{[]byte{}, -1, nil, nil}}, []transactionbase.TXOutput{{common.NewAmount(0), account.PubKeyHash(producerHash), ""}}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}
+	tx := &transaction.Transaction{nil, []transactionbase.TXInput{{[]byte{}, -1, nil, nil}}, []transactionbase.TXOutput{{common.NewAmount(0), account.PubKeyHash(producerHash), ""}}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}
 
 	for i := 0; i < 3; i++ {
 		blk := createValidBlock([]*transaction.Transaction{tx}, validProducerKey, validProducerAddr, parent)
@@ -751,7 +751,7 @@ func TestDoubleMint(t *testing.T) {
 
 func createValidBlock(tx []*transaction.Transaction, validProducerKey, validProducerAddr string, parent *block.Block) *block.Block {
 	blk := block.NewBlock(tx, parent, validProducerAddr)
-	blk.SetHash(lblock.CalculateHashWithNonce(blk))
+	blk.SetHash(lblock.CalculateHashWithNonce(blk, nil))
 	lblock.SignBlock(blk, validProducerKey)
 	return blk
 }
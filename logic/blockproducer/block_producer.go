@@ -19,6 +19,7 @@ import (
 	"github.com/dappley/go-dappley/logic/lblockchain"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
 	"github.com/dappley/go-dappley/vm"
 	logger "github.com/sirupsen/logrus"
 )
@@ -146,7 +147,19 @@ func (bp *BlockProducer) prepareBlock(deadline deadline.Deadline) *lblockchain.B
 		"valid_txs": len(validTxs),
 	}).Info("BlockProducer: prepared a block.")
 
-	ctx := lblockchain.BlockContext{Block: block.NewBlock(validTxs, parentBlock, bp.producer.Beneficiary()), UtxoIndex: utxoIndex, State: state}
+	blk := block.NewBlock(validTxs, parentBlock, bp.producer.Beneficiary())
+	if bp.bm.Getblockchain().GetForks().IsStateRootActive(blk.GetHeight()) {
+		//the state trie is saved when the block is added to the blockchain
+		db := bp.bm.Getblockchain().GetDb()
+		stateRoot, err := lblock.CalculateStateRoot(blk, parentBlock, scState.LoadScStateFromDatabase(db), state, storage.NewOverlay(db))
+		if err != nil {
+			logger.WithError(err).Error("BlockProducer: cannot calculate the state root of the new block!")
+			return nil
+		}
+		blk.SetStateRoot(stateRoot)
+	}
+
+	ctx := lblockchain.BlockContext{Block: blk, UtxoIndex: utxoIndex, State: state}
 	return &ctx
 }
 
@@ -162,10 +175,11 @@ func (bp *BlockProducer) collectTransactions(utxoIndex *lutxo.UTXOIndex, parentB
 	defer engine.DestroyEngine()
 	rewards := make(map[string]string)
 	currBlkHeight := parentBlk.GetHeight() + 1
+	chainID := bp.bm.Getblockchain().GetChainIDAtHeight(currBlkHeight)
 
 	for totalSize < bp.bm.Getblockchain().GetBlockSizeLimit() && bp.bm.Getblockchain().GetTxPool().GetNumOfTxInPool() > 0 && !deadline.IsPassed() {
 
-		txNode := bp.bm.Getblockchain().GetTxPool().PopTransactionWithMostTips(utxoIndex)
+		txNode := bp.bm.Getblockchain().GetTxPool().PopTransactionWithMostTips(utxoIndex, chainID)
 		if txNode == nil {
 			break
 		}
@@ -225,7 +239,7 @@ func (bp *BlockProducer) addBlockToBlockchain(ctx *lblockchain.BlockContext) {
 		"height": ctx.Block.GetHeight(),
 		"hash":   ctx.Block.GetHash().String(),
 	}).Info("BlockProducer: produced a new block.")
-	if !lblock.VerifyHash(ctx.Block) {
+	if !lblock.VerifyHash(ctx.Block, bp.bm.Getblockchain().GetForks()) {
 		logger.Warn("BlockProducer: hash of the new block is invalid.")
 		return
 	}
@@ -32,6 +32,7 @@ import (
 	"github.com/dappley/go-dappley/common/pubsub"
 	"github.com/dappley/go-dappley/core/block"
 	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
 	"github.com/dappley/go-dappley/logic/lblockchain"
 	"github.com/dappley/go-dappley/network"
 	"github.com/dappley/go-dappley/network/networkmodel"
@@ -338,11 +339,13 @@ func (downloadManager *DownloadManager) GetBlocksDataHandler(blocksPb *networkpb
 		block := &block.Block{}
 		block.FromProto(pbBlock)
 
-		if !downloadManager.bm.VerifyBlock(block) {
+		//the producer of the block is verified before the blocks are merged, against the dynasty elected by the
+		//blocks before it in the same batch
+		if !lblock.VerifyHash(block, downloadManager.bm.Getblockchain().GetForks()) {
 			returnBlocksLogger.WithFields(logger.Fields{
 				"height": block.GetHeight(),
 				"hash":   block.GetHash(),
-			}).Warn("DownloadManager: verify block failed.")
+			}).Warn("DownloadManager: verify block hash failed.")
 			return
 		}
 
@@ -814,6 +817,15 @@ func (downloadManager *DownloadManager) SendGetBlocksResponse(startBlockHashes [
 		return
 	}
 
+	//the bodies of pruned blocks cannot be sent
+	if downloadManager.bm.Getblockchain().IsPruned(blk.GetHeight() + 1) {
+		logger.WithFields(logger.Fields{
+			"name":   "GetBlocksRequest",
+			"height": blk.GetHeight() + 1,
+		}).Info("DownloadManager: the requested blocks are pruned.")
+		return
+	}
+
 	var blks []*block.Block
 
 	blk, err := downloadManager.bm.Getblockchain().GetBlockByHeight(blk.GetHeight() + 1)
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"reflect"
 
 	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/state"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
@@ -15,34 +17,56 @@ import (
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/merkle"
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/crypto/keystore/secp256k1"
 	"github.com/dappley/go-dappley/crypto/sha3"
+	"github.com/dappley/go-dappley/storage"
 	"github.com/dappley/go-dappley/util"
 	logger "github.com/sirupsen/logrus"
 )
 
-func HashTransactions(b *block.Block) []byte {
-	var txHashes [][]byte
-	var txHash [32]byte
+var (
+	ErrStateRootMismatch  = errors.New("block: the state root does not match the state after the block")
+	ErrMerkleRootInactive = errors.New("block: the transactions of the block are not hashed into a merkle root")
+)
+
+//HashTransactions returns the Merkle root over the hashes of the transactions in the block. The blocks below the
+//Merkle root fork are hashed over the concatenated hashes of their transactions
+func HashTransactions(b *block.Block, forks *block.Forks) []byte {
+	if !forks.IsMerkleRootActive(b.GetHeight()) {
+		txHash := sha256.Sum256(bytes.Join(getTransactionHashes(b), []byte{}))
+		return txHash[:]
+	}
+	return merkle.CalculateRoot(getTransactionHashes(b))
+}
+
+//GetTransactionMerkleBranch returns the Merkle branch that proves the transaction at the index is in the block. The
+//blocks below the Merkle root fork have no branch
+func GetTransactionMerkleBranch(b *block.Block, index int, forks *block.Forks) ([]hash.Hash, error) {
+	if !forks.IsMerkleRootActive(b.GetHeight()) {
+		return nil, ErrMerkleRootInactive
+	}
+	return merkle.GetBranch(getTransactionHashes(b), index)
+}
 
+func getTransactionHashes(b *block.Block) [][]byte {
+	var txHashes [][]byte
 	for _, tx := range b.GetTransactions() {
 		txHashes = append(txHashes, tx.Hash())
 	}
-	txHash = sha256.Sum256(bytes.Join(txHashes, []byte{}))
-
-	return txHash[:]
+	return txHashes
 }
 
-func CalculateHash(b *block.Block) hash.Hash {
-	return CalculateHashWithNonce(b)
+func CalculateHash(b *block.Block, forks *block.Forks) hash.Hash {
+	return CalculateHashWithNonce(b, forks)
 }
 
-func CalculateHashWithoutNonce(b *block.Block) hash.Hash {
+func CalculateHashWithoutNonce(b *block.Block, forks *block.Forks) hash.Hash {
 	data := bytes.Join(
 		[][]byte{
 			b.GetPrevHash(),
-			HashTransactions(b),
+			HashTransactions(b, forks),
 			util.IntToHex(b.GetTimestamp()),
 			[]byte(b.GetProducer()),
 		},
@@ -54,20 +78,28 @@ func CalculateHashWithoutNonce(b *block.Block) hash.Hash {
 	return hasher.Sum(nil)
 }
 
-func CalculateHashWithNonce(b *block.Block) hash.Hash {
-	data := bytes.Join(
-		[][]byte{
-			b.GetPrevHash(),
-			HashTransactions(b),
-			util.IntToHex(b.GetTimestamp()),
-			//util.IntToHex(targetBits),
-			util.IntToHex(b.GetNonce()),
-			[]byte(b.GetProducer()),
-		},
-		[]byte{},
-	)
-	h := sha256.Sum256(data)
-	return h[:]
+func CalculateHashWithNonce(b *block.Block, forks *block.Forks) hash.Hash {
+	return block.CalculateHeaderHash(b.GetPrevHash(), HashTransactions(b, forks), b.GetTimestamp(), b.GetNonce(), b.GetProducer(), b.GetVRFProof(), b.GetStateRoot())
+}
+
+//NewSignedHeader returns the signed header of the block, which proves the signer of the block without its transactions
+func NewSignedHeader(b *block.Block, forks *block.Forks) *block.SignedHeader {
+	return &block.SignedHeader{
+		PrevHash:  b.GetPrevHash(),
+		TxsHash:   HashTransactions(b, forks),
+		Timestamp: b.GetTimestamp(),
+		Nonce:     b.GetNonce(),
+		Producer:  b.GetProducer(),
+		Height:    b.GetHeight(),
+		Signature: b.GetSign(),
+		VRFProof:  b.GetVRFProof(),
+		StateRoot: b.GetStateRoot(),
+	}
+}
+
+//NewEvidence returns the evidence that the two input blocks are signed by the same producer
+func NewEvidence(b1 *block.Block, b2 *block.Block, forks *block.Forks) *block.Evidence {
+	return block.NewEvidence(NewSignedHeader(b1, forks), NewSignedHeader(b2, forks))
 }
 
 func SignBlock(b *block.Block, key string) bool {
@@ -100,11 +132,102 @@ func generateSignature(key string, data hash.Hash) (hash.Hash, error) {
 	return signature, nil
 }
 
-func VerifyHash(b *block.Block) bool {
-	return bytes.Compare(b.GetHash(), CalculateHash(b)) == 0
+func VerifyHash(b *block.Block, forks *block.Forks) bool {
+	return bytes.Compare(b.GetHash(), CalculateHash(b, forks)) == 0
+}
+
+//VerifyTimestamp checks that the block is not stamped earlier than its parent
+func VerifyTimestamp(b *block.Block, parentBlk *block.Block) bool {
+	if parentBlk == nil || b.GetTimestamp() < parentBlk.GetTimestamp() {
+		logger.WithFields(logger.Fields{
+			"hash":      b.GetHash(),
+			"height":    b.GetHeight(),
+			"timestamp": b.GetTimestamp(),
+		}).Warn("Block: the block is stamped earlier than its parent.")
+		return false
+	}
+	return true
+}
+
+//IsFutureBlock returns if the block is stamped more than maxDrift seconds later than the input time
+func IsFutureBlock(b *block.Block, now int64, maxDrift int64) bool {
+	return b.GetTimestamp() > now+maxDrift
+}
+
+//GetStateRoot returns the state root after the block. The root is read from the saved state roots if the block does
+//not carry it in its header
+func GetStateRoot(b *block.Block, db storage.Storage) (hash.Hash, error) {
+	if len(b.GetStateRoot()) > 0 {
+		return b.GetStateRoot(), nil
+	}
+	return state.GetStateRoot(db, b.GetHash())
+}
+
+//CalculateStateRoot returns the state root after the transactions of the block are applied on the state of its parent,
+//and the contract storage is changed from the parent storage to the new one. The nodes of the state trie are saved in
+//db, which should be an overlay that is discarded unless the block is added to the blockchain
+func CalculateStateRoot(b *block.Block, parentBlk *block.Block, parentScState *scState.ScState, newScState *scState.ScState, db storage.Storage) (hash.Hash, error) {
+	var parentRoot hash.Hash
+	if parentBlk != nil {
+		var err error
+		if parentRoot, err = GetStateRoot(parentBlk, db); err != nil {
+			return nil, err
+		}
+	}
+	stateTrie, err := state.NewStateTrie(parentRoot, db)
+	if err != nil {
+		return nil, err
+	}
+	if err := stateTrie.ApplyTransactions(b.GetTransactions()); err != nil {
+		return nil, err
+	}
+	if err := stateTrie.ApplyContractStorage(parentScState.GetChanges(newScState)); err != nil {
+		return nil, err
+	}
+	return stateTrie.RootHash(), nil
+}
+
+//CommitStateRoot saves the nodes of the state trie after the block and its state root in db. It is called when the
+//block is added to the blockchain
+func CommitStateRoot(b *block.Block, parentBlk *block.Block, parentScState *scState.ScState, newScState *scState.ScState, db storage.Storage) (hash.Hash, error) {
+	stateRoot, err := CalculateStateRoot(b, parentBlk, parentScState, newScState, db)
+	if err != nil {
+		return nil, err
+	}
+	if len(b.GetStateRoot()) > 0 && !bytes.Equal(stateRoot, b.GetStateRoot()) {
+		return nil, ErrStateRootMismatch
+	}
+	if err := state.SaveStateRoot(db, b.GetHash(), stateRoot); err != nil {
+		return nil, err
+	}
+	return stateRoot, nil
+}
+
+//VerifyStateRoot checks that the state root in the block header matches the state after the block. The trie nodes of
+//the state are written into a scratch overlay that is discarded, as the block may not be added to the blockchain
+func VerifyStateRoot(b *block.Block, parentBlk *block.Block, parentScState *scState.ScState, newScState *scState.ScState, db storage.Storage) bool {
+	stateRoot, err := CalculateStateRoot(b, parentBlk, parentScState, newScState, storage.NewOverlay(db))
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"hash":   b.GetHash(),
+			"height": b.GetHeight(),
+		}).Warn("Block: cannot calculate the state root.")
+		return false
+	}
+	if !bytes.Equal(stateRoot, b.GetStateRoot()) {
+		logger.WithFields(logger.Fields{
+			"hash":   b.GetHash(),
+			"height": b.GetHeight(),
+		}).Warn("Block: state root verify failed.")
+		return false
+	}
+	return true
 }
 
-func VerifyTransactions(b *block.Block, utxoIndex *lutxo.UTXOIndex, scState *scState.ScState, parentBlk *block.Block) bool {
+//VerifyTransactions checks the transactions of the block on top of the UTXO index and the contract storage of its
+//parent, which are updated with the transactions. The transactions have to be signed for the chain id, and the state
+//root is checked from the state root fork on
+func VerifyTransactions(b *block.Block, utxoIndex *lutxo.UTXOIndex, scState *scState.ScState, parentBlk *block.Block, db storage.Storage, forks *block.Forks, chainID uint64) bool {
 	if len(b.GetTransactions()) == 0 {
 		logger.WithFields(logger.Fields{
 			"hash":   b.GetHash(),
@@ -112,6 +235,7 @@ func VerifyTransactions(b *block.Block, utxoIndex *lutxo.UTXOIndex, scState *scS
 		}).Warn("Block: there is no transaction to verify in this block.")
 		return false
 	}
+	parentScState := scState.DeepCopy()
 
 	var coinbaseTx *transaction.Transaction
 	totalTip := common.NewAmount(0)
@@ -166,7 +290,7 @@ L:
 			actualGasList = append(actualGasList, gasCount*tx.GasPrice.Uint64())
 		} else {
 			// tx is a normal transactions
-			if err := ltransaction.VerifyTransaction(utxoIndex, tx, b.GetHeight()); err != nil {
+			if err := ltransaction.VerifyTransaction(utxoIndex, tx, b.GetHeight(), chainID); err != nil {
 				logger.WithFields(logger.Fields{
 					"hash":   b.GetHash(),
 					"height": b.GetHeight(),
@@ -226,7 +350,10 @@ L:
 		}).Warn("Block: generated tx cannot be verified.")
 		return false
 	}
-	return true
+	if !forks.IsStateRootActive(b.GetHeight()) {
+		return true
+	}
+	return VerifyStateRoot(b, parentBlk, parentScState, scState, db)
 }
 
 // verifyGeneratedTXs verify that transactions generated by gas reward or change is same with its inputs
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/state"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/core/utxo"
@@ -37,29 +38,50 @@ func TestHashTransactions(t *testing.T) {
 	var expectHash = []uint8([]byte{0x5d, 0xf6, 0xe0, 0xe2, 0x76, 0x13, 0x59, 0xd3, 0xa, 0x82, 0x75, 0x5, 0x8e, 0x29, 0x9f, 0xcc, 0x3, 0x81, 0x53, 0x45, 0x45, 0xf5, 0x5c, 0xf4, 0x3e, 0x41, 0x98, 0x3f, 0x5d, 0x4c, 0x94, 0x56})
 
 	blk := block.NewBlock([]*transaction.Transaction{{}}, parentBlk, "")
-	hash := HashTransactions(blk)
+	hash := HashTransactions(blk, &block.Forks{MerkleRootHeight: 2})
 	assert.Equal(t, expectHash, hash)
+
+	var expectMerkleRoot = []uint8([]byte{0x4e, 0x59, 0xbf, 0x27, 0x37, 0x2b, 0x13, 0x4, 0xbc, 0xb, 0x13, 0x7d, 0x1b, 0xe9, 0xd5, 0x66, 0xad, 0x58, 0xb1, 0x54, 0xb6, 0xa6, 0xb5, 0x77, 0x8a, 0xf7, 0xf4, 0x14, 0xb1, 0xd4, 0xb8, 0x4c})
+	assert.Equal(t, expectMerkleRoot, HashTransactions(blk, &block.Forks{MerkleRootHeight: 1}))
+	_, err := GetTransactionMerkleBranch(blk, 0, &block.Forks{MerkleRootHeight: 2})
+	assert.Equal(t, ErrMerkleRootInactive, err)
 }
 
 func TestBlock_VerifyHash(t *testing.T) {
 	b1 := block.GenerateMockBlock()
 
 	//The mocked block does not have correct h Value
-	assert.False(t, VerifyHash(b1))
+	assert.False(t, VerifyHash(b1, nil))
 
 	//calculate correct h Value
-	h := CalculateHash(b1)
+	h := CalculateHash(b1, nil)
 	b1.SetHash(h)
-	assert.True(t, VerifyHash(b1))
+	assert.True(t, VerifyHash(b1, nil))
 
 	//calculate a h Value with a different nonce
 	b1.SetNonce(b1.GetNonce() + 1)
-	h = CalculateHashWithNonce(b1)
-	assert.False(t, VerifyHash(b1))
+	h = CalculateHashWithNonce(b1, nil)
+	assert.False(t, VerifyHash(b1, nil))
 
-	h = CalculateHashWithoutNonce(b1)
+	h = CalculateHashWithoutNonce(b1, nil)
 	b1.SetHash(h)
-	assert.False(t, VerifyHash(b1))
+	assert.False(t, VerifyHash(b1, nil))
+}
+
+func TestVerifyTimestamp(t *testing.T) {
+	parent := block.NewBlockWithTimestamp(nil, nil, 100, "")
+	assert.True(t, VerifyTimestamp(block.NewBlockWithTimestamp(nil, parent, 105, ""), parent))
+	assert.True(t, VerifyTimestamp(block.NewBlockWithTimestamp(nil, parent, 100, ""), parent))
+	assert.False(t, VerifyTimestamp(block.NewBlockWithTimestamp(nil, parent, 95, ""), parent))
+	assert.False(t, VerifyTimestamp(block.NewBlockWithTimestamp(nil, parent, 105, ""), nil))
+}
+
+func TestIsFutureBlock(t *testing.T) {
+	blk := block.NewBlockWithTimestamp(nil, nil, 100, "")
+	assert.False(t, IsFutureBlock(blk, 100, 0))
+	assert.True(t, IsFutureBlock(blk, 99, 0))
+	assert.False(t, IsFutureBlock(blk, 98, 2))
+	assert.True(t, IsFutureBlock(blk, 97, 2))
 }
 
 func TestCalculateHashWithNonce(t *testing.T) {
@@ -74,12 +96,17 @@ func TestCalculateHashWithNonce(t *testing.T) {
 
 	blk := block.NewBlock([]*transaction.Transaction{{}}, parentBlk, "")
 	blk.SetTimestamp(0)
+	legacyForks := &block.Forks{MerkleRootHeight: 2}
 	expectHash1 := hash.Hash{0x3f, 0x2f, 0xec, 0xb4, 0x33, 0xf0, 0xd1, 0x1a, 0xa6, 0xf4, 0xf, 0xb8, 0x7f, 0x8f, 0x99, 0x11, 0xae, 0xe7, 0x42, 0xf4, 0x69, 0x7d, 0xf1, 0xaa, 0xc8, 0xd0, 0xfc, 0x40, 0xa2, 0xd8, 0xb1, 0xa5}
 	blk.SetNonce(1)
-	assert.Equal(t, hash.Hash(expectHash1), CalculateHashWithNonce(blk))
+	assert.Equal(t, hash.Hash(expectHash1), CalculateHashWithNonce(blk, legacyForks))
 	expectHash2 := hash.Hash{0xe7, 0x57, 0x13, 0xc6, 0x8a, 0x98, 0x58, 0xb3, 0x5, 0x70, 0x6e, 0x33, 0xf0, 0x95, 0xd8, 0x1a, 0xbc, 0x76, 0xef, 0x30, 0x14, 0x59, 0x88, 0x11, 0x3c, 0x11, 0x59, 0x92, 0x65, 0xd5, 0xd3, 0x4c}
 	blk.SetNonce(2)
-	assert.Equal(t, hash.Hash(expectHash2), CalculateHashWithNonce(blk))
+	assert.Equal(t, hash.Hash(expectHash2), CalculateHashWithNonce(blk, legacyForks))
+
+	//the hashes change from the Merkle root fork on
+	expectMerkleHash := hash.Hash{0x1f, 0x4b, 0xa4, 0xff, 0x6c, 0xc4, 0x84, 0x71, 0x45, 0x75, 0xfc, 0xef, 0x4d, 0x2a, 0x3b, 0xbf, 0xbc, 0xc0, 0xb3, 0x21, 0x50, 0x35, 0x84, 0xc1, 0x22, 0xc6, 0x3a, 0x20, 0xe, 0x74, 0x18, 0xf4}
+	assert.Equal(t, expectMerkleHash, CalculateHashWithNonce(blk, nil))
 }
 
 func TestBlock_VerifyTransactions(t *testing.T) {
@@ -112,6 +139,7 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 		common.NewAmount(0),
 		0,
 		transaction.TxTypeNormal,
+		0,
 	}
 
 	var prikey1 = "bb23d2ff19f5b16955e8a24dca34dd520980fe3bddca2b3e1b56663f0ec1aa71"
@@ -225,6 +253,16 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 				0,
 				nil,
 			)
+			parentState, err := state.NewStateTrie(nil, db)
+			assert.Nil(t, err)
+			for _, addrUtxos := range tt.utxos {
+				for _, addrUtxo := range addrUtxos {
+					assert.Nil(t, parentState.PutUTXO(addrUtxo.Txid, addrUtxo.TxIndex, &addrUtxo.TXOutput))
+				}
+			}
+			parentBlk.SetStateRoot(parentState.RootHash())
+			//the root of an empty state is empty, so that it is read from the saved state roots
+			assert.Nil(t, state.SaveStateRoot(db, parentBlk.GetHash(), parentState.RootHash()))
 			// add coinbase
 			totalTip := common.NewAmount(0)
 			for _, tx := range tt.txs {
@@ -233,7 +271,10 @@ func TestBlock_VerifyTransactions(t *testing.T) {
 			coninbaseTx := ltransaction.NewCoinbaseTX(address1TA.GetAddress(), "", parentBlk.GetHeight()+1, totalTip)
 			tt.txs = append(tt.txs, &coninbaseTx)
 			blk := block.NewBlock(tt.txs, parentBlk, "")
-			assert.Equal(t, tt.ok, VerifyTransactions(blk, utxoIndex, scState, parentBlk))
+			if stateRoot, err := CalculateStateRoot(blk, parentBlk, scState, scState, db); err == nil {
+				blk.SetStateRoot(stateRoot)
+			}
+			assert.Equal(t, tt.ok, VerifyTransactions(blk, utxoIndex, scState, parentBlk, db, nil, 0))
 		})
 	}
 }
@@ -253,3 +294,56 @@ func NewTransactionByVin(vinTxId []byte, vinVout int, vinPubkey []byte, voutValu
 	tx.ID = tx.Hash()
 	return tx
 }
+
+func TestCommitStateRoot(t *testing.T) {
+	db := storage.NewRamStorage()
+	acc := account.NewAccount()
+
+	genesisTx := ltransaction.NewCoinbaseTX(acc.GetAddress(), "", 0, common.NewAmount(0))
+	genesis := block.NewBlockWithRawInfo(nil, nil, 0, time.Now().Unix(), 0, []*transaction.Transaction{&genesisTx})
+	genesis.SetHash(CalculateHash(genesis, nil))
+	genesisRoot, err := CommitStateRoot(genesis, nil, scState.NewScState(), scState.NewScState(), db)
+	assert.Nil(t, err)
+	assert.Nil(t, genesis.GetStateRoot())
+	savedRoot, err := GetStateRoot(genesis, db)
+	assert.Nil(t, err)
+	assert.Equal(t, genesisRoot, savedRoot)
+
+	coinbaseTx := ltransaction.NewCoinbaseTX(acc.GetAddress(), "", 1, common.NewAmount(0))
+	blk := block.NewBlock([]*transaction.Transaction{&coinbaseTx}, genesis, "")
+
+	//the state root is verified without saving the trie nodes
+	scratch := storage.NewOverlay(db)
+	stateRoot, err := CalculateStateRoot(blk, genesis, scState.NewScState(), scState.NewScState(), scratch)
+	assert.Nil(t, err)
+	blk.SetStateRoot(stateRoot)
+	blk.SetHash(CalculateHash(blk, nil))
+	assert.True(t, VerifyStateRoot(blk, genesis, scState.NewScState(), scState.NewScState(), db))
+	_, err = state.NewStateTrie(stateRoot, db)
+	assert.NotNil(t, err)
+	_, err = GetStateRoot(block.NewBlock(nil, blk, ""), db)
+	assert.Equal(t, state.ErrStateRootNotFound, err)
+
+	//the trie nodes are saved when the block is committed
+	committedRoot, err := CommitStateRoot(blk, genesis, scState.NewScState(), scState.NewScState(), db)
+	assert.Nil(t, err)
+	assert.Equal(t, stateRoot, committedRoot)
+	_, err = state.NewStateTrie(stateRoot, db)
+	assert.Nil(t, err)
+
+	blk.SetStateRoot([]byte("fake state root"))
+	_, err = CommitStateRoot(blk, genesis, scState.NewScState(), scState.NewScState(), db)
+	assert.Equal(t, ErrStateRootMismatch, err)
+}
+
+func TestVerifyTransactions_BelowStateRootFork(t *testing.T) {
+	db := storage.NewRamStorage()
+	acc := account.NewAccount()
+	genesis := block.NewBlockWithRawInfo([]byte("genesis"), nil, 0, time.Now().Unix(), 0, nil)
+	coinbaseTx := ltransaction.NewCoinbaseTX(acc.GetAddress(), "", 1, common.NewAmount(0))
+	blk := block.NewBlock([]*transaction.Transaction{&coinbaseTx}, genesis, "")
+
+	//the state of the parent is not saved, so that the state root cannot be checked
+	assert.True(t, VerifyTransactions(blk, lutxo.NewUTXOIndex(utxo.NewUTXOCache(db)), scState.NewScState(), genesis, db, &block.Forks{StateRootHeight: 2}, 0))
+	assert.False(t, VerifyTransactions(blk, lutxo.NewUTXOIndex(utxo.NewUTXOCache(db)), scState.NewScState(), genesis, db, &block.Forks{StateRootHeight: 1}, 0))
+}
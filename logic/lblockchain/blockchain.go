@@ -20,14 +20,17 @@ package lblockchain
 
 import (
 	"bytes"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"sync"
 
+	"github.com/dappley/go-dappley/core/history"
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/migration"
 	"github.com/dappley/go-dappley/logic/transactionpool"
 
 	"github.com/dappley/go-dappley/common/hash"
@@ -39,12 +42,14 @@ import (
 	"github.com/dappley/go-dappley/core/utxo"
 	"github.com/dappley/go-dappley/storage"
 	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
 	"github.com/jinzhu/copier"
 	logger "github.com/sirupsen/logrus"
 )
 
 var tipKey = []byte("tailBlockHash")
 var libKey = []byte("lastIrreversibleBlockHash")
+var finalityCertificatePrefix = []byte("finality_")
 
 var (
 	ErrBlockDoesNotExist       = errors.New("block does not exist in db")
@@ -53,6 +58,14 @@ var (
 	ErrTransactionVerifyFailed = errors.New("transaction verification failed")
 	ErrRewardTxVerifyFailed    = errors.New("Verify reward transaction failed")
 	ErrProducerNotEnough       = errors.New("producer number is less than ConsensusSize")
+	ErrProducerVerifyFailed    = errors.New("block is not produced by the dynasty at its height")
+	ErrRollbackFailed          = errors.New("failed to roll the blockchain back to the fork parent")
+	ErrBlockTimestampInvalid   = errors.New("block timestamp verify failed")
+	ErrBlockNotOnMainChain     = errors.New("block is not on the main chain")
+	ErrCertificateNotFound     = errors.New("finality certificate not found")
+	ErrBlockPruned             = errors.New("block body has been pruned")
+	ErrBlockchainExists        = errors.New("blockchain already exists in db")
+	ErrHeadersNotLinked        = errors.New("headers do not link to the block")
 	// DefaultGasPrice default price of per gas
 	DefaultGasPrice uint64 = 1
 )
@@ -67,11 +80,21 @@ type Blockchain struct {
 	eventManager *scState.EventManager
 	blkSizeLimit int
 	mutex        *sync.Mutex
+	finality     BlockFinalizer
+	pruneDepth   uint64
+	forks        *block.Forks
+	election     ElectionCheckpointer
+	chainID      uint64
 }
 
-// CreateBlockchain creates a new blockchain db
+// CreateBlockchain creates a new blockchain db with the default genesis block paying the subsidy to the address
 func CreateBlockchain(address account.Address, db storage.Storage, libPolicy LIBPolicy, txPool *transactionpool.TransactionPool, scManager ltransaction.ScEngineManager, blkSizeLimit int) *Blockchain {
-	genesis := NewGenesisBlock(address, transaction.Subsidy)
+	return CreateBlockchainWithGenesis(NewDefaultGenesis(address, transaction.Subsidy), db, libPolicy, txPool, scManager, blkSizeLimit)
+}
+
+// CreateBlockchainWithGenesis creates a new blockchain db starting from the block of the genesis
+func CreateBlockchainWithGenesis(g *Genesis, db storage.Storage, libPolicy LIBPolicy, txPool *transactionpool.TransactionPool, scManager ltransaction.ScEngineManager, blkSizeLimit int) *Blockchain {
+	genesis := g.ToBlock()
 	bc := &Blockchain{
 		blockchain.NewBlockchain(genesis.GetHash(), genesis.GetHash()),
 		db,
@@ -82,18 +105,51 @@ func CreateBlockchain(address account.Address, db storage.Storage, libPolicy LIB
 		scState.NewEventManager(),
 		blkSizeLimit,
 		&sync.Mutex{},
+		nil,
+		0,
+		nil,
+		nil,
+		g.ChainID,
 	}
 	utxoIndex := lutxo.NewUTXOIndex(bc.GetUtxoCache())
 	utxoIndex.UpdateUtxos(genesis.GetTransactions())
-	scState := scState.NewScState()
-	err := bc.AddBlockContextToTail(&BlockContext{Block: genesis, UtxoIndex: utxoIndex, State: scState})
+	genesisState := g.ToState()
+	if err := migration.SetVersion(db, migration.CurrentVersion()); err != nil {
+		logger.Panic("CreateBlockchain: failed to save the schema version of the database!")
+	}
+	err := bc.AddBlockContextToTail(&BlockContext{Block: genesis, UtxoIndex: utxoIndex, State: genesisState})
 	if err != nil {
 		logger.Panic("CreateBlockchain: failed to add genesis block!")
 	}
 	return bc
 }
 
+//GetGenesisHash returns the hash of the genesis block of the blockchain in the database
+func GetGenesisHash(db storage.Storage) (hash.Hash, error) {
+	genesisHash, err := db.Get(util.UintToHex(0))
+	if err != nil {
+		return nil, ErrBlockDoesNotExist
+	}
+	return genesisHash, nil
+}
+
 func GetBlockchain(db storage.Storage, libPolicy LIBPolicy, txPool *transactionpool.TransactionPool, scManager ltransaction.ScEngineManager, blkSizeLimit int) (*Blockchain, error) {
+	if err := migration.CheckVersion(db); err != nil {
+		return nil, err
+	}
+	bc, err := loadBlockchain(db, libPolicy, txPool, scManager, blkSizeLimit)
+	if err != nil {
+		return nil, err
+	}
+	//a reindex that stopped while replacing the indexes leaves them incomplete until it is completed
+	if err := bc.finishReindex(); err != nil {
+		return nil, err
+	}
+	return bc, nil
+}
+
+//loadBlockchain returns the blockchain in the database regardless of the schema version of the database
+func loadBlockchain(db storage.Storage, libPolicy LIBPolicy, txPool *transactionpool.TransactionPool, scManager ltransaction.ScEngineManager, blkSizeLimit int) (*Blockchain, error) {
 	var tip []byte
 	tip, err := db.Get(tipKey)
 	if err != nil {
@@ -103,6 +159,10 @@ func GetBlockchain(db storage.Storage, libPolicy LIBPolicy, txPool *transactionp
 	if err != nil {
 		return nil, err
 	}
+	chainID, err := GetChainID(db)
+	if err != nil {
+		return nil, err
+	}
 
 	bc := &Blockchain{
 		blockchain.NewBlockchain(tip, lib),
@@ -114,10 +174,78 @@ func GetBlockchain(db storage.Storage, libPolicy LIBPolicy, txPool *transactionp
 		scState.NewEventManager(),
 		blkSizeLimit,
 		&sync.Mutex{},
+		nil,
+		0,
+		nil,
+		nil,
+		chainID,
 	}
 	return bc, nil
 }
 
+//RestoreBlockchain writes a blockchain whose tail and LIB are the input block into a database without blockchain. The
+//blocks between the genesis block and the input block are saved as pruned blocks with the input headers, which have to
+//link to the block in the order of height. The UTXO index and the state are saved as the ones after the block
+func RestoreBlockchain(db storage.Storage, genesis *block.Block, headers []*block.SignedHeader, blk *block.Block, utxoIndex *lutxo.UTXOIndex, state *scState.ScState) error {
+	if _, err := db.Get(tipKey); err == nil {
+		return ErrBlockchainExists
+	}
+	for i, header := range headers {
+		next := blk.GetPrevHash()
+		nextHeight := blk.GetHeight()
+		if i < len(headers)-1 {
+			next = headers[i+1].PrevHash
+			nextHeight = headers[i+1].Height
+		}
+		if !header.Hash().Equals(next) || header.Height+1 != nextHeight {
+			return ErrHeadersNotLinked
+		}
+	}
+
+	bc := &Blockchain{
+		bc:        blockchain.NewBlockchain(blk.GetHash(), blk.GetHash()),
+		db:        db,
+		utxoCache: utxo.NewUTXOCache(db),
+		mutex:     &sync.Mutex{},
+	}
+	if err := bc.AddBlockToDb(genesis); err != nil {
+		return err
+	}
+	for _, header := range headers {
+		rawBytes, err := proto.Marshal(header.ToProto())
+		if err != nil {
+			return err
+		}
+		if err := db.Put(getPrunedHeaderKey(header.Hash()), rawBytes); err != nil {
+			return err
+		}
+		if err := db.Put(util.UintToHex(header.Height), header.Hash()); err != nil {
+			return err
+		}
+	}
+	if err := utxoIndex.Save(); err != nil {
+		return err
+	}
+	if err := bc.AddBlockToDb(blk); err != nil {
+		return err
+	}
+	if err := state.SaveToDatabase(db); err != nil {
+		return err
+	}
+	if blk.GetHeight() > 0 {
+		if err := db.Put(prunedHeightKey, util.UintToHex(blk.GetHeight()-1)); err != nil {
+			return err
+		}
+	}
+	if err := migration.SetVersion(db, migration.CurrentVersion()); err != nil {
+		return err
+	}
+	if err := bc.SetLIBHash(blk.GetHash()); err != nil {
+		return err
+	}
+	return bc.setTailBlockHash(blk.GetHash())
+}
+
 func (bc *Blockchain) GetDb() storage.Storage {
 	return bc.db
 }
@@ -126,6 +254,17 @@ func (bc *Blockchain) GetUtxoCache() *utxo.UTXOCache {
 	return bc.utxoCache
 }
 
+//GetChainID returns the id of the network that new transactions are signed for, which is the chain id of the block
+//after the tail
+func (bc *Blockchain) GetChainID() uint64 {
+	return bc.GetChainIDAtHeight(bc.GetMaxHeight() + 1)
+}
+
+//GetChainIDAtHeight returns the id of the network that the transactions in the block at the input height are signed for
+func (bc *Blockchain) GetChainIDAtHeight(height uint64) uint64 {
+	return bc.forks.GetChainID(bc.chainID, height)
+}
+
 func (bc *Blockchain) GetTailBlockHash() hash.Hash {
 	return bc.bc.GetTailBlockHash()
 }
@@ -190,10 +329,15 @@ func (bc *Blockchain) GetLIBHeight() uint64 {
 	return block.GetHeight()
 }
 
+//GetBlockByHash returns the block with the input hash. The block has no transactions if its body has been pruned
 func (bc *Blockchain) GetBlockByHash(hash hash.Hash) (*block.Block, error) {
 	rawBytes, err := bc.db.Get(hash)
 	if err != nil {
-		return nil, ErrBlockDoesNotExist
+		header, err := bc.getPrunedHeader(hash)
+		if err != nil {
+			return nil, ErrBlockDoesNotExist
+		}
+		return block.NewHeaderOnlyBlock(header), nil
 	}
 	return block.Deserialize(rawBytes), nil
 }
@@ -219,7 +363,33 @@ func (bc *Blockchain) GetState() blockchain.BlockchainState {
 	return bc.bc.GetState()
 }
 
+//SetFinalityGadget sets the finality gadget that is notified of every block added to the tail. Blocks are only made
+//irreversible by the finality certificates of the gadget once it is set
+func (bc *Blockchain) SetFinalityGadget(finality BlockFinalizer) {
+	bc.finality = finality
+}
+
+//SetForks sets the heights from which the rules added after the launch of the network are enforced
+func (bc *Blockchain) SetForks(forks *block.Forks) {
+	bc.forks = forks
+}
+
+//GetForks returns the heights from which the rules added after the launch of the network are enforced
+func (bc *Blockchain) GetForks() *block.Forks {
+	return bc.forks
+}
+
 func (bc *Blockchain) AddBlockContextToTail(ctx *BlockContext) error {
+	if err := bc.addBlockContextToTail(ctx); err != nil {
+		return err
+	}
+	if bc.finality != nil {
+		bc.finality.OnBlockAdded(ctx.Block)
+	}
+	return nil
+}
+
+func (bc *Blockchain) addBlockContextToTail(ctx *BlockContext) error {
 	// Atomically set tail block hash and update UTXO index in db
 	bc.mutex.Lock()
 	defer bc.mutex.Unlock()
@@ -237,13 +407,14 @@ func (bc *Blockchain) AddBlockContextToTail(ctx *BlockContext) error {
 		"hash":   ctx.Block.GetHash().String(),
 	})
 
+	//all writes of the block are buffered in an overlay and committed together
 	bcTemp := bc.DeepCopy()
-
-	bcTemp.db.DisableBatch()
+	overlay := storage.NewOverlay(bc.db)
+	bcTemp.db = overlay
 
 	numTxBeforeExe := bc.GetTxPool().GetNumOfTxInPool()
 
-	err := ctx.UtxoIndex.Save()
+	utxoLayer, err := ctx.UtxoIndex.SaveToStorage(overlay)
 	if err != nil {
 		blockLogger.Warn("Blockchain: failed to save utxo to database.")
 		return err
@@ -272,16 +443,32 @@ func (bc *Blockchain) AddBlockContextToTail(ctx *BlockContext) error {
 
 	bcTemp.updateLIB(ctx.Block.GetHeight())
 
-	// Flush batch changes to storage
-	err = bcTemp.db.Flush()
+	//the state trie is saved before the contract storage, which is the storage of the parent until then
+	if err := bc.commitStateRoot(ctx, overlay); err != nil {
+		blockLogger.WithError(err).Error("Blockchain: failed to save the state trie!")
+		return err
+	}
+
+	err = ctx.State.Save(overlay, ctx.Block.GetHash())
 	if err != nil {
-		blockLogger.Error("Blockchain: failed to update tail block hash and UTXO index!")
+		blockLogger.WithError(err).Error("Blockchain: failed to save the contract state!")
 		return err
 	}
-	ctx.State.Save(bc.db, ctx.Block.GetHash())
+
+	err = overlay.Commit()
+	if err != nil {
+		blockLogger.WithError(err).Error("Blockchain: failed to commit the block to storage!")
+		return err
+	}
+	utxoLayer.Commit()
 	// Assign changes to receiver
+	bcTemp.db = bc.db
 	*bc = *bcTemp
 
+	if err := bc.prune(); err != nil {
+		blockLogger.WithError(err).Warn("Blockchain: failed to prune the blocks below the LIB.")
+	}
+
 	poolsize := 0
 	if bc.txPool != nil {
 		poolsize = bc.txPool.GetNumOfTxInPool()
@@ -295,6 +482,27 @@ func (bc *Blockchain) AddBlockContextToTail(ctx *BlockContext) error {
 	return nil
 }
 
+//commitStateRoot saves the state trie after the block into the overlay. A block that does not carry its state root,
+//which is below the state root fork, is added without its state trie if the trie cannot be built on its parent. The
+//missing tries are built by a migration
+func (bc *Blockchain) commitStateRoot(ctx *BlockContext, overlay *storage.Overlay) error {
+	var parentBlk *block.Block
+	if ctx.Block.GetHeight() > 0 {
+		var err error
+		if parentBlk, err = bc.GetBlockByHash(ctx.Block.GetPrevHash()); err != nil {
+			return err
+		}
+	}
+	_, err := lblock.CommitStateRoot(ctx.Block, parentBlk, scState.LoadScStateFromDatabase(bc.db), ctx.State, overlay)
+	if err != nil && len(ctx.Block.GetStateRoot()) == 0 {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height": ctx.Block.GetHeight(),
+		}).Warn("Blockchain: the state trie of the block is not saved.")
+		return nil
+	}
+	return err
+}
+
 func (bc *Blockchain) Iterator() *Blockchain {
 	return &Blockchain{
 		blockchain.NewBlockchain(bc.GetTailBlockHash(), bc.GetLIBHash()),
@@ -306,18 +514,20 @@ func (bc *Blockchain) Iterator() *Blockchain {
 		nil,
 		bc.blkSizeLimit,
 		bc.mutex,
+		nil,
+		bc.pruneDepth,
+		bc.forks,
+		bc.election,
+		bc.chainID,
 	}
 }
 
 func (bc *Blockchain) Next() (*block.Block, error) {
-	var blk *block.Block
-	encodedBlock, err := bc.db.Get(bc.GetTailBlockHash())
+	blk, err := bc.GetBlockByHash(bc.GetTailBlockHash())
 	if err != nil {
 		return nil, err
 	}
 
-	blk = block.Deserialize(encodedBlock)
-
 	bc.bc.SetTailBlockHash(blk.GetPrevHash())
 
 	return blk, nil
@@ -371,9 +581,119 @@ func (bc *Blockchain) AddBlockToDb(blk *block.Block) error {
 			return err
 		}
 	}
+	return indexTransactions(blk, bc.db, bc.db)
+}
+
+//indexTransactions indexes the transactions of the block by id and adds them to the history of their addresses. The
+//outputs spent by the block are read from the transaction journals in journalDb
+func indexTransactions(blk *block.Block, db storage.Storage, journalDb storage.Storage) error {
+	for i, tx := range blk.GetTransactions() {
+		txIndex := &transaction.TxIndex{BlockId: blk.GetHash(), BlockHeight: blk.GetHeight(), BlockIndex: i}
+		if err := transaction.PutTxIndex(tx.ID, txIndex, db); err != nil {
+			logger.WithError(err).Warn("Blockchain: failed to index blk transactions in database!")
+			return err
+		}
+	}
+	for pubKeyHash, entries := range getHistoryEntries(blk, journalDb) {
+		if err := history.AppendEntries(account.PubKeyHash(pubKeyHash), entries, db); err != nil {
+			logger.WithError(err).Warn("Blockchain: failed to add blk transactions to address history in database!")
+			return err
+		}
+	}
 	return nil
 }
 
+//getHistoryEntries returns the outputs received and spent in the block by address in the order of the transactions
+func getHistoryEntries(blk *block.Block, db storage.Storage) map[string][]*history.Entry {
+	entries := make(map[string][]*history.Entry)
+	for _, tx := range blk.GetTransactions() {
+		adaptedTx := transaction.NewTxAdapter(tx)
+		if adaptedTx.IsNormal() || adaptedTx.IsContract() || adaptedTx.IsContractSend() || adaptedTx.IsVote() {
+			for _, vin := range tx.Vin {
+				vout, err := transaction.GetTxOutput(vin, db)
+				if err != nil {
+					logger.WithError(err).WithFields(logger.Fields{
+						"txid": hex.EncodeToString(tx.ID),
+					}).Warn("Blockchain: cannot find the output spent by the transaction.")
+					continue
+				}
+				entries[string(vout.PubKeyHash)] = append(entries[string(vout.PubKeyHash)], &history.Entry{
+					Txid:        tx.ID,
+					BlockHeight: blk.GetHeight(),
+					Type:        history.EntrySpent,
+					OutputTxid:  vin.Txid,
+					OutputIndex: vin.Vout,
+					Value:       vout.Value,
+				})
+			}
+		}
+		for i, vout := range tx.Vout {
+			entries[string(vout.PubKeyHash)] = append(entries[string(vout.PubKeyHash)], &history.Entry{
+				Txid:        tx.ID,
+				BlockHeight: blk.GetHeight(),
+				Type:        history.EntryReceived,
+				OutputTxid:  tx.ID,
+				OutputIndex: i,
+				Value:       vout.Value,
+			})
+		}
+	}
+	return entries
+}
+
+//GetAddressHistory returns at most maxCount history entries of the address in the blocks from startHeight to endHeight,
+//beginning at the cursor if it is not 0. It also returns the cursor of the next entry in the range, which is 0 if
+//there is none. An endHeight of 0 stands for the tail of the blockchain
+func (bc *Blockchain) GetAddressHistory(pubKeyHash account.PubKeyHash, startHeight, endHeight, cursor uint64, maxCount int) ([]*history.Entry, uint64, error) {
+	seq, err := history.FindEntry(pubKeyHash, startHeight, bc.db)
+	if err != nil {
+		return nil, 0, err
+	}
+	if cursor > seq {
+		seq = cursor
+	}
+
+	entries := []*history.Entry{}
+	count := history.GetNumOfEntries(pubKeyHash, bc.db)
+	for ; seq < count; seq++ {
+		entry, err := history.GetEntry(pubKeyHash, seq, bc.db)
+		if err != nil {
+			return nil, 0, err
+		}
+		if endHeight != 0 && entry.BlockHeight > endHeight {
+			return entries, 0, nil
+		}
+		if len(entries) >= maxCount {
+			return entries, seq, nil
+		}
+		entries = append(entries, entry)
+	}
+	return entries, 0, nil
+}
+
+//GetTransactionByID returns the transaction with the input id on the main chain, the block that contains it and the
+//index of the transaction in the block
+func (bc *Blockchain) GetTransactionByID(txid []byte) (*transaction.Transaction, *block.Block, int, error) {
+	txIndex, err := transaction.GetTxIndex(txid, bc.db)
+	if err != nil {
+		return nil, nil, 0, ErrTransactionNotFound
+	}
+	if txIndex.BlockHeight > bc.GetMaxHeight() {
+		return nil, nil, 0, ErrTransactionNotFound
+	}
+	if bc.IsPruned(txIndex.BlockHeight) {
+		return nil, nil, 0, ErrBlockPruned
+	}
+	blk, err := bc.GetBlockByHeight(txIndex.BlockHeight)
+	if err != nil || !blk.GetHash().Equals(txIndex.BlockId) {
+		return nil, nil, 0, ErrTransactionNotFound
+	}
+	if txIndex.BlockIndex < 0 || txIndex.BlockIndex >= len(blk.GetTransactions()) {
+		return nil, nil, 0, ErrTransactionNotFound
+	}
+	return blk.GetTransactions()[txIndex.BlockIndex], blk, txIndex.BlockIndex, nil
+}
+
 func (bc *Blockchain) IsHigherThanBlockchain(block *block.Block) bool {
 	return block.GetHeight() > bc.GetMaxHeight()
 }
@@ -383,7 +703,9 @@ func (bc *Blockchain) IsInBlockchain(hash hash.Hash) bool {
 	return err == nil
 }
 
-//rollback the blockchain to a block with the targetHash
+//Rollback rolls the blockchain back to the block with the targetHash. The UTXO index and the contract storage are the
+//ones after the target block. All writes of the rollback are buffered in an overlay and committed together, and the
+//transactions of the removed blocks are returned to the transaction pool after the commit
 func (bc *Blockchain) Rollback(targetHash hash.Hash, utxo *lutxo.UTXOIndex, scState *scState.ScState) bool {
 	bc.mutex.Lock()
 	defer bc.mutex.Unlock()
@@ -396,44 +718,87 @@ func (bc *Blockchain) Rollback(targetHash hash.Hash, utxo *lutxo.UTXOIndex, scSt
 	if lblock.IsHashEqual(parentblockHash, targetHash) {
 		return true
 	}
+	targetBlk, err := bc.GetBlockByHash(targetHash)
+	if err != nil {
+		return false
+	}
+
+	bcTemp := bc.DeepCopy()
+	overlay := storage.NewOverlay(bc.db)
+	bcTemp.db = overlay
 
 	//keep rolling back blocks until the block with the input hash
+	var rolledBackTxs []*transaction.Transaction
 	for bytes.Compare(parentblockHash, targetHash) != 0 {
 
 		block, err := bc.GetBlockByHash(parentblockHash)
+		if err != nil {
+			return false
+		}
 		logger.WithFields(logger.Fields{
 			"height": block.GetHeight(),
 			"hash":   parentblockHash.String(),
 		}).Info("Blockchain: is about to rollback the block...")
-		if err != nil {
-			return false
-		}
 		parentblockHash = block.GetPrevHash()
 
 		for _, tx := range block.GetTransactions() {
 			adaptedTx := transaction.NewTxAdapter(tx)
 			if !adaptedTx.IsCoinbase() && !adaptedTx.IsRewardTx() && !adaptedTx.IsGasRewardTx() && !adaptedTx.IsGasChangeTx() {
-				bc.txPool.Rollback(*tx)
+				rolledBackTxs = append(rolledBackTxs, tx)
+			}
+			if err := bcTemp.removeTxIndex(tx.ID, block.GetHash()); err != nil {
+				logger.WithError(err).Error("Blockchain: failed to remove the transaction index during rollback!")
+				return false
+			}
+		}
+		for pubKeyHash := range getHistoryEntries(block, overlay) {
+			err = history.TruncateEntries(account.PubKeyHash(pubKeyHash), targetBlk.GetHeight(), overlay)
+			if err != nil {
+				logger.WithError(err).Error("Blockchain: failed to remove the address history during rollback!")
+				return false
 			}
 		}
 	}
 
-	bc.db.EnableBatch()
-	defer bc.db.DisableBatch()
-
-	err := bc.setTailBlockHash(parentblockHash)
+	err = bcTemp.setTailBlockHash(parentblockHash)
 	if err != nil {
 		logger.Error("Blockchain: failed to set tail block hash during rollback!")
 		return false
 	}
 
-	utxo.Save()
-	scState.SaveToDatabase(bc.db)
-	bc.db.Flush()
+	utxoLayer, err := utxo.SaveToStorage(overlay)
+	if err != nil {
+		logger.WithError(err).Error("Blockchain: failed to save the UTXO index during rollback!")
+		return false
+	}
+	if err := scState.SaveToDatabase(overlay); err != nil {
+		logger.WithError(err).Error("Blockchain: failed to save the contract state during rollback!")
+		return false
+	}
+
+	if err := overlay.Commit(); err != nil {
+		logger.WithError(err).Error("Blockchain: failed to commit the rollback to storage!")
+		return false
+	}
+	utxoLayer.Commit()
+	bcTemp.db = bc.db
+	*bc = *bcTemp
 
+	for _, tx := range rolledBackTxs {
+		bc.txPool.Rollback(*tx)
+	}
 	return true
 }
 
+//removeTxIndex removes the index of the transaction if it points to the block with the input hash
+func (bc *Blockchain) removeTxIndex(txid []byte, blkHash hash.Hash) error {
+	txIndex, err := transaction.GetTxIndex(txid, bc.db)
+	if err != nil || !blkHash.Equals(txIndex.BlockId) {
+		return nil
+	}
+	return transaction.DeleteTxIndex(txid, bc.db)
+}
+
 func (bc *Blockchain) setTailBlockHash(hash hash.Hash) error {
 	err := bc.db.Put(tipKey, hash)
 	if err != nil {
@@ -532,8 +897,10 @@ func (bc *Blockchain) isAliveProducerSufficient(blk *block.Block) bool {
 	return true
 }
 
+//updateLIB moves the LIB to the block confirmed by enough blocks after it. With a finality gadget, only the finality
+//certificates move the LIB, so that every block below the LIB is finalized by a certificate
 func (bc *Blockchain) updateLIB(currBlkHeight uint64) {
-	if bc.libPolicy == nil {
+	if bc.libPolicy == nil || bc.finality != nil {
 		return
 	}
 
@@ -542,7 +909,6 @@ func (bc *Blockchain) updateLIB(currBlkHeight uint64) {
 	if currBlkHeight > uint64(minConfirmationNum) {
 		LIBHeight = currBlkHeight - uint64(minConfirmationNum)
 	}
-
 	LIBBlk, err := bc.GetBlockByHeight(LIBHeight)
 	if err != nil {
 		logger.WithError(err).Warn("Blockchain: Can not find LIB block in database")
@@ -551,3 +917,46 @@ func (bc *Blockchain) updateLIB(currBlkHeight uint64) {
 
 	bc.SetLIBHash(LIBBlk.GetHash())
 }
+
+//Finalize saves the finality certificate of a block on the main chain and makes the block irreversible. The signatures
+//of the certificate are verified by the finality gadget
+func (bc *Blockchain) Finalize(cert *block.FinalityCertificate) error {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	if cert.Height > bc.GetMaxHeight() {
+		return ErrBlockNotOnMainChain
+	}
+	blk, err := bc.GetBlockByHeight(cert.Height)
+	if err != nil {
+		return err
+	}
+	if !blk.GetHash().Equals(cert.BlockHash) {
+		return ErrBlockNotOnMainChain
+	}
+
+	rawBytes, err := cert.Serialize()
+	if err != nil {
+		return err
+	}
+	if err := bc.db.Put(getFinalityCertificateKey(cert.BlockHash), rawBytes); err != nil {
+		return err
+	}
+	if cert.Height <= bc.GetLIBHeight() {
+		return nil
+	}
+	return bc.SetLIBHash(cert.BlockHash)
+}
+
+//GetFinalityCertificate returns the finality certificate of the block with the input hash
+func (bc *Blockchain) GetFinalityCertificate(hash hash.Hash) (*block.FinalityCertificate, error) {
+	rawBytes, err := bc.db.Get(getFinalityCertificateKey(hash))
+	if err != nil {
+		return nil, ErrCertificateNotFound
+	}
+	return block.DeserializeFinalityCertificate(rawBytes)
+}
+
+func getFinalityCertificateKey(hash hash.Hash) []byte {
+	return append(append([]byte{}, finalityCertificatePrefix...), hash...)
+}
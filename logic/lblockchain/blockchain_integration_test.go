@@ -49,7 +49,7 @@ func TestBlockchain_RollbackToABlockWithTransactions(t *testing.T) {
 		tailBlk, _ := bc.GetTailBlock()
 		cbtx := ltransaction.NewCoinbaseTX(coinbaseAddr, "", bc.GetMaxHeight(), common.NewAmount(0))
 		b := block.NewBlock([]*transaction.Transaction{&cbtx}, tailBlk, coinbaseAddr.String())
-		b.SetHash(lblock.CalculateHash(b))
+		b.SetHash(lblock.CalculateHash(b, nil))
 		bc.AddBlockContextToTail(PrepareBlockContext(bc, b))
 	}
 
@@ -77,13 +77,13 @@ func TestBlockchain_RollbackToABlockWithTransactions(t *testing.T) {
 	tailBlk, _ := bc.GetTailBlock()
 	cbtx := ltransaction.NewCoinbaseTX(coinbaseAddr, "", bc.GetMaxHeight(), common.NewAmount(0))
 	b := block.NewBlock([]*transaction.Transaction{&cbtx, &txs[0]}, tailBlk, "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
-	b.SetHash(lblock.CalculateHash(b))
+	b.SetHash(lblock.CalculateHash(b, nil))
 	bc.AddBlockContextToTail(PrepareBlockContext(bc, b))
 
 	//add block 5 with tx1
 	tailBlk, _ = bc.GetTailBlock()
 	b = block.NewBlock([]*transaction.Transaction{&cbtx, &txs[1]}, tailBlk, "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
-	b.SetHash(lblock.CalculateHash(b))
+	b.SetHash(lblock.CalculateHash(b, nil))
 	bc.AddBlockContextToTail(PrepareBlockContext(bc, b))
 
 	//find the hash at height 3
@@ -131,6 +131,7 @@ func fakeDependentTxs(utxoIndex *lutxo.UTXOIndex, fundAccount *account.Account,
 		common.NewAmount(0),
 		common.NewAmount(0),
 		"",
+		0,
 	}
 
 	newTx, err := createTransaction(utxoIndex, params)
@@ -148,6 +149,7 @@ func fakeDependentTxs(utxoIndex *lutxo.UTXOIndex, fundAccount *account.Account,
 			common.NewAmount(0),
 			common.NewAmount(0),
 			"",
+			0,
 		}
 		if i%2 == 1 {
 			params.SenderKeyPair = keyPair2
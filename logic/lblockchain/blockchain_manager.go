@@ -19,6 +19,7 @@ package lblockchain
 
 import (
 	"bytes"
+	"time"
 
 	"github.com/dappley/go-dappley/common/log"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/dappley/go-dappley/core/blockchain"
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
 
 	"github.com/dappley/go-dappley/common"
@@ -45,12 +47,21 @@ const (
 	HeightDiffThreshold = 10
 	SendBlock           = "SendBlockByHash"
 	RequestBlock        = "requestBlock"
+	BroadcastEvidence   = "BroadcastEvidence"
+	BroadcastPreCommit  = "BroadcastPreCommit"
+)
+
+const (
+	DefaultMaxClockDrift = 2  // seconds a block may be stamped ahead of the local clock
+	MaxFutureBlockTime   = 60 // seconds ahead of the local clock a received block is kept at most
 )
 
 var (
 	bmSubscribedTopics = []string{
 		SendBlock,
 		RequestBlock,
+		BroadcastEvidence,
+		BroadcastPreCommit,
 	}
 )
 
@@ -64,6 +75,7 @@ type BlockchainManager struct {
 	consensus         Consensus
 	downloadRequestCh chan chan bool
 	netService        NetService
+	maxClockDrift     int64
 }
 
 func NewBlockchainManager(blockchain *Blockchain, blockpool *blockchain.BlockPool, service NetService, consensus Consensus) *BlockchainManager {
@@ -73,10 +85,17 @@ func NewBlockchainManager(blockchain *Blockchain, blockpool *blockchain.BlockPoo
 		netService:        service,
 		consensus:         consensus,
 		downloadRequestCh: make(chan chan bool, 100),
+		maxClockDrift:     DefaultMaxClockDrift,
 	}
 	bm.ListenToNetService()
 	return bm
 }
+
+//SetMaxClockDrift sets the seconds a block may be stamped ahead of the local clock
+func (bm *BlockchainManager) SetMaxClockDrift(maxClockDrift int64) {
+	bm.maxClockDrift = maxClockDrift
+}
+
 func (bm *BlockchainManager) GetDownloadRequestCh() chan chan bool {
 	return bm.downloadRequestCh
 }
@@ -125,6 +144,10 @@ func (bm *BlockchainManager) GetTopicHandler(topic string) pubsub.TopicHandler {
 		return bm.SendBlockHandler
 	case RequestBlock:
 		return bm.RequestBlockHandler
+	case BroadcastEvidence:
+		return bm.BroadcastEvidenceHandler
+	case BroadcastPreCommit:
+		return bm.BroadcastPreCommitHandler
 	}
 	return nil
 }
@@ -138,7 +161,7 @@ func (bm *BlockchainManager) GetblockPool() *blockchain.BlockPool {
 }
 
 func (bm *BlockchainManager) VerifyBlock(blk *block.Block) bool {
-	if !lblock.VerifyHash(blk) {
+	if !lblock.VerifyHash(blk, bm.blockchain.GetForks()) {
 		logger.Warn("BlockchainManager: Block hash verification failed!")
 		return false
 	}
@@ -166,6 +189,11 @@ func (bm *BlockchainManager) Push(blk *block.Block, pid networkmodel.PeerInfo) {
 		return
 	}
 
+	if lblock.IsFutureBlock(blk, time.Now().Unix(), bm.maxClockDrift) {
+		bm.keepFutureBlock(blk, pid)
+		return
+	}
+
 	receiveBlockHeight := blk.GetHeight()
 	ownBlockHeight := bm.Getblockchain().GetMaxHeight()
 	// Do the subtraction calculation after judging the size to avoid the overflow of the symbol uint64
@@ -222,6 +250,39 @@ func (bm *BlockchainManager) Push(blk *block.Block, pid networkmodel.PeerInfo) {
 	return
 }
 
+//keepFutureBlock keeps the block in the block pool and pushes it again once its time slot arrives. Blocks stamped
+//too far ahead of the local clock are dropped
+func (bm *BlockchainManager) keepFutureBlock(blk *block.Block, pid networkmodel.PeerInfo) {
+	delay := time.Until(time.Unix(blk.GetTimestamp()-bm.maxClockDrift, 0))
+	if delay > MaxFutureBlockTime*time.Second {
+		logger.WithFields(logger.Fields{
+			"hash":      blk.GetHash().String(),
+			"height":    blk.GetHeight(),
+			"timestamp": blk.GetTimestamp(),
+		}).Warn("BlockchainManager: discarded a block stamped too far in the future.")
+		return
+	}
+
+	if !bm.blockPool.AddFutureBlock(blk) {
+		return
+	}
+	logger.WithFields(logger.Fields{
+		"hash":      blk.GetHash().String(),
+		"height":    blk.GetHeight(),
+		"timestamp": blk.GetTimestamp(),
+	}).Info("BlockchainManager: keeps a future block until its time slot arrives.")
+
+	time.AfterFunc(delay, func() {
+		defer log.CrashHandler()
+		if bm.blockPool.RemoveFutureBlock(blk.GetHash()) {
+			bm.Push(blk, pid)
+		}
+	})
+}
+
+//MergeFork replaces the blocks above the fork parent by the fork blocks, which are ordered from the fork head down to
+//the child of the fork parent. The producers of the fork are checked against the dynasties elected on the fork before
+//the chain is rolled back, and the blocks that were on chain are restored if a fork block fails a later check
 func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash hash.Hash) error {
 
 	//find parent block
@@ -233,6 +294,13 @@ func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash h
 		return nil
 	}
 
+	if verifier, ok := bm.consensus.(ForkVerifier); ok {
+		if err := verifier.VerifyFork(forkBlks); err != nil {
+			logger.WithError(err).Warn("BlockchainManager: the producers of the fork are invalid.")
+			return ErrProducerVerifyFailed
+		}
+	}
+
 	//verify transactions in the fork
 	utxo, scState, err := RevertUtxoAndScStateAtBlockHash(bm.blockchain.GetDb(), bm.blockchain, forkParentHash)
 	if err != nil {
@@ -247,36 +315,39 @@ func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash h
 			"error": err,
 			"hash":  forkParentHash.String(),
 		}).Error("BlockchainManager: get fork parent block failed.")
+		return err
+	}
+	tailBlks, err := bm.getBlocksAbove(parentBlk)
+	if err != nil {
+		return err
 	}
 
-	firstCheck := true
+	if !bm.Getblockchain().CheckLibPolicy(forkHeadBlock) {
+		return ErrProducerNotEnough
+	}
+	if !bm.blockchain.Rollback(forkParentHash, utxo, rollScState) {
+		return ErrRollbackFailed
+	}
 
 	for i := len(forkBlks) - 1; i >= 0; i-- {
-		if !bm.Getblockchain().CheckLibPolicy(forkBlks[i]) {
-			return ErrProducerNotEnough
-		}
-
-		if firstCheck {
-			firstCheck = false
-			bm.blockchain.Rollback(forkParentHash, utxo, rollScState)
-		}
-
 		logger.WithFields(logger.Fields{
 			"height": forkBlks[i].GetHeight(),
 			"hash":   forkBlks[i].GetHash().String(),
 		}).Info("BlockchainManager: is verifying a block in the fork.")
 
-		if !lblock.VerifyTransactions(forkBlks[i], utxo, scState, parentBlk) {
-			return ErrTransactionVerifyFailed
+		if err := bm.verifyForkBlock(forkBlks[i], parentBlk, utxo, scState); err != nil {
+			bm.restoreTail(forkParentHash, tailBlks)
+			return err
 		}
 
 		ctx := BlockContext{Block: forkBlks[i], UtxoIndex: utxo, State: scState}
-		err = bm.blockchain.AddBlockContextToTail(&ctx)
-		if err != nil {
+		if err := bm.blockchain.AddBlockContextToTail(&ctx); err != nil {
 			logger.WithFields(logger.Fields{
 				"error":  err,
 				"height": forkBlks[i].GetHeight(),
 			}).Error("BlockchainManager: add fork to tail failed.")
+			bm.restoreTail(forkParentHash, tailBlks)
+			return err
 		}
 		parentBlk = forkBlks[i]
 	}
@@ -284,6 +355,100 @@ func (bm *BlockchainManager) MergeFork(forkBlks []*block.Block, forkParentHash h
 	return nil
 }
 
+//verifyForkBlock checks the block of a fork whose ancestors are on chain, and applies its transactions to the UTXO
+//index and the contract state
+func (bm *BlockchainManager) verifyForkBlock(blk *block.Block, parentBlk *block.Block, utxo *lutxo.UTXOIndex, scState *scState.ScState) error {
+	if !bm.Getblockchain().CheckLibPolicy(blk) {
+		return ErrProducerNotEnough
+	}
+
+	//the ancestors of the block are on chain now, so that the block is checked against the dynasty at its height
+	if !bm.consensus.Validate(blk) {
+		return ErrProducerVerifyFailed
+	}
+
+	if !bm.verifyTimestamp(blk, parentBlk) {
+		return ErrBlockTimestampInvalid
+	}
+
+	if !lblock.VerifyTransactions(blk, utxo, scState, parentBlk, bm.blockchain.GetDb(), bm.blockchain.GetForks(), bm.blockchain.GetChainIDAtHeight(blk.GetHeight())) {
+		return ErrTransactionVerifyFailed
+	}
+	return nil
+}
+
+//getBlocksAbove returns the blocks on chain above the input block in ascending order
+func (bm *BlockchainManager) getBlocksAbove(blk *block.Block) ([]*block.Block, error) {
+	var blks []*block.Block
+	for height := blk.GetHeight() + 1; height <= bm.blockchain.GetMaxHeight(); height++ {
+		b, err := bm.blockchain.GetBlockByHeight(height)
+		if err != nil {
+			return nil, err
+		}
+		blks = append(blks, b)
+	}
+	return blks, nil
+}
+
+//restoreTail rolls the chain back to the fork parent and puts the blocks that were on chain above it back, after the
+//fork that replaced them failed to be merged
+func (bm *BlockchainManager) restoreTail(forkParentHash hash.Hash, tailBlks []*block.Block) {
+	bc := bm.blockchain
+	utxo, state, err := RevertUtxoAndScStateAtBlockHash(bc.GetDb(), bc, forkParentHash)
+	if err != nil {
+		logger.WithError(err).Error("BlockchainManager: failed to restore the blocks replaced by the fork.")
+		return
+	}
+	if !bc.Rollback(forkParentHash, utxo, state.DeepCopy()) {
+		logger.WithError(ErrRollbackFailed).Error("BlockchainManager: failed to restore the blocks replaced by the fork.")
+		return
+	}
+
+	parentBlk, err := bc.GetBlockByHash(forkParentHash)
+	if err != nil {
+		logger.WithError(err).Error("BlockchainManager: failed to restore the blocks replaced by the fork.")
+		return
+	}
+	for _, blk := range tailBlks {
+		if !lblock.VerifyTransactions(blk, utxo, state, parentBlk, bc.GetDb(), bc.GetForks(), bc.GetChainIDAtHeight(blk.GetHeight())) {
+			logger.WithError(ErrTransactionVerifyFailed).WithFields(logger.Fields{
+				"height": blk.GetHeight(),
+			}).Error("BlockchainManager: failed to restore the blocks replaced by the fork.")
+			return
+		}
+		ctx := BlockContext{Block: blk, UtxoIndex: utxo, State: state}
+		if err := bc.AddBlockContextToTail(&ctx); err != nil {
+			logger.WithError(err).WithFields(logger.Fields{
+				"height": blk.GetHeight(),
+			}).Error("BlockchainManager: failed to restore the blocks replaced by the fork.")
+			return
+		}
+		parentBlk = blk
+	}
+	logger.WithFields(logger.Fields{
+		"height": bc.GetMaxHeight(),
+	}).Warn("BlockchainManager: restored the blocks replaced by the fork.")
+}
+
+//verifyTimestamp checks the timestamp of the block against its parent and the local clock, and against the timestamp
+//rules of the consensus if it has any
+func (bm *BlockchainManager) verifyTimestamp(blk *block.Block, parentBlk *block.Block) bool {
+	if bm.blockchain.GetForks().IsSlotTimestampActive(blk.GetHeight()) && !lblock.VerifyTimestamp(blk, parentBlk) {
+		return false
+	}
+	if lblock.IsFutureBlock(blk, time.Now().Unix(), bm.maxClockDrift) {
+		logger.WithFields(logger.Fields{
+			"height":    blk.GetHeight(),
+			"timestamp": blk.GetTimestamp(),
+		}).Warn("BlockchainManager: the block is stamped ahead of the local clock.")
+		return false
+	}
+	if verifier, ok := bm.consensus.(TimestampVerifier); ok {
+		return verifier.VerifyTimestamp(blk, parentBlk)
+	}
+	return true
+}
+
 //RequestBlock sends a requestBlock command to its peer with pid through network module
 func (bm *BlockchainManager) RequestBlock(hash hash.Hash, pid networkmodel.PeerInfo) {
 	request := &lblockchainpb.RequestBlock{Hash: hash}
@@ -310,6 +475,10 @@ func (bm *BlockchainManager) RequestBlockHandler(input interface{}) {
 		logger.WithError(err).Warn("BlockchainManager: failed to get the requested block.")
 		return
 	}
+	if bm.Getblockchain().IsPruned(block.GetHeight()) {
+		logger.WithError(ErrBlockPruned).Warn("BlockchainManager: failed to get the requested block.")
+		return
+	}
 
 	bm.SendBlockToPeer(block, command.GetSource())
 }
@@ -349,6 +518,106 @@ func (bm *BlockchainManager) SendBlockHandler(input interface{}) {
 	}
 }
 
+//BroadcastEvidence adds the evidence of a double-minting producer to the transaction pool and broadcasts it to all peers
+func (bm *BlockchainManager) BroadcastEvidence(evidence *block.Evidence) {
+	if !bm.addEvidenceTx(evidence) || bm.netService == nil {
+		return
+	}
+	bm.netService.BroadcastNormalPriorityCommand(BroadcastEvidence, evidence.ToProto())
+}
+
+//BroadcastEvidenceHandler handles when blockchain manager receives the evidence of a double-minting producer from its peers
+func (bm *BlockchainManager) BroadcastEvidenceHandler(input interface{}) {
+
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	evidencePb := &blockpb.Evidence{}
+	if err := proto.Unmarshal(command.GetData(), evidencePb); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: parse data failed.")
+		return
+	}
+
+	evidence := &block.Evidence{}
+	evidence.FromProto(evidencePb)
+	if !bm.addEvidenceTx(evidence) {
+		return
+	}
+
+	if command.IsBroadcast() {
+		//relay the original command
+		bm.netService.Relay(command.GetCommand(), networkmodel.PeerInfo{}, networkmodel.NormalPriorityCommand)
+	}
+}
+
+//addEvidenceTx verifies the evidence and adds the evidence transaction to the transaction pool, so that the evidence is
+//included in the next block. It returns false if the evidence is invalid or already in the transaction pool
+func (bm *BlockchainManager) addEvidenceTx(evidence *block.Evidence) bool {
+	verifier, ok := bm.consensus.(EvidenceVerifier)
+	if !ok {
+		logger.Warn("BlockchainManager: consensus does not support evidence.")
+		return false
+	}
+	if err := verifier.VerifyEvidence(evidence); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: received an invalid evidence.")
+		return false
+	}
+
+	tx, err := ltransaction.NewEvidenceTx(evidence)
+	if err != nil {
+		logger.WithError(err).Warn("BlockchainManager: failed to create the evidence transaction.")
+		return false
+	}
+	txPool := bm.blockchain.GetTxPool()
+	if txPool.GetTransactionById(tx.ID) != nil {
+		return false
+	}
+	txPool.Push(tx)
+	logger.WithFields(logger.Fields{
+		"height":    evidence.First.Height,
+		"timestamp": evidence.First.Timestamp,
+	}).Warn("BlockchainManager: added the evidence of a double-minting producer.")
+	return true
+}
+
+//BroadcastPreCommit broadcasts the precommit signed by the local producer to all peers
+func (bm *BlockchainManager) BroadcastPreCommit(preCommit *block.PreCommit) {
+	if bm.netService == nil {
+		return
+	}
+	bm.netService.BroadcastHighProrityCommand(BroadcastPreCommit, preCommit.ToProto())
+}
+
+//BroadcastPreCommitHandler handles when blockchain manager receives a precommit from its peers
+func (bm *BlockchainManager) BroadcastPreCommitHandler(input interface{}) {
+
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	preCommitPb := &blockpb.PreCommit{}
+	if err := proto.Unmarshal(command.GetData(), preCommitPb); err != nil {
+		logger.WithError(err).Warn("BlockchainManager: parse data failed.")
+		return
+	}
+
+	finality, ok := bm.consensus.(FinalityGadget)
+	if !ok {
+		return
+	}
+	preCommit := &block.PreCommit{}
+	preCommit.FromProto(preCommitPb)
+	isNew, err := finality.AddPreCommit(preCommit)
+	if err != nil {
+		logger.WithError(err).Warn("BlockchainManager: received an invalid precommit.")
+		return
+	}
+
+	if isNew && command.IsBroadcast() {
+		//relay the original command
+		bm.netService.Relay(command.GetCommand(), networkmodel.PeerInfo{}, networkmodel.HighPriorityCommand)
+	}
+}
+
 // RevertUtxoAndScStateAtBlockHash returns the previous snapshot of UTXOIndex when the block of given hash was the tail block.
 func RevertUtxoAndScStateAtBlockHash(db storage.Storage, bc *Blockchain, hash hash.Hash) (*lutxo.UTXOIndex, *scState.ScState, error) {
 	index := lutxo.NewUTXOIndex(bc.GetUtxoCache())
@@ -4,6 +4,7 @@ import (
 	"github.com/dappley/go-dappley/core/blockchain"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"testing"
+	"time"
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/common/hash"
@@ -14,14 +15,17 @@ import (
 	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/core/utxo"
 	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain/mocks"
 	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/logic/transactionpool"
 	logger "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/network/networkmodel"
 	"github.com/dappley/go-dappley/storage"
 )
 
@@ -33,9 +37,9 @@ func TestBlockChainManager_NumForks(t *testing.T) {
 
 	b1 := block.NewBlockWithRawInfo(nil, blk.GetHash(), 1, 0, 1, nil)
 	b3 := block.NewBlockWithRawInfo(nil, b1.GetHash(), 3, 0, 2, nil)
-	b3.SetHash(lblock.CalculateHash(b3))
+	b3.SetHash(lblock.CalculateHash(b3, nil))
 	b6 := block.NewBlockWithRawInfo(nil, b3.GetHash(), 6, 0, 3, nil)
-	b6.SetHash(lblock.CalculateHash(b6))
+	b6.SetHash(lblock.CalculateHash(b6, nil))
 
 	err = bc.AddBlockContextToTail(&BlockContext{Block: b1, UtxoIndex: lutxo.NewUTXOIndex(nil), State: scState.NewScState()})
 	require.Nil(t, err)
@@ -46,16 +50,16 @@ func TestBlockChainManager_NumForks(t *testing.T) {
 
 	// create first fork of height 3
 	b2 := block.NewBlockWithRawInfo(nil, b1.GetHash(), 2, 0, 2, nil)
-	b2.SetHash(lblock.CalculateHash(b2))
+	b2.SetHash(lblock.CalculateHash(b2, nil))
 
 	b4 := block.NewBlockWithRawInfo(nil, b2.GetHash(), 4, 0, 3, nil)
-	b4.SetHash(lblock.CalculateHash(b4))
+	b4.SetHash(lblock.CalculateHash(b4, nil))
 
 	b5 := block.NewBlockWithRawInfo(nil, b2.GetHash(), 5, 0, 3, nil)
-	b5.SetHash(lblock.CalculateHash(b5))
+	b5.SetHash(lblock.CalculateHash(b5, nil))
 
 	b7 := block.NewBlockWithRawInfo(nil, b4.GetHash(), 7, 0, 4, nil)
-	b7.SetHash(lblock.CalculateHash(b7))
+	b7.SetHash(lblock.CalculateHash(b7, nil))
 
 	/*
 		              b1
@@ -79,7 +83,7 @@ func TestBlockChainManager_NumForks(t *testing.T) {
 
 	// adding block that is not connected to BlockChain should be ignored
 	b8 := block.NewBlockWithRawInfo(nil, []byte{9}, 8, 0, 4, nil)
-	b8.SetHash(lblock.CalculateHash(b8))
+	b8.SetHash(lblock.CalculateHash(b8, nil))
 	bp.AddBlock(b8)
 	require.Equal(t, 2, testGetNumForkHeads(bp))
 
@@ -89,7 +93,7 @@ func TestBlockChainManager_NumForks(t *testing.T) {
 
 	// create a new fork off b6
 	b9 := block.NewBlockWithRawInfo(nil, b6.GetHash(), 9, 0, 4, nil)
-	b9.SetHash(lblock.CalculateHash(b9))
+	b9.SetHash(lblock.CalculateHash(b9, nil))
 
 	bp.AddBlock(b9)
 	require.Equal(t, 3, testGetNumForkHeads(bp))
@@ -102,6 +106,115 @@ func TestBlockChainManager_NumForks(t *testing.T) {
 	require.EqualValues(t, 3, longestFork)
 }
 
+func TestBlockchainManager_PushFutureBlock(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(1)
+	bc.SetState(blockchain.BlockchainReady)
+	consensus := &mocks.Consensus{}
+	consensus.On("Validate", mock.Anything).Return(true)
+	bp := blockchain.NewBlockPool(nil)
+	bcm := NewBlockchainManager(bc, bp, nil, consensus)
+	bcm.SetMaxClockDrift(0)
+
+	newBlock := func(timestamp int64) *block.Block {
+		tailBlk, err := bc.GetTailBlock()
+		require.Nil(t, err)
+		addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+		cbtx := ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
+		blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, tailBlk, timestamp, addr.String())
+		setTestStateRoot(t, blk, tailBlk, bc.GetDb())
+		blk.SetHash(lblock.CalculateHash(blk, nil))
+		return blk
+	}
+
+	//a block stamped too far ahead of the local clock is discarded
+	bcm.Push(newBlock(time.Now().Unix()+MaxFutureBlockTime+10), networkmodel.PeerInfo{})
+	assert.Equal(t, 0, bp.GetNumOfFutureBlocks())
+
+	//a block stamped ahead of the local clock is kept until its time slot arrives
+	bcm.Push(newBlock(time.Now().Unix()+1), networkmodel.PeerInfo{})
+	assert.Equal(t, 1, bp.GetNumOfFutureBlocks())
+	assert.EqualValues(t, 1, bc.GetMaxHeight())
+
+	assert.Eventually(t, func() bool { return bc.GetMaxHeight() == 2 }, 3*time.Second, 100*time.Millisecond)
+	assert.Equal(t, 0, bp.GetNumOfFutureBlocks())
+}
+
+func TestBlockchainManager_MergeForkTimestamp(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(1)
+	consensus := &mocks.Consensus{}
+	consensus.On("Validate", mock.Anything).Return(true)
+	bcm := NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, consensus)
+
+	tailBlk, err := bc.GetTailBlock()
+	require.Nil(t, err)
+	addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+	cbtx := ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
+
+	//a block stamped earlier than its parent is rejected
+	blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, tailBlk, tailBlk.GetTimestamp()-1, addr.String())
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.Equal(t, ErrBlockTimestampInvalid, bcm.MergeFork([]*block.Block{blk}, tailBlk.GetHash()))
+
+	//a block stamped ahead of the local clock is rejected
+	blk = block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, tailBlk, time.Now().Unix()+DefaultMaxClockDrift+10, addr.String())
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.Equal(t, ErrBlockTimestampInvalid, bcm.MergeFork([]*block.Block{blk}, tailBlk.GetHash()))
+
+	blk = block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, tailBlk, tailBlk.GetTimestamp(), addr.String())
+	setTestStateRoot(t, blk, tailBlk, bc.GetDb())
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.Nil(t, bcm.MergeFork([]*block.Block{blk}, tailBlk.GetHash()))
+	assert.EqualValues(t, 2, bc.GetMaxHeight())
+
+	//a block below the fork height may be stamped earlier than its parent
+	bc.SetForks(&block.Forks{SlotTimestampHeight: 4})
+	tailBlk, err = bc.GetTailBlock()
+	require.Nil(t, err)
+	cbtx = ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
+	blk = block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, tailBlk, tailBlk.GetTimestamp()-1, addr.String())
+	setTestStateRoot(t, blk, tailBlk, bc.GetDb())
+	blk.SetHash(lblock.CalculateHash(blk, nil))
+	assert.Nil(t, bcm.MergeFork([]*block.Block{blk}, tailBlk.GetHash()))
+	assert.EqualValues(t, 3, bc.GetMaxHeight())
+}
+
+func TestBlockchainManager_MergeForkRestoresTail(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(3)
+	tailHash := bc.GetTailBlockHash()
+	parentBlk, err := bc.GetBlockByHeight(1)
+	require.Nil(t, err)
+
+	addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+	newForkBlock := func(parent *block.Block) *block.Block {
+		cbtx := ltransaction.NewCoinbaseTX(addr, "fork", parent.GetHeight()+1, common.NewAmount(0))
+		return block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, parent, parent.GetTimestamp()+1, addr.String())
+	}
+	forkBlk1 := newForkBlock(parentBlk)
+	setTestStateRoot(t, forkBlk1, parentBlk, bc.GetDb())
+	forkBlk1.SetHash(lblock.CalculateHash(forkBlk1, nil))
+	//the state root of the second block is not checked, as it fails the check of its producer first
+	forkBlk2 := newForkBlock(forkBlk1)
+	forkBlk2.SetHash(lblock.CalculateHash(forkBlk2, nil))
+
+	//the second block of the fork fails the check after the first one replaced the tail
+	consensus := &mocks.Consensus{}
+	consensus.On("Validate", forkBlk2).Return(false)
+	consensus.On("Validate", mock.Anything).Return(true)
+	bcm := NewBlockchainManager(bc, blockchain.NewBlockPool(nil), nil, consensus)
+
+	assert.Equal(t, ErrProducerVerifyFailed, bcm.MergeFork([]*block.Block{forkBlk2, forkBlk1}, parentBlk.GetHash()))
+	assert.Equal(t, tailHash, bc.GetTailBlockHash())
+	assert.EqualValues(t, 3, bc.GetMaxHeight())
+	assert.Nil(t, bc.Verify(nil))
+}
+
+//setTestStateRoot sets the state root of the block without smart contract transactions on top of its parent
+func setTestStateRoot(t *testing.T, blk *block.Block, parentBlk *block.Block, db storage.Storage) {
+	stateRoot, err := lblock.CalculateStateRoot(blk, parentBlk, scState.NewScState(), scState.NewScState(), storage.NewOverlay(db))
+	require.Nil(t, err)
+	blk.SetStateRoot(stateRoot)
+}
+
 func testGetNumForkHeads(bp *blockchain.BlockPool) int {
 	return len(testGetForkHeadHashes(bp))
 }
@@ -177,6 +290,7 @@ func TestGetUTXOIndexAtBlockHash(t *testing.T) {
 		common.NewAmount(0),
 		0,
 		transaction.TxTypeNormal,
+		0,
 	}
 	abnormalTX := transaction.Transaction{
 		hash.Hash("abnormal"),
@@ -187,17 +301,18 @@ func TestGetUTXOIndexAtBlockHash(t *testing.T) {
 		common.NewAmount(0),
 		0,
 		transaction.TxTypeNormal,
+		0,
 	}
 	prevBlock := block.NewBlock([]*transaction.Transaction{}, genesisBlock, "")
-	prevBlock.SetHash(lblock.CalculateHash(prevBlock))
+	prevBlock.SetHash(lblock.CalculateHash(prevBlock, nil))
 	emptyBlock := block.NewBlock([]*transaction.Transaction{}, prevBlock, "")
-	emptyBlock.SetHash(lblock.CalculateHash(emptyBlock))
+	emptyBlock.SetHash(lblock.CalculateHash(emptyBlock, nil))
 	normalBlock := block.NewBlock([]*transaction.Transaction{&normalTX}, genesisBlock, "")
-	normalBlock.SetHash(lblock.CalculateHash(normalBlock))
+	normalBlock.SetHash(lblock.CalculateHash(normalBlock, nil))
 	normalBlock2 := block.NewBlock([]*transaction.Transaction{&normalTX2}, normalBlock, "")
-	normalBlock2.SetHash(lblock.CalculateHash(normalBlock2))
+	normalBlock2.SetHash(lblock.CalculateHash(normalBlock2, nil))
 	abnormalBlock := block.NewBlock([]*transaction.Transaction{&abnormalTX}, normalBlock, "")
-	abnormalBlock.SetHash(lblock.CalculateHash(abnormalBlock))
+	abnormalBlock.SetHash(lblock.CalculateHash(abnormalBlock, nil))
 	uTXOBlockchain := prepareBlockchainWithBlocks([]*block.Block{normalBlock, normalBlock2})
 	err := removeUTXOFromBlockchain([]*transaction.Transaction{&normalTX2}, uTXOBlockchain.GetUtxoCache()).Save()
 	if err != nil {
@@ -25,11 +25,14 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/dappley/go-dappley/core/history"
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/core/utxo"
 	"github.com/dappley/go-dappley/logic/ltransaction"
 	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/migration"
 	"github.com/dappley/go-dappley/logic/transactionpool"
 
 	"github.com/dappley/go-dappley/common/hash"
@@ -41,6 +44,7 @@ import (
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/storage"
+	blockchainMock "github.com/dappley/go-dappley/logic/lblockchain/mocks"
 	"github.com/dappley/go-dappley/storage/mocks"
 	logger "github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -67,6 +71,37 @@ func TestCreateBlockchain(t *testing.T) {
 	assert.Empty(t, blk.GetPrevHash())
 }
 
+func TestGetBlockchain_SchemaVersion(t *testing.T) {
+	s := GenerateMockBlockchainWithCoinbaseTxOnly(1).GetDb()
+	_, err := GetBlockchain(s, nil, nil, nil, 1000000)
+	assert.Nil(t, err)
+
+	//a database written by a newer version of the node is refused
+	assert.Nil(t, migration.SetVersion(s, migration.CurrentVersion()+1))
+	_, err = GetBlockchain(s, nil, nil, nil, 1000000)
+	assert.Equal(t, migration.ErrVersionTooNew, err)
+}
+
+func TestBlockchain_GetChainIDAtHeight(t *testing.T) {
+	s := storage.NewRamStorage()
+	defer s.Close()
+
+	addr := account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
+	bc := CreateBlockchain(addr, s, nil, transactionpool.NewTransactionPool(nil, 128), nil, 1000000)
+	bc.SetForks(&block.Forks{ChainID: 7, ChainIDHeight: 3})
+
+	//the chain id of the genesis block holds on every height
+	assert.EqualValues(t, DefaultChainID, bc.GetChainIDAtHeight(0))
+	assert.EqualValues(t, DefaultChainID, bc.GetChainIDAtHeight(5))
+
+	//a network whose genesis block has no chain id uses the configured one from its activation height on
+	bc.chainID = 0
+	assert.EqualValues(t, 0, bc.GetChainID())
+	assert.EqualValues(t, 0, bc.GetChainIDAtHeight(2))
+	assert.EqualValues(t, 7, bc.GetChainIDAtHeight(3))
+	assert.EqualValues(t, 7, bc.GetChainIDAtHeight(5))
+}
+
 func TestBlockchain_SetTailBlockHash(t *testing.T) {
 	s := storage.NewRamStorage()
 	defer s.Close()
@@ -138,8 +173,19 @@ func TestBlockchain_RollbackToABlock(t *testing.T) {
 	blk, err := bc.GetBlockByHeight(3)
 	assert.Nil(t, err)
 
+	//a rollback that fails to be committed leaves the blockchain as it is
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+	db := bc.db
+	bc.db = &failedStorage{db, errors.New("simulated storage failure")}
+	assert.False(t, bc.Rollback(blk.GetHash(), lutxo.NewUTXOIndex(bc.GetUtxoCache()), scState.NewScState()))
+	bc.db = db
+	assert.Equal(t, tailBlk.GetHash(), hash.Hash(bc.GetTailBlockHash()))
+	_, _, _, err = bc.GetTransactionByID(tailBlk.GetTransactions()[0].ID)
+	assert.Nil(t, err)
+
 	//rollback to height 3
-	bc.Rollback(blk.GetHash(), lutxo.NewUTXOIndex(bc.GetUtxoCache()), scState.NewScState())
+	assert.True(t, bc.Rollback(blk.GetHash(), lutxo.NewUTXOIndex(bc.GetUtxoCache()), scState.NewScState()))
 
 	//the height 3 block should be the new tail block
 	newTailBlk, err := bc.GetTailBlock()
@@ -148,6 +194,110 @@ func TestBlockchain_RollbackToABlock(t *testing.T) {
 
 }
 
+func TestBlockchain_GetTransactionByID(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	defer bc.db.Close()
+
+	blk, err := bc.GetBlockByHeight(4)
+	assert.Nil(t, err)
+	tx := blk.GetTransactions()[0]
+	retrievedTx, retrievedBlk, index, err := bc.GetTransactionByID(tx.ID)
+	assert.Nil(t, err)
+	assert.Equal(t, tx.ID, retrievedTx.ID)
+	assert.Equal(t, blk.GetHash(), retrievedBlk.GetHash())
+	assert.Equal(t, 0, index)
+
+	_, _, _, err = bc.GetTransactionByID([]byte("txid"))
+	assert.Equal(t, ErrTransactionNotFound, err)
+
+	//the transactions of the blocks that are rolled back are not found anymore
+	parentBlk, err := bc.GetBlockByHeight(3)
+	assert.Nil(t, err)
+	assert.True(t, bc.Rollback(parentBlk.GetHash(), lutxo.NewUTXOIndex(bc.GetUtxoCache()), scState.NewScState()))
+	_, _, _, err = bc.GetTransactionByID(tx.ID)
+	assert.Equal(t, ErrTransactionNotFound, err)
+	_, retrievedBlk, _, err = bc.GetTransactionByID(parentBlk.GetTransactions()[0].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, parentBlk.GetHash(), retrievedBlk.GetHash())
+}
+
+func TestBlockchain_GetAddressHistory(t *testing.T) {
+	miner := account.NewAccount()
+	minerPubKeyHash := miner.GetPubKeyHash()
+	receiverPubKeyHash := account.NewAccount().GetPubKeyHash()
+	libPolicy := &blockchainMock.LIBPolicy{}
+	libPolicy.On("GetProducers").Return(nil)
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	s := storage.NewRamStorage()
+	defer s.Close()
+	bc := CreateBlockchain(miner.GetAddress(), s, libPolicy, transactionpool.NewTransactionPool(nil, 128000), nil, 100000)
+	addBlock := func(txs []*transaction.Transaction) *block.Block {
+		tailBlk, _ := bc.GetTailBlock()
+		blk := block.NewBlock(txs, tailBlk, miner.GetAddress().String())
+		blk.SetHash(lblock.CalculateHash(blk, nil))
+		assert.Nil(t, bc.AddBlockContextToTail(PrepareBlockContext(bc, blk)))
+		return blk
+	}
+	for i := 0; i < 3; i++ {
+		cbtx := ltransaction.NewCoinbaseTX(miner.GetAddress(), "", bc.GetMaxHeight()+1, common.NewAmount(0))
+		addBlock([]*transaction.Transaction{&cbtx})
+	}
+	tailBlk, err := bc.GetTailBlock()
+	assert.Nil(t, err)
+
+	//spend the coinbase output at height 1
+	blk1, err := bc.GetBlockByHeight(1)
+	assert.Nil(t, err)
+	cbtx := blk1.GetTransactions()[0]
+	tx := &transaction.Transaction{
+		ID:   []byte("tx"),
+		Vin:  []transactionbase.TXInput{{Txid: cbtx.ID, Vout: 0, PubKey: miner.GetKeyPair().GetPublicKey()}},
+		Vout: []transactionbase.TXOutput{{Value: cbtx.Vout[0].Value, PubKeyHash: receiverPubKeyHash}},
+		Tip:  common.NewAmount(0),
+	}
+	addBlock([]*transaction.Transaction{tx})
+
+	//the miner received the coinbase outputs from the genesis block on and spent one of them
+	entries, cursor, err := bc.GetAddressHistory(minerPubKeyHash, 0, 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), cursor)
+	assert.Equal(t, 5, len(entries))
+	for height, entry := range entries[:4] {
+		assert.Equal(t, uint64(height), entry.BlockHeight)
+		assert.Equal(t, history.EntryReceived, entry.Type)
+	}
+	assert.Equal(t, history.EntrySpent, entries[4].Type)
+	assert.Equal(t, []byte(tx.ID), entries[4].Txid)
+	assert.Equal(t, []byte(cbtx.ID), entries[4].OutputTxid)
+	assert.Equal(t, cbtx.Vout[0].Value, entries[4].Value)
+
+	entries, _, err = bc.GetAddressHistory(receiverPubKeyHash, 0, 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, history.EntryReceived, entries[0].Type)
+
+	//pages are continued from the cursor
+	entries, cursor, err = bc.GetAddressHistory(minerPubKeyHash, 1, 3, 0, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(entries))
+	assert.Equal(t, uint64(1), entries[0].BlockHeight)
+	entries, cursor, err = bc.GetAddressHistory(minerPubKeyHash, 1, 3, cursor, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), cursor)
+	assert.Equal(t, 1, len(entries))
+	assert.Equal(t, uint64(3), entries[0].BlockHeight)
+
+	//the history of the blocks that are rolled back is removed
+	assert.True(t, bc.Rollback(tailBlk.GetHash(), lutxo.NewUTXOIndex(bc.GetUtxoCache()), scState.NewScState()))
+	entries, _, err = bc.GetAddressHistory(minerPubKeyHash, 0, 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, len(entries))
+	entries, _, err = bc.GetAddressHistory(receiverPubKeyHash, 0, 0, 0, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, entries)
+}
+
 func TestBlockchain_AddBlockToTail(t *testing.T) {
 
 	// Serialized data of an empty block (generated using `utx := NewGenesisBlock(Address{}) hex.EncodeToString(utx.Serialize())`)
@@ -156,7 +306,7 @@ func TestBlockchain_AddBlockToTail(t *testing.T) {
 
 	// Create a blockchain for testing
 	addr := account.NewAddress("dGDrVKjCG3sdXtDUgWZ7Fp3Q97tLhqWivf")
-	bc := &Blockchain{blockchain.NewBlockchain(hash.Hash{}, hash.Hash{}), db, utxo.NewUTXOCache(db), nil, transactionpool.NewTransactionPool(nil, 128), nil, nil, 1000000, &sync.Mutex{}}
+	bc := &Blockchain{blockchain.NewBlockchain(hash.Hash{}, hash.Hash{}), db, utxo.NewUTXOCache(db), nil, transactionpool.NewTransactionPool(nil, 128), nil, nil, 1000000, &sync.Mutex{}, nil, 0, nil, nil, 0}
 	bc.SetState(blockchain.BlockchainInit)
 
 	// Add genesis block
@@ -165,29 +315,23 @@ func TestBlockchain_AddBlockToTail(t *testing.T) {
 	// Storage will allow blockchain creation to succeed
 	db.On("Put", mock.Anything, mock.Anything).Return(nil)
 	db.On("Get", []byte("utxo")).Return([]byte{}, nil)
-	db.On("Get", scState.GetScStateKey([]byte{})).Return([]byte{}, nil)
-	db.On("Get", scState.GetScStateKey(genesis.GetHash())).Return([]byte{}, nil)
+	db.On("Get", []byte("scState")).Return([]byte{}, nil)
 	db.On("Get", mock.Anything).Return(serializedBlk, nil)
-	db.On("EnableBatch").Return()
-	db.On("DisableBatch").Return()
-	// Flush invoked in AddBlockToTail twice
-	db.On("Flush").Return(nil).Twice()
+	db.On("NewBatch").Return(storage.NewRamStorage().NewBatch()).Once()
 
 	err := bc.AddBlockContextToTail(PrepareBlockContext(bc, genesis))
 
-	// Expect batch write was used
-	//db.AssertCalled(t, "EnableBatch")
-	db.AssertCalled(t, "Flush")
-	db.AssertCalled(t, "DisableBatch")
+	// Expect the writes of the block were committed in one batch
+	db.AssertNumberOfCalls(t, "NewBatch", 1)
 
 	// Expect no error when adding genesis block
 	assert.Nil(t, err)
 	// Expect that blockchain tail is genesis block
 	assert.Equal(t, genesis.GetHash(), hash.Hash(bc.GetTailBlockHash()))
 
-	// Simulate a failure when flushing new block to storage
+	// Simulate a failure when committing new block to storage
 	simulatedFailure := errors.New("simulated storage failure")
-	db.On("Flush").Return(simulatedFailure)
+	db.On("NewBatch").Return(&failedBatch{storage.NewRamStorage().NewBatch(), simulatedFailure})
 
 	// Add new block
 	blk := block.NewBlock([]*transaction.Transaction{}, genesis, "")
@@ -196,10 +340,30 @@ func TestBlockchain_AddBlockToTail(t *testing.T) {
 	blk.SetHeight(1)
 	err = bc.AddBlockContextToTail(PrepareBlockContext(bc, blk))
 
-	// Expect the coinbase tx to go through
-	assert.Equal(t, nil, err)
-	// Expect that the block added is the blockchain tail
-	assert.Equal(t, blk.GetHash(), hash.Hash(bc.GetTailBlockHash()))
+	// Expect the failure to be returned
+	assert.Equal(t, simulatedFailure, err)
+	// Expect that the tail is unchanged
+	assert.Equal(t, genesis.GetHash(), hash.Hash(bc.GetTailBlockHash()))
+}
+
+//failedBatch is a write batch that fails to write
+type failedBatch struct {
+	storage.Batch
+	err error
+}
+
+func (b *failedBatch) Write() error {
+	return b.err
+}
+
+//failedStorage is a storage whose write batches fail to write
+type failedStorage struct {
+	storage.Storage
+	err error
+}
+
+func (s *failedStorage) NewBatch() storage.Batch {
+	return &failedBatch{s.Storage.NewBatch(), s.err}
 }
 
 func BenchmarkBlockchain_AddBlockToTail(b *testing.B) {
@@ -231,7 +395,7 @@ func BenchmarkBlockchain_AddBlockToTail(b *testing.B) {
 		}
 
 		b := block.NewBlock(txs, tailBlk, "")
-		b.SetHash(lblock.CalculateHash(b))
+		b.SetHash(lblock.CalculateHash(b, nil))
 		state := scState.LoadScStateFromDatabase(bc.GetDb())
 		bc.AddBlockContextToTail(&BlockContext{Block: b, UtxoIndex: utxo, State: state})
 	}
@@ -247,8 +411,48 @@ func GenerateMockBlockchain(size int) *Blockchain {
 	for i := 0; i < size; i++ {
 		tailBlk, _ := bc.GetTailBlock()
 		b := block.NewBlock([]*transaction.Transaction{core.MockTransaction()}, tailBlk, "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
-		b.SetHash(lblock.CalculateHash(b))
+		b.SetHash(lblock.CalculateHash(b, nil))
 		bc.AddBlockContextToTail(PrepareBlockContext(bc, b))
 	}
 	return bc
 }
+
+type fakeBlockFinalizer struct {
+	blocks []*block.Block
+}
+
+func (finalizer *fakeBlockFinalizer) OnBlockAdded(blk *block.Block) {
+	finalizer.blocks = append(finalizer.blocks, blk)
+}
+
+func TestBlockchain_Finalize(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	defer bc.db.Close()
+	finalizer := &fakeBlockFinalizer{}
+	bc.SetFinalityGadget(finalizer)
+
+	blk, err := bc.GetBlockByHeight(3)
+	assert.Nil(t, err)
+	cert := &block.FinalityCertificate{BlockHash: blk.GetHash(), Height: 3, Signatures: []hash.Hash{hash.Hash("signature")}}
+	assert.Nil(t, bc.Finalize(cert))
+	assert.Equal(t, uint64(3), bc.GetLIBHeight())
+	savedCert, err := bc.GetFinalityCertificate(blk.GetHash())
+	assert.Nil(t, err)
+	assert.Equal(t, cert, savedCert)
+
+	//an older certificate is saved without moving the irreversible block back
+	blk, err = bc.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	assert.Nil(t, bc.Finalize(&block.FinalityCertificate{BlockHash: blk.GetHash(), Height: 2}))
+	assert.Equal(t, uint64(3), bc.GetLIBHeight())
+
+	assert.Equal(t, ErrBlockNotOnMainChain, bc.Finalize(&block.FinalityCertificate{BlockHash: hash.Hash("hash"), Height: 4}))
+	assert.Equal(t, ErrBlockNotOnMainChain, bc.Finalize(&block.FinalityCertificate{BlockHash: hash.Hash("hash"), Height: 6}))
+	_, err = bc.GetFinalityCertificate(hash.Hash("hash"))
+	assert.Equal(t, ErrCertificateNotFound, err)
+
+	//the finality gadget is notified of new blocks, and the confirmation count does not move the irreversible block
+	AddBlockToGeneratedBlockchain(bc, 6)
+	assert.Equal(t, 6, len(finalizer.blocks))
+	assert.Equal(t, uint64(3), bc.GetLIBHeight())
+}
@@ -18,35 +18,254 @@
 package lblockchain
 
 import (
-	"time"
+	"errors"
+	"sort"
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
 	"github.com/dappley/go-dappley/logic/lblock"
+	lblockchainpb "github.com/dappley/go-dappley/logic/lblockchain/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
 )
 
-const genesisCoinbaseData = "Hello world"
+const (
+	DefaultChainID          = 1
+	defaultGenesisTimestamp = 1532392928 //July 23,2018 17:42 PST
+)
+
+var (
+	ErrGenesisChainIDMissing    = errors.New("genesis chain id is missing")
+	ErrGenesisNoAllocation      = errors.New("genesis has no balance allocation")
+	ErrGenesisAllocationInvalid = errors.New("genesis allocation is invalid")
+	ErrGenesisContractInvalid   = errors.New("genesis contract is invalid")
+	ErrGenesisProducerInvalid   = errors.New("genesis producer is invalid")
+	ErrGenesisBlockInvalid      = errors.New("block is not a genesis block")
+	ErrGenesisMismatch          = errors.New("genesis block in the database is not the one of the genesis file")
+)
+
+// Genesis describes the first block of a network: the initial balances, the pre-deployed contracts with their
+// storage, the initial producers, the time and the chain id. All of them are part of the hash of the genesis block
+type Genesis struct {
+	ChainID     uint64
+	Timestamp   int64
+	Producers   []string
+	Allocations []*GenesisAllocation
+	Contracts   []*GenesisContract
+}
+
+// GenesisAllocation is a balance paid to an address in the genesis block
+type GenesisAllocation struct {
+	Address account.Address
+	Amount  *common.Amount
+}
 
+// GenesisContract is a contract deployed in the genesis block with its initial storage
+type GenesisContract struct {
+	Address account.Address
+	Source  string
+	Storage map[string]string
+}
+
+//NewDefaultGenesis returns the genesis that pays the subsidy to the address
+func NewDefaultGenesis(address account.Address, subsidy *common.Amount) *Genesis {
+	return &Genesis{
+		ChainID:     DefaultChainID,
+		Timestamp:   defaultGenesisTimestamp,
+		Allocations: []*GenesisAllocation{{Address: address, Amount: subsidy}},
+	}
+}
+
+//NewGenesisBlock returns the default genesis block that pays the subsidy to the address
 func NewGenesisBlock(address account.Address, subsidy *common.Amount) *block.Block {
-	acc := account.NewTransactionAccountByAddress(address)
-	txin := transactionbase.TXInput{nil, -1, nil, []byte(genesisCoinbaseData)}
-	txout := transactionbase.NewTXOutput(subsidy, acc)
-	txs := []*transaction.Transaction{}
-	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeCoinbase}
-	tx.ID = tx.Hash()
-	txs = append(txs, &tx)
+	return NewDefaultGenesis(address, subsidy).ToBlock()
+}
 
-	blk := block.NewBlockWithRawInfo(
-		nil,
-		nil,
-		0,
-		1532392928, //July 23,2018 17:42 PST
-		0,
-		txs)
+//Verify checks that the genesis has a chain id, at least one allocation, and valid addresses for its allocations,
+//contracts and producers
+func (g *Genesis) Verify() error {
+	if g.ChainID == 0 {
+		return ErrGenesisChainIDMissing
+	}
+	if len(g.Allocations) == 0 {
+		return ErrGenesisNoAllocation
+	}
+	for _, allocation := range g.Allocations {
+		acc := account.NewTransactionAccountByAddress(allocation.Address)
+		if !acc.IsValid() || allocation.Amount == nil || allocation.Amount.Validate() != nil {
+			return ErrGenesisAllocationInvalid
+		}
+		if isContract, _ := acc.GetPubKeyHash().IsContract(); isContract {
+			return ErrGenesisAllocationInvalid
+		}
+	}
+	deployed := make(map[account.Address]bool)
+	for _, contract := range g.Contracts {
+		acc := account.NewTransactionAccountByAddress(contract.Address)
+		if !acc.IsValid() || contract.Source == "" || deployed[contract.Address] {
+			return ErrGenesisContractInvalid
+		}
+		if isContract, _ := acc.GetPubKeyHash().IsContract(); !isContract {
+			return ErrGenesisContractInvalid
+		}
+		deployed[contract.Address] = true
+	}
+	for _, producer := range g.Producers {
+		if !account.NewTransactionAccountByAddress(account.NewAddress(producer)).IsValid() {
+			return ErrGenesisProducerInvalid
+		}
+	}
+	return nil
+}
 
-	blk.SetHash(lblock.CalculateHash(blk))
+//ToBlock builds the genesis block. Its coinbase transaction pays the allocations followed by one output for each
+//contract, and carries the chain id, the producers and the contract storage as its data
+func (g *Genesis) ToBlock() *block.Block {
+	txin := transactionbase.TXInput{Txid: nil, Vout: -1, Signature: nil, PubKey: g.serializeData()}
+	var txouts []transactionbase.TXOutput
+	for _, allocation := range g.Allocations {
+		txouts = append(txouts, *transactionbase.NewTXOutput(allocation.Amount, account.NewTransactionAccountByAddress(allocation.Address)))
+	}
+	for _, contract := range g.Contracts {
+		txouts = append(txouts, *transactionbase.NewContractTXOutput(account.NewTransactionAccountByAddress(contract.Address), contract.Source))
+	}
+	tx := &transaction.Transaction{
+		Vin:        []transactionbase.TXInput{txin},
+		Vout:       txouts,
+		Tip:        common.NewAmount(0),
+		GasLimit:   common.NewAmount(0),
+		GasPrice:   common.NewAmount(0),
+		CreateTime: g.Timestamp * 1000,
+		Type:       transaction.TxTypeCoinbase,
+	}
+	tx.ID = tx.Hash()
+
+	//the state root of the genesis block is not in its header. It is saved when the blockchain is created
+	blk := block.NewBlockWithRawInfo(nil, nil, 0, g.Timestamp, 0, []*transaction.Transaction{tx})
+	blk.SetHash(lblock.CalculateHash(blk, nil))
 	return blk
 }
+
+//ToState returns the contract storage after the genesis block
+func (g *Genesis) ToState() *scState.ScState {
+	state := scState.NewScState()
+	for _, contract := range g.Contracts {
+		for key, value := range contract.Storage {
+			state.Set(contract.Address.String(), key, value)
+		}
+	}
+	return state
+}
+
+//NewGenesisFromBlock recovers the genesis from its block. The genesis blocks of earlier versions carry no genesis data
+//and return ErrGenesisBlockInvalid
+func NewGenesisFromBlock(blk *block.Block) (*Genesis, error) {
+	if blk.GetHeight() != 0 || len(blk.GetTransactions()) != 1 || len(blk.GetTransactions()[0].Vin) != 1 {
+		return nil, ErrGenesisBlockInvalid
+	}
+	tx := blk.GetTransactions()[0]
+	data := &lblockchainpb.GenesisData{}
+	if err := proto.Unmarshal(tx.Vin[0].PubKey, data); err != nil || data.GetChainId() == 0 {
+		return nil, ErrGenesisBlockInvalid
+	}
+
+	contractStorage := make(map[string]map[string]string)
+	for _, s := range data.GetStorage() {
+		contractStorage[s.GetAddress()] = make(map[string]string)
+		for _, item := range s.GetItems() {
+			contractStorage[s.GetAddress()][item.GetKey()] = item.GetValue()
+		}
+	}
+	g := &Genesis{
+		ChainID:   data.GetChainId(),
+		Timestamp: blk.GetTimestamp(),
+		Producers: data.GetProducers(),
+	}
+	for _, vout := range tx.Vout {
+		address := vout.GetAddress()
+		if isContract, _ := vout.PubKeyHash.IsContract(); isContract {
+			g.Contracts = append(g.Contracts, &GenesisContract{Address: address, Source: vout.Contract, Storage: contractStorage[address.String()]})
+			continue
+		}
+		g.Allocations = append(g.Allocations, &GenesisAllocation{Address: address, Amount: vout.Value})
+	}
+	return g, nil
+}
+
+//GetGenesis returns the genesis recovered from the genesis block in the database. The genesis blocks of earlier
+//versions carry no genesis data and return ErrGenesisBlockInvalid
+func GetGenesis(db storage.Storage) (*Genesis, error) {
+	genesisHash, err := GetGenesisHash(db)
+	if err != nil {
+		return nil, err
+	}
+	rawBytes, err := db.Get(genesisHash)
+	if err != nil {
+		return nil, ErrBlockDoesNotExist
+	}
+	return NewGenesisFromBlock(block.Deserialize(rawBytes))
+}
+
+//GetChainID returns the chain id of the genesis block in the database. The genesis blocks of earlier versions have no
+//chain id and return 0
+func GetChainID(db storage.Storage) (uint64, error) {
+	genesis, err := GetGenesis(db)
+	if err == ErrGenesisBlockInvalid {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return genesis.ChainID, nil
+}
+
+//VerifyGenesis returns ErrGenesisMismatch if the genesis block in the database is not the block of the genesis. A
+//database without blockchain passes, and so does a genesis block of an earlier version, which cannot be built from a
+//genesis file
+func VerifyGenesis(db storage.Storage, genesis *Genesis) error {
+	genesisHash, err := GetGenesisHash(db)
+	if err != nil || genesisHash.Equals(genesis.ToBlock().GetHash()) {
+		return nil
+	}
+	if _, err := GetGenesis(db); err == ErrGenesisBlockInvalid {
+		logger.WithFields(logger.Fields{
+			"chain_genesis_hash": genesisHash,
+		}).Warn("Genesis: the genesis block in the database predates the genesis file and is not verified.")
+		return nil
+	}
+	return ErrGenesisMismatch
+}
+
+//serializeData encodes the chain id, the producers and the contract storage. The storage is sorted by key so that the
+//same genesis always results in the same block
+func (g *Genesis) serializeData() []byte {
+	data := &lblockchainpb.GenesisData{
+		ChainId:   g.ChainID,
+		Producers: g.Producers,
+	}
+	for _, contract := range g.Contracts {
+		if len(contract.Storage) == 0 {
+			continue
+		}
+		keys := make([]string, 0, len(contract.Storage))
+		for key := range contract.Storage {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		s := &lblockchainpb.GenesisStorage{Address: contract.Address.String()}
+		for _, key := range keys {
+			s.Items = append(s.Items, &lblockchainpb.GenesisStorageItem{Key: key, Value: contract.Storage[key]})
+		}
+		data.Storage = append(data.Storage, s)
+	}
+	rawBytes, err := proto.Marshal(data)
+	if err != nil {
+		logger.WithError(err).Panic("Genesis: cannot serialize the genesis data!")
+	}
+	return rawBytes
+}
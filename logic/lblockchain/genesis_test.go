@@ -0,0 +1,163 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	genesisTestAddr1    = "dastXXWLe5pxbRYFhcyUq8T3wb5srWkHKa"
+	genesisTestAddr2    = "dUuPPYshbBgkzUrgScEHWvdGbSxC8z4R12"
+	genesisTestContract = "cmD7LPpacQgaUgydJG3gM5749n6NMn9uv1"
+)
+
+func newTestGenesis() *Genesis {
+	return &Genesis{
+		ChainID:   7,
+		Timestamp: 1600000000,
+		Producers: []string{genesisTestAddr1},
+		Allocations: []*GenesisAllocation{
+			{Address: account.NewAddress(genesisTestAddr1), Amount: common.NewAmount(100)},
+			{Address: account.NewAddress(genesisTestAddr2), Amount: common.NewAmount(200)},
+		},
+		Contracts: []*GenesisContract{
+			{
+				Address: account.NewAddress(genesisTestContract),
+				Source:  "'use strict'; module.exports = {};",
+				Storage: map[string]string{"owner": genesisTestAddr1, "supply": "300"},
+			},
+		},
+	}
+}
+
+func TestGenesis_ToBlock(t *testing.T) {
+	g := newTestGenesis()
+	require.Nil(t, g.Verify())
+
+	//the same genesis always results in the same block
+	blk := g.ToBlock()
+	assert.Equal(t, blk.GetHash(), newTestGenesis().ToBlock().GetHash())
+	assert.EqualValues(t, 1600000000, blk.GetTimestamp())
+	assert.Len(t, blk.GetTransactions()[0].Vout, 3)
+
+	recovered, err := NewGenesisFromBlock(blk)
+	require.Nil(t, err)
+	assert.Equal(t, g, recovered)
+
+	//every part of the genesis changes the block
+	g.ChainID = 8
+	assert.NotEqual(t, blk.GetHash(), g.ToBlock().GetHash())
+	g = newTestGenesis()
+	g.Allocations[1].Amount = common.NewAmount(201)
+	assert.NotEqual(t, blk.GetHash(), g.ToBlock().GetHash())
+	g = newTestGenesis()
+	g.Contracts[0].Storage["supply"] = "301"
+	assert.NotEqual(t, blk.GetHash(), g.ToBlock().GetHash())
+	g = newTestGenesis()
+	g.Producers = nil
+	assert.NotEqual(t, blk.GetHash(), g.ToBlock().GetHash())
+
+	//a block above the genesis is not a genesis block
+	blk.SetHeight(1)
+	_, err = NewGenesisFromBlock(blk)
+	assert.Equal(t, ErrGenesisBlockInvalid, err)
+}
+
+func TestGenesis_Verify(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(g *Genesis)
+		err    error
+	}{
+		{"no chain id", func(g *Genesis) { g.ChainID = 0 }, ErrGenesisChainIDMissing},
+		{"no allocation", func(g *Genesis) { g.Allocations = nil }, ErrGenesisNoAllocation},
+		{"invalid allocation address", func(g *Genesis) { g.Allocations[0].Address = account.NewAddress("invalid") }, ErrGenesisAllocationInvalid},
+		{"allocation to contract", func(g *Genesis) { g.Allocations[0].Address = account.NewAddress(genesisTestContract) }, ErrGenesisAllocationInvalid},
+		{"no allocation amount", func(g *Genesis) { g.Allocations[0].Amount = nil }, ErrGenesisAllocationInvalid},
+		{"user contract address", func(g *Genesis) { g.Contracts[0].Address = account.NewAddress(genesisTestAddr2) }, ErrGenesisContractInvalid},
+		{"no contract source", func(g *Genesis) { g.Contracts[0].Source = "" }, ErrGenesisContractInvalid},
+		{"duplicate contract", func(g *Genesis) { g.Contracts = append(g.Contracts, g.Contracts[0]) }, ErrGenesisContractInvalid},
+		{"invalid producer", func(g *Genesis) { g.Producers = []string{"invalid"} }, ErrGenesisProducerInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGenesis()
+			tt.modify(g)
+			assert.Equal(t, tt.err, g.Verify())
+		})
+	}
+}
+
+func TestCreateBlockchainWithGenesis(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	g := newTestGenesis()
+	bc := CreateBlockchainWithGenesis(g, db, nil, transactionpool.NewTransactionPool(nil, 128000), nil, 100000)
+
+	genesisHash, err := GetGenesisHash(db)
+	require.Nil(t, err)
+	assert.Equal(t, g.ToBlock().GetHash(), genesisHash)
+
+	utxoIndex := lutxo.NewUTXOIndex(bc.GetUtxoCache())
+	for _, allocation := range g.Allocations {
+		pubKeyHash := account.NewTransactionAccountByAddress(allocation.Address).GetPubKeyHash()
+		utxos := utxoIndex.GetAllUTXOsByPubKeyHash(pubKeyHash).GetAllUtxos()
+		require.Len(t, utxos, 1)
+		assert.Equal(t, allocation.Amount, utxos[0].Value)
+	}
+	contractPubKeyHash := account.NewTransactionAccountByAddress(account.NewAddress(genesisTestContract)).GetPubKeyHash()
+	assert.Equal(t, g.Contracts[0].Source, utxoIndex.GetContractCreateUTXOByPubKeyHash(contractPubKeyHash).Contract)
+	assert.Equal(t, g.Contracts[0].Storage, scState.LoadScStateFromDatabase(db).GetStorageByAddress(genesisTestContract))
+
+	//the contract storage of the genesis block is replayed by the verification and the reindex
+	assert.Nil(t, bc.Verify(nil))
+	require.Nil(t, bc.Reindex())
+	assert.Equal(t, g.Contracts[0].Storage, scState.LoadScStateFromDatabase(db).GetStorageByAddress(genesisTestContract))
+	assert.Nil(t, bc.Verify(nil))
+}
+
+func TestVerifyGenesis(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	g := newTestGenesis()
+	assert.Nil(t, VerifyGenesis(db, g))
+	_, err := GetGenesis(db)
+	assert.Equal(t, ErrBlockDoesNotExist, err)
+
+	CreateBlockchainWithGenesis(g, db, nil, transactionpool.NewTransactionPool(nil, 128000), nil, 100000)
+	assert.Nil(t, VerifyGenesis(db, g))
+	recovered, err := GetGenesis(db)
+	require.Nil(t, err)
+	assert.Equal(t, g.Producers, recovered.Producers)
+
+	//a genesis file of another network does not match the blockchain
+	other := newTestGenesis()
+	other.ChainID = 8
+	assert.Equal(t, ErrGenesisMismatch, VerifyGenesis(db, other))
+}
@@ -0,0 +1,440 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/history"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	integrityLogInterval = 1000
+	reindexPhaseClear    = "clear"
+	reindexPhaseMove     = "move"
+)
+
+var (
+	//reindexScratchPrefix is the prefix of the scratch area where the indexes are rebuilt, and reindexPhaseKey holds the
+	//phase of the reindex while the scratch area replaces the live indexes
+	reindexScratchPrefix = []byte("reindex_")
+	reindexPhaseKey      = []byte("reindexPhase")
+)
+
+var (
+	ErrHeightIndexInvalid    = errors.New("block is not indexed at its height")
+	ErrBlockHashInvalid      = errors.New("block hash verify failed")
+	ErrUtxoIndexInconsistent = errors.New("UTXO index does not match the blocks")
+	ErrScStateInconsistent   = errors.New("contract storage does not match the blocks")
+	ErrChainPruned           = errors.New("blocks below the pruned height cannot be replayed")
+)
+
+// IntegrityError is the first inconsistency found at a height of the blockchain
+type IntegrityError struct {
+	Height uint64
+	Err    error
+}
+
+func (e *IntegrityError) Error() string {
+	return fmt.Sprintf("height %d: %v", e.Height, e.Err)
+}
+
+//Verify walks the blockchain from the genesis block to the tail and returns an IntegrityError at the first height whose
+//block is not indexed, has an invalid hash or signature, does not link to its parent or has invalid transactions. The
+//transactions are replayed on an empty UTXO index and contract storage, which have to match the ones in the database
+//at the tail. The signatures of the headers are only checked if the verifier is not nil, and the transactions are only
+//replayed if no block has been pruned
+func (bc *Blockchain) Verify(verifier HeaderVerifier) error {
+	tailHeight := bc.GetMaxHeight()
+	replay := bc.GetPrunedHeight() == 0
+	if !replay {
+		logger.Warn("Blockchain: the transactions are not verified since the chain has been pruned.")
+	}
+
+	//the replay is written into memory and the state trie nodes into an overlay that is discarded
+	replayDb := storage.NewRamStorage()
+	scratch := storage.NewOverlay(bc.db)
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(replayDb))
+	state := scState.LoadScStateFromDatabase(replayDb)
+	pubKeyHashes := make(map[string]bool)
+
+	var parentBlk *block.Block
+	for height := uint64(0); height <= tailHeight; height++ {
+		blk, err := bc.getIndexedBlock(height)
+		if err != nil {
+			return &IntegrityError{height, err}
+		}
+		if height > 0 {
+			if !blk.GetPrevHash().Equals(parentBlk.GetHash()) {
+				return &IntegrityError{height, ErrPrevHashVerifyFailed}
+			}
+			if verifier != nil {
+				if err := verifier.Verify(bc.GetSignedHeader(blk)); err != nil {
+					return &IntegrityError{height, err}
+				}
+			}
+		}
+		if replay {
+			if err := replayBlock(blk, parentBlk, utxoIndex, state, scratch, bc.forks, bc.GetChainIDAtHeight(height)); err != nil {
+				return &IntegrityError{height, err}
+			}
+			if err := utxoIndex.Save(); err != nil {
+				return err
+			}
+			if err := state.Save(replayDb, blk.GetHash()); err != nil {
+				return err
+			}
+			for _, tx := range blk.GetTransactions() {
+				for _, vout := range tx.Vout {
+					pubKeyHashes[string(vout.PubKeyHash)] = true
+				}
+			}
+			scratch.Discard()
+		}
+		if height%integrityLogInterval == 0 {
+			logger.WithFields(logger.Fields{
+				"height":     height,
+				"tailHeight": tailHeight,
+			}).Info("Blockchain: is verifying the blocks.")
+		}
+		parentBlk = blk
+	}
+
+	if replay {
+		storedIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(bc.db))
+		for pubKeyHash := range pubKeyHashes {
+			replayed := utxoIndex.GetAllUTXOsByPubKeyHash(account.PubKeyHash(pubKeyHash))
+			if !isSameUTXOs(replayed, storedIndex.GetAllUTXOsByPubKeyHash(account.PubKeyHash(pubKeyHash))) {
+				return &IntegrityError{tailHeight, ErrUtxoIndexInconsistent}
+			}
+		}
+		if !proto.Equal(state.ToProto(), scState.LoadScStateFromDatabase(bc.db).ToProto()) {
+			return &IntegrityError{tailHeight, ErrScStateInconsistent}
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"tailHeight": tailHeight,
+		"replayed":   replay,
+	}).Info("Blockchain: verified the blocks.")
+	return nil
+}
+
+//Reindex rebuilds the UTXO index, the contract storage, the state trie of every block, the transaction index and the
+//address history by replaying the blocks from the genesis block to the tail. Every block is verified by its
+//transactions before it is applied, and an IntegrityError is returned at the first block that fails. The indexes are
+//rebuilt in a scratch area, which replaces the live indexes only after the replay succeeds. A pruned chain cannot be
+//reindexed
+func (bc *Blockchain) Reindex() error {
+	bc.mutex.Lock()
+	defer bc.mutex.Unlock()
+
+	if bc.GetPrunedHeight() > 0 {
+		return ErrChainPruned
+	}
+	if err := bc.finishReindex(); err != nil {
+		return err
+	}
+	tailHeight := bc.GetMaxHeight()
+
+	//the scratch area of a reindex that failed before is removed first
+	if _, err := clearScratch(bc.db); err != nil {
+		return err
+	}
+	if err := bc.replayIntoScratch(tailHeight); err != nil {
+		if _, clearErr := clearScratch(bc.db); clearErr != nil {
+			logger.WithError(clearErr).Warn("Blockchain: failed to remove the scratch area of the reindex.")
+		}
+		return err
+	}
+	if err := bc.swapIndexes(reindexPhaseClear); err != nil {
+		return err
+	}
+
+	logger.WithFields(logger.Fields{
+		"tailHeight": tailHeight,
+	}).Info("Blockchain: reindexed the blocks.")
+	return nil
+}
+
+//replayIntoScratch replays the blocks from the genesis block to the tail height on empty indexes in the scratch area
+func (bc *Blockchain) replayIntoScratch(tailHeight uint64) error {
+	scratch := storage.NewPrefixStorage(bc.db, reindexScratchPrefix)
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(scratch))
+	state := scState.LoadScStateFromDatabase(scratch)
+
+	var parentBlk *block.Block
+	for height := uint64(0); height <= tailHeight; height++ {
+		blk, err := bc.getIndexedBlock(height)
+		if err != nil {
+			return &IntegrityError{height, err}
+		}
+		if height > 0 && !blk.GetPrevHash().Equals(parentBlk.GetHash()) {
+			return &IntegrityError{height, ErrPrevHashVerifyFailed}
+		}
+
+		//the changes of every block are written together
+		overlay := storage.NewOverlay(scratch)
+		parentState := scState.LoadScStateFromDatabase(scratch)
+		if err := replayBlock(blk, parentBlk, utxoIndex, state, overlay, bc.forks, bc.GetChainIDAtHeight(height)); err != nil {
+			return &IntegrityError{height, err}
+		}
+		if _, err := lblock.CommitStateRoot(blk, parentBlk, parentState, state, overlay); err != nil {
+			return &IntegrityError{height, err}
+		}
+		utxoLayer, err := utxoIndex.SaveToStorage(overlay)
+		if err != nil {
+			return err
+		}
+		if err := state.Save(overlay, blk.GetHash()); err != nil {
+			return err
+		}
+		if err := indexTransactions(blk, overlay, bc.db); err != nil {
+			return err
+		}
+		if err := overlay.Commit(); err != nil {
+			return err
+		}
+		utxoLayer.Commit()
+
+		if height%integrityLogInterval == 0 {
+			logger.WithFields(logger.Fields{
+				"height":     height,
+				"tailHeight": tailHeight,
+			}).Info("Blockchain: is reindexing the blocks.")
+		}
+		parentBlk = blk
+	}
+	return nil
+}
+
+//finishReindex completes the reindex that stopped while its scratch area was replacing the live indexes. It does
+//nothing if no reindex was replacing the indexes
+func (bc *Blockchain) finishReindex() error {
+	phase, err := bc.db.Get(reindexPhaseKey)
+	if err != nil {
+		return nil
+	}
+	logger.Warn("Blockchain: is completing the reindex that stopped while replacing the indexes.")
+	return bc.swapIndexes(string(phase))
+}
+
+//swapIndexes replaces the live indexes by the ones in the scratch area, starting from the phase. The live indexes are
+//removed first and the scratch area is then moved onto them. The phase is saved before each step, so that a swap that
+//stops midway is completed by finishReindex
+func (bc *Blockchain) swapIndexes(phase string) error {
+	if phase == reindexPhaseClear {
+		if err := bc.db.Put(reindexPhaseKey, []byte(reindexPhaseClear)); err != nil {
+			return err
+		}
+		numOfKey, err := bc.clearIndexes(bc.GetMaxHeight())
+		if err != nil {
+			return err
+		}
+		logger.WithFields(logger.Fields{
+			"numOfKey": numOfKey,
+		}).Info("Blockchain: removed the UTXO index, the contract storage and the address history.")
+	}
+
+	if err := bc.db.Put(reindexPhaseKey, []byte(reindexPhaseMove)); err != nil {
+		return err
+	}
+	numOfKey, err := moveScratch(bc.db)
+	if err != nil {
+		return err
+	}
+	logger.WithFields(logger.Fields{
+		"numOfKey": numOfKey,
+	}).Info("Blockchain: replaced the indexes by the reindexed ones.")
+
+	bc.utxoCache = utxo.NewUTXOCache(bc.db)
+	return bc.db.Del(reindexPhaseKey)
+}
+
+//moveScratch moves the keys in the scratch area of the reindex onto the live keys in chunks of bounded size. A key is
+//removed from the scratch area after it is written, so that a move that stops midway can be run again. It returns the
+//number of moved keys
+func moveScratch(db storage.Storage) (int, error) {
+	batch := storage.NewChunkedBatch(db, storage.DefaultChunkLen)
+	it := db.NewPrefixIterator(reindexScratchPrefix)
+	defer it.Release()
+	numOfKey := 0
+	for it.Next() {
+		key := append([]byte{}, it.Key()...)
+		if err := batch.Put(key[len(reindexScratchPrefix):], append([]byte{}, it.Value()...)); err != nil {
+			return 0, err
+		}
+		if err := batch.Del(key); err != nil {
+			return 0, err
+		}
+		numOfKey++
+	}
+	if err := it.Error(); err != nil {
+		return 0, err
+	}
+	return numOfKey, batch.Write()
+}
+
+//clearScratch removes the scratch area of the reindex in chunks of bounded size. It returns the number of removed keys
+func clearScratch(db storage.Storage) (int, error) {
+	batch := storage.NewChunkedBatch(db, storage.DefaultChunkLen)
+	it := db.NewPrefixIterator(reindexScratchPrefix)
+	defer it.Release()
+	numOfKey := 0
+	for it.Next() {
+		if err := batch.Del(append([]byte{}, it.Key()...)); err != nil {
+			return 0, err
+		}
+		numOfKey++
+	}
+	if err := it.Error(); err != nil {
+		return 0, err
+	}
+	return numOfKey, batch.Write()
+}
+
+//ReindexDatabase reindexes the blockchain in the database before the database is of the current schema version. It is
+//run by the migrations that add an index. A database without blockchain is left as it is, and so is a pruned
+//blockchain, since its blocks below the pruned height cannot be replayed
+func ReindexDatabase(db storage.Storage, forks *block.Forks) error {
+	if _, err := db.Get(tipKey); err != nil {
+		return nil
+	}
+	bc, err := loadBlockchain(db, nil, nil, nil, 0)
+	if err != nil {
+		return err
+	}
+	if bc.GetPrunedHeight() > 0 {
+		logger.Warn("Blockchain: the pruned blocks cannot be reindexed.")
+		return nil
+	}
+	bc.SetForks(forks)
+	return bc.Reindex()
+}
+
+//getIndexedBlock returns the block indexed at the height and checks its hash. A pruned block is checked by its header
+func (bc *Blockchain) getIndexedBlock(height uint64) (*block.Block, error) {
+	blk, err := bc.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if blk.GetHeight() != height {
+		return nil, ErrHeightIndexInvalid
+	}
+	if bc.IsPruned(height) {
+		indexedHash, err := bc.db.Get(util.UintToHex(height))
+		if err != nil || !blk.GetHash().Equals(indexedHash) {
+			return nil, ErrBlockHashInvalid
+		}
+		return blk, nil
+	}
+	if !lblock.VerifyHash(blk, bc.forks) {
+		return nil, ErrBlockHashInvalid
+	}
+	return blk, nil
+}
+
+//clearIndexes removes the UTXOs, the UTXO list heads and the address history of the owners of all outputs in the blocks
+//up to the tail height, and the contract storage. The keys are removed in chunks of bounded size. It returns the number
+//of removed keys
+func (bc *Blockchain) clearIndexes(tailHeight uint64) (int, error) {
+	batch := storage.NewChunkedBatch(bc.db, storage.DefaultChunkLen)
+	numOfKey := 0
+	owners := make(map[string]bool)
+	for height := uint64(0); height <= tailHeight; height++ {
+		blk, err := bc.GetBlockByHeight(height)
+		if err != nil {
+			return 0, &IntegrityError{height, err}
+		}
+		for _, tx := range blk.GetTransactions() {
+			for i, vout := range tx.Vout {
+				if err := batch.Del([]byte((&utxo.UTXO{Txid: tx.ID, TxIndex: i}).GetUTXOKey())); err != nil {
+					return 0, err
+				}
+				if err := batch.Del([]byte(vout.PubKeyHash.String())); err != nil {
+					return 0, err
+				}
+				numOfKey += 2
+				owners[string(vout.PubKeyHash)] = true
+			}
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return 0, err
+	}
+	for owner := range owners {
+		numOfKey += int(history.GetNumOfEntries(account.PubKeyHash(owner), bc.db))
+		if err := history.DeleteEntries(account.PubKeyHash(owner), bc.db); err != nil {
+			return 0, err
+		}
+	}
+	numOfScKey, err := scState.ClearDatabase(bc.db)
+	if err != nil {
+		return 0, err
+	}
+	return numOfKey + numOfScKey, nil
+}
+
+//replayBlock applies the transactions of the block on the UTXO index and the state after they are verified for the
+//chain id. The genesis block is applied without verification, together with the storage of its contracts
+func replayBlock(blk *block.Block, parentBlk *block.Block, utxoIndex *lutxo.UTXOIndex, state *scState.ScState, db storage.Storage, forks *block.Forks, chainID uint64) error {
+	if parentBlk == nil {
+		utxoIndex.UpdateUtxos(blk.GetTransactions())
+		//the genesis blocks of earlier versions have no contracts
+		if genesis, err := NewGenesisFromBlock(blk); err == nil {
+			for _, contract := range genesis.Contracts {
+				for key, value := range contract.Storage {
+					state.Set(contract.Address.String(), key, value)
+				}
+			}
+		}
+		return nil
+	}
+	if !lblock.VerifyTransactions(blk, utxoIndex, state, parentBlk, db, forks, chainID) {
+		return ErrTransactionVerifyFailed
+	}
+	return nil
+}
+
+//isSameUTXOs returns true if both lists have the same UTXOs, regardless of their order
+func isSameUTXOs(utxos1 *utxo.UTXOTx, utxos2 *utxo.UTXOTx) bool {
+	if utxos1.Size() != utxos2.Size() {
+		return false
+	}
+	for key, utxo1 := range utxos1.Indices {
+		utxo2, ok := utxos2.Indices[key]
+		if !ok || utxo1.UtxoType != utxo2.UtxoType || utxo1.Contract != utxo2.Contract ||
+			!bytes.Equal(utxo1.PubKeyHash, utxo2.PubKeyHash) || utxo1.Value.Cmp(utxo2.Value) != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,130 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/history"
+	"github.com/dappley/go-dappley/core/state"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/migration"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockchain_VerifyAndReindex(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	assert.Nil(t, bc.Verify(nil))
+
+	//a UTXO removed from the database is found by the verification and restored by the reindex
+	pubKeyHash := account.NewTransactionAccountByAddress(account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")).GetPubKeyHash()
+	blk, err := bc.GetBlockByHeight(3)
+	require.Nil(t, err)
+	utxoKey := (&utxo.UTXO{Txid: blk.GetTransactions()[0].ID, TxIndex: 0}).GetUTXOKey()
+	require.Nil(t, bc.GetDb().Del([]byte(utxoKey)))
+	assert.Equal(t, &IntegrityError{5, ErrUtxoIndexInconsistent}, bc.Verify(nil))
+
+	require.Nil(t, bc.Reindex())
+	assert.Nil(t, bc.Verify(nil))
+	utxos := lutxo.NewUTXOIndex(bc.GetUtxoCache()).GetAllUTXOsByPubKeyHash(pubKeyHash)
+	assert.Equal(t, 6, utxos.Size())
+
+	//the chain keeps growing after the reindex
+	AddBlockToGeneratedBlockchain(bc, 1)
+	assert.EqualValues(t, 6, bc.GetMaxHeight())
+	assert.Nil(t, bc.Verify(nil))
+}
+
+func TestReindexDatabase(t *testing.T) {
+	assert.Nil(t, ReindexDatabase(storage.NewRamStorage(), nil))
+
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	db := bc.GetDb()
+	blk, err := bc.GetBlockByHeight(3)
+	require.Nil(t, err)
+	txid := blk.GetTransactions()[0].ID
+	pubKeyHash := account.NewTransactionAccountByAddress(account.NewAddress("16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")).GetPubKeyHash()
+	numOfEntries := history.GetNumOfEntries(pubKeyHash, db)
+	stateRoot, err := state.GetStateRoot(db, blk.GetHash())
+	require.Nil(t, err)
+
+	//a database of the previous schema version without the indexes added since
+	require.Nil(t, migration.SetVersion(db, 2))
+	require.Nil(t, transaction.DeleteTxIndex(txid, db))
+	require.Nil(t, history.DeleteEntries(pubKeyHash, db))
+	require.Nil(t, state.SaveStateRoot(db, blk.GetHash(), []byte("stale state root")))
+
+	require.Nil(t, ReindexDatabase(db, nil))
+	txIndex, err := transaction.GetTxIndex(txid, db)
+	require.Nil(t, err)
+	assert.EqualValues(t, blk.GetHash(), txIndex.BlockId)
+	assert.Equal(t, numOfEntries, history.GetNumOfEntries(pubKeyHash, db))
+	reindexedRoot, err := state.GetStateRoot(db, blk.GetHash())
+	require.Nil(t, err)
+	assert.Equal(t, stateRoot, reindexedRoot)
+}
+
+func TestBlockchain_VerifyBlockHash(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	blk, err := bc.GetBlockByHeight(3)
+	require.Nil(t, err)
+
+	//the block stored under its hash is changed
+	tampered := block.NewBlockWithRawInfo(blk.GetHash(), blk.GetPrevHash(), blk.GetNonce()+1, blk.GetTimestamp(), blk.GetHeight(), blk.GetTransactions())
+	require.Nil(t, bc.GetDb().Put(blk.GetHash(), tampered.Serialize()))
+	assert.Equal(t, &IntegrityError{3, ErrBlockHashInvalid}, bc.Verify(nil))
+	assert.Equal(t, &IntegrityError{3, ErrBlockHashInvalid}, bc.Reindex())
+
+	//the failed reindex leaves the live indexes and no scratch area behind
+	utxoKey := (&utxo.UTXO{Txid: blk.GetTransactions()[0].ID, TxIndex: 0}).GetUTXOKey()
+	_, err = bc.GetDb().Get([]byte(utxoKey))
+	assert.Nil(t, err)
+	it := bc.GetDb().NewPrefixIterator(reindexScratchPrefix)
+	assert.False(t, it.Next())
+	it.Release()
+}
+
+func TestBlockchain_FinishReindex(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	blk, err := bc.GetBlockByHeight(3)
+	require.Nil(t, err)
+	utxoKey := (&utxo.UTXO{Txid: blk.GetTransactions()[0].ID, TxIndex: 0}).GetUTXOKey()
+	require.Nil(t, bc.GetDb().Del([]byte(utxoKey)))
+
+	//a reindex that stopped after the live indexes were removed is completed from the scratch area
+	require.Nil(t, bc.replayIntoScratch(bc.GetMaxHeight()))
+	require.Nil(t, bc.GetDb().Put(reindexPhaseKey, []byte(reindexPhaseClear)))
+	_, err = bc.clearIndexes(bc.GetMaxHeight())
+	require.Nil(t, err)
+	require.Nil(t, bc.GetDb().Put(reindexPhaseKey, []byte(reindexPhaseMove)))
+
+	require.Nil(t, bc.finishReindex())
+	assert.Nil(t, bc.Verify(nil))
+	_, err = bc.GetDb().Get(reindexPhaseKey)
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+	it := bc.GetDb().NewPrefixIterator(reindexScratchPrefix)
+	assert.False(t, it.Next())
+	it.Release()
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/dappley/go-dappley/common/pubsub"
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/dappley/go-dappley/storage"
 	"github.com/golang/protobuf/proto"
 )
 
@@ -16,6 +17,39 @@ type Consensus interface {
 	Validate(*block.Block) bool
 }
 
+// ForkVerifier checks the producers of the blocks of a fork against the dynasties elected on the fork, before the chain
+// is rolled back to the fork parent
+type ForkVerifier interface {
+	VerifyFork(forkBlks []*block.Block) error
+}
+
+type TimestampVerifier interface {
+	VerifyTimestamp(blk *block.Block, parentBlk *block.Block) bool
+}
+
+type EvidenceVerifier interface {
+	VerifyEvidence(*block.Evidence) error
+}
+
+// ElectionCheckpointer persists the tally of the votes in the blocks up to a height, so that the election no longer
+// needs the bodies of those blocks
+type ElectionCheckpointer interface {
+	SaveCheckpoint(db storage.Storage, height uint64) error
+}
+
+// HeaderVerifier checks that the header of a block is signed by its producer
+type HeaderVerifier interface {
+	Verify(header *block.SignedHeader) error
+}
+
+type BlockFinalizer interface {
+	OnBlockAdded(*block.Block)
+}
+
+type FinalityGadget interface {
+	AddPreCommit(*block.PreCommit) (bool, error)
+}
+
 type LIBPolicy interface {
 	GetMinConfirmationNum() int
 	IsBypassingLibCheck() bool
@@ -0,0 +1,319 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/logic/lblockchain/pb/genesis.proto
+
+package lblockchainpb
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type GenesisData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId   uint64            `protobuf:"varint,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Producers []string          `protobuf:"bytes,2,rep,name=producers,proto3" json:"producers,omitempty"`
+	Storage   []*GenesisStorage `protobuf:"bytes,3,rep,name=storage,proto3" json:"storage,omitempty"`
+}
+
+func (x *GenesisData) Reset() {
+	*x = GenesisData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisData) ProtoMessage() {}
+
+func (x *GenesisData) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisData.ProtoReflect.Descriptor instead.
+func (*GenesisData) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisData) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
+func (x *GenesisData) GetProducers() []string {
+	if x != nil {
+		return x.Producers
+	}
+	return nil
+}
+
+func (x *GenesisData) GetStorage() []*GenesisStorage {
+	if x != nil {
+		return x.Storage
+	}
+	return nil
+}
+
+type GenesisStorage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Items   []*GenesisStorageItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *GenesisStorage) Reset() {
+	*x = GenesisStorage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisStorage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisStorage) ProtoMessage() {}
+
+func (x *GenesisStorage) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisStorage.ProtoReflect.Descriptor instead.
+func (*GenesisStorage) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GenesisStorage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GenesisStorage) GetItems() []*GenesisStorageItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GenesisStorageItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key   string `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *GenesisStorageItem) Reset() {
+	*x = GenesisStorageItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisStorageItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisStorageItem) ProtoMessage() {}
+
+func (x *GenesisStorageItem) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenesisStorageItem.ProtoReflect.Descriptor instead.
+func (*GenesisStorageItem) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GenesisStorageItem) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *GenesisStorageItem) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+var File_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDesc = []byte{
+	0x0a, 0x40, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x2f, 0x70, 0x62, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0d, 0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x70,
+	0x62, 0x22, 0x7f, 0x0a, 0x0b, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x19, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x70,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x52, 0x07, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x22, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e,
+	0x6c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x3c, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x73,
+	0x69, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescData = file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_goTypes = []interface{}{
+	(*GenesisData)(nil),        // 0: lblockchainpb.GenesisData
+	(*GenesisStorage)(nil),     // 1: lblockchainpb.GenesisStorage
+	(*GenesisStorageItem)(nil), // 2: lblockchainpb.GenesisStorageItem
+}
+var file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_depIdxs = []int32{
+	1, // 0: lblockchainpb.GenesisData.storage:type_name -> lblockchainpb.GenesisStorage
+	2, // 1: lblockchainpb.GenesisStorage.items:type_name -> lblockchainpb.GenesisStorageItem
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_init() }
+func file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_init() {
+	if File_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisStorage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenesisStorageItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto = out.File
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_logic_lblockchain_pb_genesis_proto_depIdxs = nil
+}
@@ -0,0 +1,153 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"encoding/binary"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+//maxPruneBlocksPerRound is the maximum number of blocks pruned after a block is added, so that enabling pruning on a
+//long chain does not stall the node
+const maxPruneBlocksPerRound = 100
+
+var prunedHeightKey = []byte("prunedHeight")
+var prunedHeaderPrefix = []byte("prunedheader_")
+
+//SetPruneDepth enables the pruning of the block bodies and state changelogs that are more than depth blocks below the
+//LIB. The signed headers, the UTXO set and the current state are kept. A depth of 0 keeps all blocks. The nodes of the
+//state tries are not pruned, since they are shared by the state roots of many blocks and are not reference counted
+func (bc *Blockchain) SetPruneDepth(depth uint64) {
+	bc.pruneDepth = depth
+}
+
+//SetElection sets the election that tallies the votes in the block bodies. Its tally is checkpointed before the bodies
+//are pruned
+func (bc *Blockchain) SetElection(election ElectionCheckpointer) {
+	bc.election = election
+}
+
+//GetPrunedHeight returns the height of the last block whose body has been pruned, or 0 if no block has been pruned
+func (bc *Blockchain) GetPrunedHeight() uint64 {
+	rawBytes, err := bc.db.Get(prunedHeightKey)
+	if err != nil || len(rawBytes) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(rawBytes)
+}
+
+//IsPruned returns true if the body of the block at the input height has been pruned. The genesis block is never pruned
+func (bc *Blockchain) IsPruned(height uint64) bool {
+	return height > 0 && height <= bc.GetPrunedHeight()
+}
+
+//GetSignedHeader returns the signed header of the block on the chain, including the header of a pruned block
+func (bc *Blockchain) GetSignedHeader(blk *block.Block) *block.SignedHeader {
+	if bc.IsPruned(blk.GetHeight()) {
+		if header, err := bc.getPrunedHeader(blk.GetHash()); err == nil {
+			return header
+		}
+	}
+	return lblock.NewSignedHeader(blk, bc.forks)
+}
+
+//prune removes the bodies and state changelogs of the blocks above the pruned height that are more than the prune depth
+//below the LIB. The tally of the election is checkpointed at the last pruned block first, so that the votes in the
+//pruned bodies are not needed after a restart. The signed headers and the pruned height are written in one batch before
+//anything is deleted, so that a node stopping in between only leaves data behind
+func (bc *Blockchain) prune() error {
+	if bc.pruneDepth == 0 {
+		return nil
+	}
+	libHeight := bc.GetLIBHeight()
+	if libHeight <= bc.pruneDepth {
+		return nil
+	}
+	startHeight := bc.GetPrunedHeight() + 1
+	endHeight := libHeight - bc.pruneDepth
+	if endHeight >= startHeight+maxPruneBlocksPerRound {
+		endHeight = startHeight + maxPruneBlocksPerRound - 1
+	}
+	if startHeight > endHeight {
+		return nil
+	}
+	if bc.election != nil {
+		if err := bc.election.SaveCheckpoint(bc.db, endHeight); err != nil {
+			return err
+		}
+	}
+
+	headerBatch := bc.db.NewBatch()
+	deleteBatch := bc.db.NewBatch()
+	for height := startHeight; height <= endHeight; height++ {
+		blk, err := bc.GetBlockByHeight(height)
+		if err != nil {
+			return err
+		}
+		rawBytes, err := proto.Marshal(lblock.NewSignedHeader(blk, bc.forks).ToProto())
+		if err != nil {
+			return err
+		}
+		headerBatch.Put(getPrunedHeaderKey(blk.GetHash()), rawBytes)
+		deleteBatch.Del(blk.GetHash())
+		if err := scState.DeleteLog(deleteBatch, blk.GetHash()); err != nil {
+			return err
+		}
+	}
+	headerBatch.Put(prunedHeightKey, util.UintToHex(endHeight))
+	if err := headerBatch.Write(); err != nil {
+		return err
+	}
+	if err := deleteBatch.Write(); err != nil {
+		return err
+	}
+
+	logger.WithFields(logger.Fields{
+		"start_height": startHeight,
+		"end_height":   endHeight,
+		"lib_height":   libHeight,
+	}).Info("Blockchain: pruned the blocks below the LIB.")
+	return nil
+}
+
+func (bc *Blockchain) getPrunedHeader(hash hash.Hash) (*block.SignedHeader, error) {
+	rawBytes, err := bc.db.Get(getPrunedHeaderKey(hash))
+	if err != nil {
+		return nil, err
+	}
+	headerPb := &blockpb.SignedHeader{}
+	if err := proto.Unmarshal(rawBytes, headerPb); err != nil {
+		return nil, err
+	}
+	header := &block.SignedHeader{}
+	header.FromProto(headerPb)
+	return header, nil
+}
+
+func getPrunedHeaderKey(hash hash.Hash) []byte {
+	return append(append([]byte{}, prunedHeaderPrefix...), hash...)
+}
@@ -0,0 +1,116 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lblockchain
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeCheckpointer struct {
+	heights []uint64
+	err     error
+}
+
+func (checkpointer *fakeCheckpointer) SaveCheckpoint(db storage.Storage, height uint64) error {
+	if checkpointer.err != nil {
+		return checkpointer.err
+	}
+	checkpointer.heights = append(checkpointer.heights, height)
+	return nil
+}
+
+func TestBlockchain_Prune(t *testing.T) {
+	//the LIB is 6 blocks below the tail
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	bc.SetPruneDepth(2)
+	var blks []*block.Block
+	for height := uint64(0); height <= 5; height++ {
+		blk, err := bc.GetBlockByHeight(height)
+		require.Nil(t, err)
+		blks = append(blks, blk)
+	}
+	assert.EqualValues(t, 0, bc.GetPrunedHeight())
+	//coinbase-only blocks do not change the contract storage, so the changelogs are written here
+	for _, blk := range blks {
+		require.Nil(t, bc.GetDb().Put([]byte("scLog"+blk.GetHash().String()), []byte{}))
+	}
+
+	AddBlockToGeneratedBlockchain(bc, 5)
+	assert.EqualValues(t, 4, bc.GetLIBHeight())
+	assert.EqualValues(t, 2, bc.GetPrunedHeight())
+	assert.False(t, bc.IsPruned(0))
+	assert.True(t, bc.IsPruned(2))
+	assert.False(t, bc.IsPruned(3))
+
+	//the headers of the pruned blocks are kept
+	for height, blk := range blks {
+		prunedBlk, err := bc.GetBlockByHeight(uint64(height))
+		require.Nil(t, err)
+		assert.Equal(t, blk.GetHash(), prunedBlk.GetHash())
+		assert.Equal(t, blk.GetPrevHash(), prunedBlk.GetPrevHash())
+		assert.Equal(t, lblock.NewSignedHeader(blk, nil), bc.GetSignedHeader(prunedBlk))
+		if bc.IsPruned(uint64(height)) {
+			assert.Empty(t, prunedBlk.GetTransactions())
+		} else {
+			assert.Equal(t, len(blk.GetTransactions()), len(prunedBlk.GetTransactions()))
+		}
+	}
+
+	//the transactions and state changelogs of the pruned blocks are removed
+	_, _, _, err := bc.GetTransactionByID(blks[1].GetTransactions()[0].ID)
+	assert.Equal(t, ErrBlockPruned, err)
+	_, err = bc.GetDb().Get([]byte("scLog" + blks[1].GetHash().String()))
+	assert.NotNil(t, err)
+	_, err = bc.GetDb().Get([]byte("scLog" + blks[3].GetHash().String()))
+	assert.Nil(t, err)
+	tx, _, _, err := bc.GetTransactionByID(blks[3].GetTransactions()[0].ID)
+	assert.Nil(t, err)
+	assert.Equal(t, blks[3].GetTransactions()[0].ID, tx.ID)
+
+	//the pruned height follows the LIB
+	AddBlockToGeneratedBlockchain(bc, 1)
+	assert.EqualValues(t, 3, bc.GetPrunedHeight())
+}
+
+func TestBlockchain_PruneCheckpointsElection(t *testing.T) {
+	bc := GenerateMockBlockchainWithCoinbaseTxOnly(5)
+	bc.SetPruneDepth(2)
+	election := &fakeCheckpointer{err: errors.New("checkpoint failed")}
+	bc.SetElection(election)
+
+	//the blocks are not pruned if the tally of their votes cannot be checkpointed
+	AddBlockToGeneratedBlockchain(bc, 5)
+	assert.EqualValues(t, 0, bc.GetPrunedHeight())
+	blk, err := bc.GetBlockByHeight(1)
+	require.Nil(t, err)
+	assert.NotEmpty(t, blk.GetTransactions())
+
+	//the tally is checkpointed at the last pruned block before the bodies are removed
+	election.err = nil
+	AddBlockToGeneratedBlockchain(bc, 1)
+	assert.EqualValues(t, 3, bc.GetPrunedHeight())
+	assert.Equal(t, []uint64{3}, election.heights)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/dappley/go-dappley/logic/lutxo"
 	"github.com/dappley/go-dappley/logic/transactionpool"
 	"github.com/dappley/go-dappley/storage"
+	logger "github.com/sirupsen/logrus"
 )
 
 func PrepareBlockContext(bc *Blockchain, blk *block.Block) *BlockContext {
@@ -22,6 +23,15 @@ func PrepareBlockContext(bc *Blockchain, blk *block.Block) *BlockContext {
 	return &ctx
 }
 
+//setCoinbaseOnlyStateRoot sets the state root of a block without smart contract transactions on top of its parent
+func setCoinbaseOnlyStateRoot(bc *Blockchain, blk *block.Block, parentBlk *block.Block) {
+	stateRoot, err := lblock.CalculateStateRoot(blk, parentBlk, scState.NewScState(), scState.NewScState(), storage.NewOverlay(bc.GetDb()))
+	if err != nil {
+		logger.WithError(err).Panic("Blockchain: cannot calculate the state root of the mock block.")
+	}
+	blk.SetStateRoot(stateRoot)
+}
+
 func GenerateMockBlockchainWithCoinbaseTxOnly(size int) *Blockchain {
 	//create a new block chain
 	s := storage.NewRamStorage()
@@ -36,7 +46,8 @@ func GenerateMockBlockchainWithCoinbaseTxOnly(size int) *Blockchain {
 		tailBlk, _ := bc.GetTailBlock()
 		cbtx := ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
 		b := block.NewBlock([]*transaction.Transaction{&cbtx}, tailBlk, "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
-		b.SetHash(lblock.CalculateHash(b))
+		setCoinbaseOnlyStateRoot(bc, b, tailBlk)
+		b.SetHash(lblock.CalculateHash(b, nil))
 		bc.AddBlockContextToTail(PrepareBlockContext(bc, b))
 	}
 	return bc
@@ -48,7 +59,8 @@ func AddBlockToGeneratedBlockchain(bc *Blockchain, numOfBlks int) {
 		tailBlk, _ := bc.GetTailBlock()
 		cbtx := ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
 		b := block.NewBlock([]*transaction.Transaction{&cbtx}, tailBlk, "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj")
-		b.SetHash(lblock.CalculateHash(b))
+		setCoinbaseOnlyStateRoot(bc, b, tailBlk)
+		b.SetHash(lblock.CalculateHash(b, nil))
 		bc.AddBlockContextToTail(PrepareBlockContext(bc, b))
 	}
 }
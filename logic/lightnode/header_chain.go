@@ -0,0 +1,293 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightnode
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"sync"
+
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	headerKeyPrefix = "lightheader:"
+	headerTailKey   = "lightheadertail"
+)
+
+var (
+	ErrHeaderNotFound       = errors.New("header chain: header not found")
+	ErrHeaderChainEmpty     = errors.New("header chain: the genesis header is not synced")
+	ErrHeadersNotLinked     = errors.New("header chain: headers do not link to the chain")
+	ErrHeaderTimestampOrder = errors.New("header chain: header is not stamped later than its parent")
+	ErrHeadersNotLonger     = errors.New("header chain: fork is not longer than the chain")
+	ErrGenesisMismatch      = errors.New("header chain: genesis header does not match the local genesis block")
+)
+
+//HeaderVerifier checks the producer of a header whose ancestors are on the header chain
+type HeaderVerifier interface {
+	Verify(header *block.SignedHeader) error
+}
+
+// HeaderChain keeps the signed headers of the main chain by height. The local genesis block is the trust anchor of
+// the chain, and every later header has to link to its parent and be signed by the producer of its time slot.
+type HeaderChain struct {
+	db         storage.Storage
+	genesis    *block.Block
+	verifier   HeaderVerifier
+	tailHeight uint64
+	isEmpty    bool
+	pending    map[uint64]*block.SignedHeader
+	mutex      sync.RWMutex
+	addMutex   sync.Mutex
+}
+
+//NewHeaderChain returns the header chain saved in the database, which starts at the input genesis block
+func NewHeaderChain(db storage.Storage, genesis *block.Block) *HeaderChain {
+	chain := &HeaderChain{db: db, genesis: genesis, isEmpty: true}
+	rawBytes, err := db.Get([]byte(headerTailKey))
+	if err == nil && len(rawBytes) == 8 {
+		chain.tailHeight = binary.BigEndian.Uint64(rawBytes)
+		chain.isEmpty = false
+	}
+	return chain
+}
+
+//SetVerifier sets the verifier of the headers added to the chain
+func (chain *HeaderChain) SetVerifier(verifier HeaderVerifier) {
+	chain.verifier = verifier
+}
+
+//IsEmpty returns true if the genesis header is not on the chain yet
+func (chain *HeaderChain) IsEmpty() bool {
+	chain.mutex.RLock()
+	defer chain.mutex.RUnlock()
+	return chain.isEmpty
+}
+
+//GetMaxHeight returns the height of the tail header
+func (chain *HeaderChain) GetMaxHeight() uint64 {
+	chain.mutex.RLock()
+	defer chain.mutex.RUnlock()
+	return chain.tailHeight
+}
+
+//GetTailHeader returns the header at the tail of the chain
+func (chain *HeaderChain) GetTailHeader() (*block.SignedHeader, error) {
+	chain.mutex.RLock()
+	defer chain.mutex.RUnlock()
+	if chain.isEmpty {
+		return nil, ErrHeaderChainEmpty
+	}
+	return chain.getHeader(chain.tailHeight)
+}
+
+//GetHeader returns the header at the input height
+func (chain *HeaderChain) GetHeader(height uint64) (*block.SignedHeader, error) {
+	chain.mutex.RLock()
+	defer chain.mutex.RUnlock()
+	if chain.isEmpty || height > chain.tailHeight {
+		return nil, ErrHeaderNotFound
+	}
+	return chain.getHeader(height)
+}
+
+//GetBlockByHeight returns a block without transactions for the header at the input height. While headers are being
+//added, it also returns the headers that are verified but not saved yet, so that the verifier reads their ancestors
+func (chain *HeaderChain) GetBlockByHeight(height uint64) (*block.Block, error) {
+	chain.mutex.RLock()
+	header, ok := chain.pending[height]
+	chain.mutex.RUnlock()
+	if !ok {
+		var err error
+		header, err = chain.GetHeader(height)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return block.NewHeaderOnlyBlock(header), nil
+}
+
+//AddHeaders verifies the consecutive headers and puts them on the chain. Headers that replace a fork of the chain
+//are only accepted if the new fork is longer. The first header at height 0 starts an empty chain
+func (chain *HeaderChain) AddHeaders(headers []*block.SignedHeader) error {
+	chain.addMutex.Lock()
+	defer chain.addMutex.Unlock()
+
+	chain.mutex.RLock()
+	//skip the headers that are on the chain already
+	for len(headers) > 0 && chain.isOnChain(headers[0]) {
+		headers = headers[1:]
+	}
+	err := chain.checkRange(headers)
+	chain.mutex.RUnlock()
+	if err != nil || len(headers) == 0 {
+		return err
+	}
+
+	defer chain.setPending(nil)
+	chain.setPending(make(map[uint64]*block.SignedHeader))
+	for i, header := range headers {
+		if i > 0 && header.Height != headers[i-1].Height+1 {
+			return ErrHeadersNotLinked
+		}
+		if err := chain.verifyHeader(header); err != nil {
+			return err
+		}
+		chain.mutex.Lock()
+		chain.pending[header.Height] = header
+		chain.mutex.Unlock()
+	}
+
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+	return chain.save(headers[0].Height, headers[len(headers)-1].Height)
+}
+
+//checkRange checks that the headers start at most one height above the tail and end above the tail
+func (chain *HeaderChain) checkRange(headers []*block.SignedHeader) error {
+	if len(headers) == 0 {
+		return nil
+	}
+	first := headers[0]
+	last := headers[len(headers)-1]
+	if chain.isEmpty {
+		if first.Height != 0 {
+			return ErrHeaderChainEmpty
+		}
+		return nil
+	}
+	if first.Height == 0 || first.Height > chain.tailHeight+1 {
+		return ErrHeadersNotLinked
+	}
+	if last.Height <= chain.tailHeight {
+		return ErrHeadersNotLonger
+	}
+	return nil
+}
+
+//verifyHeader checks that the header links to its parent and is signed by the producer of its time slot
+func (chain *HeaderChain) verifyHeader(header *block.SignedHeader) error {
+	if header.Height == 0 {
+		//the genesis header is not signed and is trusted if it is the local genesis block
+		if !bytes.Equal(header.Hash(), chain.genesis.GetHash()) {
+			return ErrGenesisMismatch
+		}
+		return nil
+	}
+	chain.mutex.RLock()
+	parent, err := chain.getParent(header)
+	chain.mutex.RUnlock()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(header.PrevHash, parent.Hash()) {
+		return ErrHeadersNotLinked
+	}
+	if header.Timestamp <= parent.Timestamp {
+		return ErrHeaderTimestampOrder
+	}
+	if chain.verifier == nil {
+		return nil
+	}
+	return chain.verifier.Verify(header)
+}
+
+func (chain *HeaderChain) setPending(pending map[uint64]*block.SignedHeader) {
+	chain.mutex.Lock()
+	defer chain.mutex.Unlock()
+	chain.pending = pending
+}
+
+//isOnChain returns true if the header is already on the chain at its height
+func (chain *HeaderChain) isOnChain(header *block.SignedHeader) bool {
+	if chain.isEmpty || header.Height > chain.tailHeight {
+		return false
+	}
+	stored, err := chain.getHeader(header.Height)
+	return err == nil && bytes.Equal(stored.Hash(), header.Hash())
+}
+
+//getParent returns the parent of the header from the pending headers or from the chain
+func (chain *HeaderChain) getParent(header *block.SignedHeader) (*block.SignedHeader, error) {
+	if parent, ok := chain.pending[header.Height-1]; ok {
+		return parent, nil
+	}
+	if chain.isEmpty || header.Height-1 > chain.tailHeight {
+		return nil, ErrHeadersNotLinked
+	}
+	return chain.getHeader(header.Height - 1)
+}
+
+//save writes the pending headers from the start to the end height and removes the headers of the replaced fork.
+//The tail is written after the new headers, so that the chain stays consistent if the node stops in between
+func (chain *HeaderChain) save(startHeight, endHeight uint64) error {
+	for height := startHeight; height <= endHeight; height++ {
+		rawBytes, err := proto.Marshal(chain.pending[height].ToProto())
+		if err != nil {
+			return err
+		}
+		if err := chain.db.Put(getHeaderKey(height), rawBytes); err != nil {
+			return err
+		}
+	}
+	if err := chain.db.Put([]byte(headerTailKey), util.UintToHex(endHeight)); err != nil {
+		return err
+	}
+	if !chain.isEmpty {
+		for height := endHeight + 1; height <= chain.tailHeight; height++ {
+			chain.db.Del(getHeaderKey(height))
+		}
+	}
+
+	if !chain.isEmpty && startHeight <= chain.tailHeight {
+		logger.WithFields(logger.Fields{
+			"fork_height": startHeight,
+			"old_tail":    chain.tailHeight,
+			"new_tail":    endHeight,
+		}).Info("HeaderChain: switched to a longer fork.")
+	}
+	chain.tailHeight = endHeight
+	chain.isEmpty = false
+	return nil
+}
+
+func (chain *HeaderChain) getHeader(height uint64) (*block.SignedHeader, error) {
+	rawBytes, err := chain.db.Get(getHeaderKey(height))
+	if err != nil {
+		return nil, ErrHeaderNotFound
+	}
+	headerPb := &blockpb.SignedHeader{}
+	if err := proto.Unmarshal(rawBytes, headerPb); err != nil {
+		return nil, err
+	}
+	header := &block.SignedHeader{}
+	header.FromProto(headerPb)
+	return header, nil
+}
+
+func getHeaderKey(height uint64) []byte {
+	return append([]byte(headerKeyPrefix), util.UintToHex(height)...)
+}
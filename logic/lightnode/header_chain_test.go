@@ -0,0 +1,129 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightnode
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeVerifier struct {
+	rejectedHeight uint64
+}
+
+func (verifier *fakeVerifier) Verify(header *block.SignedHeader) error {
+	if header.Height == verifier.rejectedHeight {
+		return errors.New("rejected")
+	}
+	return nil
+}
+
+//generateHeaders returns the headers on top of the parent, stamped one slot apart and tagged with the fork
+func generateHeaders(parent *block.SignedHeader, num int, fork string) []*block.SignedHeader {
+	headers := []*block.SignedHeader{}
+	for i := 0; i < num; i++ {
+		header := &block.SignedHeader{
+			TxsHash:   []byte(fork),
+			Timestamp: parent.Timestamp + 5,
+			Height:    parent.Height + 1,
+			PrevHash:  parent.Hash(),
+		}
+		headers = append(headers, header)
+		parent = header
+	}
+	return headers
+}
+
+func TestHeaderChain_AddHeaders(t *testing.T) {
+	db := storage.NewRamStorage()
+	genesis := &block.SignedHeader{Height: 0, Timestamp: 100}
+	chain := NewHeaderChain(db, block.NewHeaderOnlyBlock(genesis))
+	headers := generateHeaders(genesis, 5, "main")
+
+	//an empty chain starts at the local genesis block
+	assert.True(t, chain.IsEmpty())
+	assert.Equal(t, ErrHeaderChainEmpty, chain.AddHeaders(headers))
+	otherGenesis := &block.SignedHeader{Height: 0, Timestamp: 200}
+	assert.Equal(t, ErrGenesisMismatch, chain.AddHeaders(append([]*block.SignedHeader{otherGenesis}, generateHeaders(otherGenesis, 3, "main")...)))
+	assert.True(t, chain.IsEmpty())
+	assert.Nil(t, chain.AddHeaders(append([]*block.SignedHeader{genesis}, headers[:3]...)))
+	assert.EqualValues(t, 3, chain.GetMaxHeight())
+
+	//headers on the chain are skipped
+	assert.Nil(t, chain.AddHeaders(headers[1:]))
+	assert.EqualValues(t, 5, chain.GetMaxHeight())
+	tail, err := chain.GetTailHeader()
+	assert.Nil(t, err)
+	assert.Equal(t, headers[4].Hash(), tail.Hash())
+
+	//the chain is loaded from the database
+	chain = NewHeaderChain(db, block.NewHeaderOnlyBlock(genesis))
+	assert.False(t, chain.IsEmpty())
+	assert.EqualValues(t, 5, chain.GetMaxHeight())
+	blk, err := chain.GetBlockByHeight(2)
+	assert.Nil(t, err)
+	assert.Equal(t, headers[1].Hash(), blk.GetHash())
+	_, err = chain.GetHeader(6)
+	assert.Equal(t, ErrHeaderNotFound, err)
+
+	//headers have to link to the chain
+	next := generateHeaders(headers[4], 2, "main")
+	assert.Equal(t, ErrHeadersNotLinked, chain.AddHeaders(next[1:]))
+	next[1].PrevHash = []byte("wrong")
+	assert.Equal(t, ErrHeadersNotLinked, chain.AddHeaders(next))
+	next = generateHeaders(headers[4], 1, "main")
+	next[0].Timestamp = headers[4].Timestamp
+	assert.Equal(t, ErrHeaderTimestampOrder, chain.AddHeaders(next))
+	assert.EqualValues(t, 5, chain.GetMaxHeight())
+}
+
+func TestHeaderChain_Fork(t *testing.T) {
+	genesis := &block.SignedHeader{Height: 0, Timestamp: 100}
+	chain := NewHeaderChain(storage.NewRamStorage(), block.NewHeaderOnlyBlock(genesis))
+	headers := generateHeaders(genesis, 5, "main")
+	assert.Nil(t, chain.AddHeaders(append([]*block.SignedHeader{genesis}, headers...)))
+
+	//a fork that is not longer than the chain is rejected
+	fork := generateHeaders(headers[1], 3, "fork")
+	assert.Equal(t, ErrHeadersNotLonger, chain.AddHeaders(fork))
+
+	//the fork is not added if one of its headers is invalid
+	fork = generateHeaders(headers[1], 6, "fork")
+	verifier := &fakeVerifier{rejectedHeight: 7}
+	chain.SetVerifier(verifier)
+	assert.NotNil(t, chain.AddHeaders(fork))
+	tail, err := chain.GetTailHeader()
+	assert.Nil(t, err)
+	assert.Equal(t, headers[4].Hash(), tail.Hash())
+
+	//a longer fork replaces the chain
+	verifier.rejectedHeight = 0
+	assert.Nil(t, chain.AddHeaders(fork[:4]))
+	assert.EqualValues(t, 6, chain.GetMaxHeight())
+	header, err := chain.GetHeader(3)
+	assert.Nil(t, err)
+	assert.Equal(t, fork[0].Hash(), header.Hash())
+	header, err = chain.GetHeader(2)
+	assert.Nil(t, err)
+	assert.Equal(t, headers[1].Hash(), header.Hash())
+}
@@ -0,0 +1,13 @@
+package lightnode
+
+import (
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	"github.com/golang/protobuf/proto"
+)
+
+type NetService interface {
+	GetPeers() []networkmodel.PeerInfo
+	UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo)
+	Listen(subscriber pubsub.Subscriber)
+}
@@ -0,0 +1,575 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightnode
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/log"
+	"github.com/dappley/go-dappley/common/merkle"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/common/trie"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/state"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
+	"github.com/hashicorp/golang-lru"
+	"github.com/libp2p/go-libp2p-core/peer"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	GetHeadersRequest             = "GetHeadersRequest"
+	GetHeadersResponse            = "GetHeadersResponse"
+	GetBlocksByHeightRequest      = "GetBlocksByHeightRequest"
+	GetBlocksByHeightResponse     = "GetBlocksByHeightResponse"
+	GetTransactionProofRequest    = "GetTransactionProofRequest"
+	GetTransactionProofResponse   = "GetTransactionProofResponse"
+	GetUtxoProofRequest           = "GetUtxoProofRequest"
+	GetUtxoProofResponse          = "GetUtxoProofResponse"
+	GetContractStateProofRequest  = "GetContractStateProofRequest"
+	GetContractStateProofResponse = "GetContractStateProofResponse"
+
+	SyncInterval   time.Duration = 5 * time.Second
+	RequestTimeout time.Duration = 5 * time.Second
+
+	maxGetHeadersNum = 100
+	maxGetBlocksNum  = 20
+	blockCacheSize   = 128
+	//headers below the tail are requested again, so that a longer fork of the recent blocks is found
+	syncOverlapNum = 10
+)
+
+var (
+	ErrNoPeer          = errors.New("light node: no peer is connected")
+	ErrRequestTimeout  = errors.New("light node: the peer did not respond in time")
+	ErrNotFound        = errors.New("light node: not found on the peer")
+	ErrHeaderNotSynced = errors.New("light node: the header of the proof is not synced yet")
+	ErrProofInvalid    = errors.New("light node: the proof does not match the synced header")
+	ErrInvalidAddress  = errors.New("light node: invalid address")
+	ErrBlockInvalid    = errors.New("light node: the block does not match the synced header")
+)
+
+var (
+	lightNodeSubscribedTopics = []string{
+		GetHeadersResponse,
+		GetBlocksByHeightResponse,
+		GetTransactionProofResponse,
+		GetUtxoProofResponse,
+		GetContractStateProofResponse,
+	}
+)
+
+//pendingRequest is an on-demand request waiting for the response of a peer
+type pendingRequest struct {
+	command    string
+	key        string
+	peerId     peer.ID
+	responseCh chan proto.Message
+}
+
+// LightNode syncs the signed block headers from its peers and verifies them without executing the blocks. The
+// transactions, UTXOs and contract states are requested from full peers on demand, and are only returned if their
+// proofs lead to a synced header. The blocks with their transactions are only requested for the election of the
+// producers, which tallies the votes in them.
+type LightNode struct {
+	chain        *HeaderChain
+	node         NetService
+	forks        *block.Forks
+	blocks       *lru.Cache
+	pending      *pendingRequest
+	mutex        sync.Mutex
+	requestMutex sync.Mutex
+	stopCh       chan bool
+}
+
+//NewLightNode returns a light node that syncs the header chain from the peers of the node
+func NewLightNode(node NetService, chain *HeaderChain) *LightNode {
+	blocks, err := lru.New(blockCacheSize)
+	if err != nil {
+		logger.Panic(err)
+	}
+	lightNode := &LightNode{
+		chain:  chain,
+		node:   node,
+		blocks: blocks,
+		stopCh: make(chan bool, 1),
+	}
+	if node != nil {
+		node.Listen(lightNode)
+	}
+	return lightNode
+}
+
+//SetForks sets the heights from which the rules added after the launch of the network are enforced
+func (lightNode *LightNode) SetForks(forks *block.Forks) {
+	lightNode.forks = forks
+}
+
+//GetHeaderChain returns the headers synced by the light node
+func (lightNode *LightNode) GetHeaderChain() *HeaderChain {
+	return lightNode.chain
+}
+
+//Start syncs the headers from the peers periodically
+func (lightNode *LightNode) Start() {
+	go func() {
+		defer log.CrashHandler()
+
+		ticker := time.NewTicker(SyncInterval)
+		defer ticker.Stop()
+		lightNode.SyncHeaders()
+		for {
+			select {
+			case <-ticker.C:
+				lightNode.SyncHeaders()
+			case <-lightNode.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+//Stop stops syncing the headers
+func (lightNode *LightNode) Stop() {
+	lightNode.stopCh <- true
+}
+
+func (lightNode *LightNode) GetSubscribedTopics() []string {
+	return lightNodeSubscribedTopics
+}
+
+func (lightNode *LightNode) GetTopicHandler(topic string) pubsub.TopicHandler {
+	switch topic {
+	case GetHeadersResponse:
+		return lightNode.GetHeadersResponseHandler
+	case GetBlocksByHeightResponse:
+		return lightNode.GetBlocksByHeightResponseHandler
+	case GetTransactionProofResponse:
+		return lightNode.GetTransactionProofResponseHandler
+	case GetUtxoProofResponse:
+		return lightNode.GetUtxoProofResponseHandler
+	case GetContractStateProofResponse:
+		return lightNode.GetContractStateProofResponseHandler
+	}
+	return nil
+}
+
+//SyncHeaders requests the headers above the tail from all peers
+func (lightNode *LightNode) SyncHeaders() {
+	for _, peerInfo := range lightNode.node.GetPeers() {
+		lightNode.SendGetHeadersRequest(lightNode.getSyncStartHeight(), peerInfo)
+	}
+}
+
+//getSyncStartHeight returns the height of the first header requested from the peers
+func (lightNode *LightNode) getSyncStartHeight() uint64 {
+	if lightNode.chain.IsEmpty() {
+		return 0
+	}
+	if tailHeight := lightNode.chain.GetMaxHeight(); tailHeight > syncOverlapNum {
+		return tailHeight + 1 - syncOverlapNum
+	}
+	return 1
+}
+
+func (lightNode *LightNode) SendGetHeadersRequest(startHeight uint64, destination networkmodel.PeerInfo) {
+	request := &networkpb.GetHeaders{StartHeight: startHeight, MaxCount: maxGetHeadersNum}
+	lightNode.node.UnicastHighProrityCommand(GetHeadersRequest, request, destination)
+}
+
+func (lightNode *LightNode) GetHeadersResponseHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnHeaders{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetHeadersResponse",
+		}).Info("LightNode: parse data failed.")
+		return
+	}
+
+	headers := make([]*block.SignedHeader, len(param.GetHeaders()))
+	for i, headerPb := range param.GetHeaders() {
+		headers[i] = &block.SignedHeader{}
+		headers[i].FromProto(headerPb)
+	}
+
+	err := lightNode.chain.AddHeaders(headers)
+	if err == ErrHeadersNotLonger {
+		return
+	}
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"peer_id":      command.GetSource().PeerId,
+			"start_height": param.GetStartHeight(),
+			"num_headers":  len(headers),
+		}).Warn("LightNode: failed to add the headers of the peer.")
+		return
+	}
+
+	//the peer has more headers if the response is full
+	if len(headers) == maxGetHeadersNum {
+		lightNode.SendGetHeadersRequest(lightNode.chain.GetMaxHeight()+1, command.GetSource())
+	}
+}
+
+//GetMaxHeight returns the height of the tail header
+func (lightNode *LightNode) GetMaxHeight() uint64 {
+	return lightNode.chain.GetMaxHeight()
+}
+
+//GetBlockByHeight returns the block with its transactions for the header at the input height, including the headers
+//that are being added. The blocks are requested from the peers in batches and are only returned if they match the
+//synced headers
+func (lightNode *LightNode) GetBlockByHeight(height uint64) (*block.Block, error) {
+	headerBlk, err := lightNode.chain.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if blk, ok := lightNode.blocks.Get(headerBlk.GetHash().String()); ok {
+		return blk.(*block.Block), nil
+	}
+
+	err = lightNode.requestFromPeers(
+		GetBlocksByHeightRequest,
+		strconv.FormatUint(height, 10),
+		&networkpb.GetBlocksByHeight{StartHeight: height, MaxCount: maxGetBlocksNum},
+		func(response proto.Message) error {
+			return lightNode.verifyBlocks(height, response.(*networkpb.ReturnBlocksByHeight))
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if blk, ok := lightNode.blocks.Get(headerBlk.GetHash().String()); ok {
+		return blk.(*block.Block), nil
+	}
+	return nil, ErrBlockInvalid
+}
+
+//GetTransaction returns the transaction with the input id and the height of the block that contains it. The
+//transaction is proven to be in the block by the Merkle root of the synced header
+func (lightNode *LightNode) GetTransaction(txid []byte) (*transaction.Transaction, uint64, error) {
+	var tx *transaction.Transaction
+	var height uint64
+	err := lightNode.requestFromPeers(
+		GetTransactionProofRequest,
+		hex.EncodeToString(txid),
+		&networkpb.GetTransactionProof{Txid: txid},
+		func(response proto.Message) error {
+			var err error
+			tx, height, err = lightNode.verifyTransactionProof(txid, response.(*networkpb.ReturnTransactionProof))
+			return err
+		},
+	)
+	return tx, height, err
+}
+
+//GetUtxos returns the UTXOs of the address in the state of the tail block of a peer, and the height of the block.
+//Every UTXO is proven to be in the state root of the synced header. The proofs cannot show that the peer returned
+//all UTXOs of the address
+func (lightNode *LightNode) GetUtxos(address string) ([]*utxo.UTXO, uint64, error) {
+	acc := account.NewTransactionAccountByAddress(account.NewAddress(address))
+	if !acc.IsValid() {
+		return nil, 0, ErrInvalidAddress
+	}
+
+	var utxos []*utxo.UTXO
+	var height uint64
+	err := lightNode.requestFromPeers(
+		GetUtxoProofRequest,
+		address,
+		&networkpb.GetUtxoProof{Address: address},
+		func(response proto.Message) error {
+			var err error
+			utxos, height, err = lightNode.verifyUtxoProof(acc.GetPubKeyHash(), response.(*networkpb.ReturnUtxoProof))
+			return err
+		},
+	)
+	return utxos, height, err
+}
+
+//GetContractState returns the value of the key in the storage of the contract in the state of the tail block of a
+//peer, and the height of the block. A key that is not in the storage cannot be proven and returns ErrNotFound
+func (lightNode *LightNode) GetContractState(address, key string) (string, uint64, error) {
+	var value string
+	var height uint64
+	err := lightNode.requestFromPeers(
+		GetContractStateProofRequest,
+		address+":"+key,
+		&networkpb.GetContractStateProof{Address: address, Key: key},
+		func(response proto.Message) error {
+			var err error
+			value, height, err = lightNode.verifyContractStateProof(address, key, response.(*networkpb.ReturnContractStateProof))
+			return err
+		},
+	)
+	return value, height, err
+}
+
+//requestFromPeers sends the request to the peers one by one until the response of a peer is verified. It returns
+//the error of the last peer if no response is verified
+func (lightNode *LightNode) requestFromPeers(command, key string, request proto.Message, verify func(proto.Message) error) error {
+	lightNode.requestMutex.Lock()
+	defer lightNode.requestMutex.Unlock()
+
+	err := ErrNoPeer
+	for _, peerInfo := range lightNode.node.GetPeers() {
+		var response proto.Message
+		response, err = lightNode.request(command, key, request, peerInfo)
+		if err == nil {
+			err = verify(response)
+		}
+		if err == nil {
+			return nil
+		}
+		if err == ErrHeaderNotSynced {
+			lightNode.SendGetHeadersRequest(lightNode.getSyncStartHeight(), peerInfo)
+		}
+		logger.WithError(err).WithFields(logger.Fields{
+			"command": command,
+			"key":     key,
+			"peer_id": peerInfo.PeerId,
+		}).Info("LightNode: the response of the peer is rejected.")
+	}
+	return err
+}
+
+//request sends the request to the peer and waits for its response
+func (lightNode *LightNode) request(command, key string, request proto.Message, destination networkmodel.PeerInfo) (proto.Message, error) {
+	pending := &pendingRequest{
+		command:    command,
+		key:        key,
+		peerId:     destination.PeerId,
+		responseCh: make(chan proto.Message, 1),
+	}
+	lightNode.mutex.Lock()
+	lightNode.pending = pending
+	lightNode.mutex.Unlock()
+	defer func() {
+		lightNode.mutex.Lock()
+		lightNode.pending = nil
+		lightNode.mutex.Unlock()
+	}()
+
+	lightNode.node.UnicastHighProrityCommand(command, request, destination)
+
+	timer := time.NewTimer(RequestTimeout)
+	defer timer.Stop()
+	select {
+	case response := <-pending.responseCh:
+		return response, nil
+	case <-timer.C:
+		return nil, ErrRequestTimeout
+	}
+}
+
+//onResponse passes the response to the pending request if it answers the request
+func (lightNode *LightNode) onResponse(command, key string, source peer.ID, response proto.Message) {
+	lightNode.mutex.Lock()
+	defer lightNode.mutex.Unlock()
+
+	pending := lightNode.pending
+	if pending == nil || pending.command != command || pending.key != key || pending.peerId != source {
+		logger.WithFields(logger.Fields{
+			"command": command,
+			"peer_id": source,
+		}).Debug("LightNode: response is not for the pending request.")
+		return
+	}
+	select {
+	case pending.responseCh <- response:
+	default:
+	}
+}
+
+func (lightNode *LightNode) GetBlocksByHeightResponseHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnBlocksByHeight{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlocksByHeightResponse",
+		}).Info("LightNode: parse data failed.")
+		return
+	}
+	lightNode.onResponse(GetBlocksByHeightRequest, strconv.FormatUint(param.GetStartHeight(), 10), command.GetSource().PeerId, param)
+}
+
+func (lightNode *LightNode) GetTransactionProofResponseHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnTransactionProof{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetTransactionProofResponse",
+		}).Info("LightNode: parse data failed.")
+		return
+	}
+	lightNode.onResponse(GetTransactionProofRequest, hex.EncodeToString(param.GetTxid()), command.GetSource().PeerId, param)
+}
+
+func (lightNode *LightNode) GetUtxoProofResponseHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnUtxoProof{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetUtxoProofResponse",
+		}).Info("LightNode: parse data failed.")
+		return
+	}
+	lightNode.onResponse(GetUtxoProofRequest, param.GetAddress(), command.GetSource().PeerId, param)
+}
+
+func (lightNode *LightNode) GetContractStateProofResponseHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.ReturnContractStateProof{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetContractStateProofResponse",
+		}).Info("LightNode: parse data failed.")
+		return
+	}
+	lightNode.onResponse(GetContractStateProofRequest, param.GetAddress()+":"+param.GetKey(), command.GetSource().PeerId, param)
+}
+
+//verifyBlocks caches the blocks of the response whose hashes cover their transactions and match the headers at their
+//heights. The blocks above the headers of the chain are skipped
+func (lightNode *LightNode) verifyBlocks(startHeight uint64, response *networkpb.ReturnBlocksByHeight) error {
+	if len(response.GetBlocks()) == 0 {
+		return ErrNotFound
+	}
+	for i, blkPb := range response.GetBlocks() {
+		height := startHeight + uint64(i)
+		headerBlk, err := lightNode.chain.GetBlockByHeight(height)
+		if err != nil {
+			break
+		}
+		blk := &block.Block{}
+		blk.FromProto(blkPb)
+		if blk.GetHeight() != height || !blk.GetHash().Equals(headerBlk.GetHash()) || !lblock.VerifyHash(blk, lightNode.forks) {
+			return ErrBlockInvalid
+		}
+		lightNode.blocks.Add(blk.GetHash().String(), blk)
+	}
+	return nil
+}
+
+//verifyTransactionProof checks that the Merkle branch of the transaction leads to the root of the synced header
+func (lightNode *LightNode) verifyTransactionProof(txid []byte, response *networkpb.ReturnTransactionProof) (*transaction.Transaction, uint64, error) {
+	if response.GetTransaction() == nil {
+		return nil, 0, ErrNotFound
+	}
+	header, err := lightNode.getHeader(response.GetBlockHeight())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	tx := &transaction.Transaction{}
+	tx.FromProto(response.GetTransaction())
+	if !bytes.Equal(tx.ID, txid) {
+		return nil, 0, ErrProofInvalid
+	}
+
+	branch := make([]hash.Hash, len(response.GetBranch()))
+	for i, siblingHash := range response.GetBranch() {
+		branch[i] = siblingHash
+	}
+	txsHash, err := merkle.CalculateRootFromBranch(tx.Hash(), int(response.GetIndex()), int(response.GetNumTxs()), branch)
+	if err != nil || !bytes.Equal(txsHash, header.TxsHash) {
+		return nil, 0, ErrProofInvalid
+	}
+	return tx, header.Height, nil
+}
+
+//verifyUtxoProof checks that every UTXO belongs to the public key hash and is in the state root of the synced header
+func (lightNode *LightNode) verifyUtxoProof(pubKeyHash account.PubKeyHash, response *networkpb.ReturnUtxoProof) ([]*utxo.UTXO, uint64, error) {
+	header, err := lightNode.getHeader(response.GetBlockHeight())
+	if err != nil {
+		return nil, 0, err
+	}
+
+	utxos := make([]*utxo.UTXO, 0, len(response.GetUtxos()))
+	for _, utxoProof := range response.GetUtxos() {
+		if utxoProof.GetUtxo() == nil {
+			return nil, 0, ErrProofInvalid
+		}
+		u := &utxo.UTXO{}
+		u.FromProto(utxoProof.GetUtxo())
+		if !bytes.Equal(u.PubKeyHash, pubKeyHash) {
+			return nil, 0, ErrProofInvalid
+		}
+		if err := state.VerifyUTXOProof(header.StateRoot, u.Txid, u.TxIndex, &u.TXOutput, toMerkleProof(utxoProof.GetProof())); err != nil {
+			return nil, 0, ErrProofInvalid
+		}
+		utxos = append(utxos, u)
+	}
+	return utxos, header.Height, nil
+}
+
+//verifyContractStateProof checks that the value of the key is in the state root of the synced header
+func (lightNode *LightNode) verifyContractStateProof(address, key string, response *networkpb.ReturnContractStateProof) (string, uint64, error) {
+	if response.GetValue() == "" {
+		return "", 0, ErrNotFound
+	}
+	header, err := lightNode.getHeader(response.GetBlockHeight())
+	if err != nil {
+		return "", 0, err
+	}
+	if err := state.VerifyContractStorageProof(header.StateRoot, address, key, response.GetValue(), toMerkleProof(response.GetProof())); err != nil {
+		return "", 0, ErrProofInvalid
+	}
+	return response.GetValue(), header.Height, nil
+}
+
+//getHeader returns the synced header at the height of a proof
+func (lightNode *LightNode) getHeader(height uint64) (*block.SignedHeader, error) {
+	header, err := lightNode.chain.GetHeader(height)
+	if err != nil {
+		return nil, ErrHeaderNotSynced
+	}
+	return header, nil
+}
+
+func toMerkleProof(proofPb *networkpb.StateProof) trie.MerkleProof {
+	proof := make(trie.MerkleProof, len(proofPb.GetNodes()))
+	for i, node := range proofPb.GetNodes() {
+		proof[i] = node.GetVal()
+	}
+	return proof
+}
@@ -0,0 +1,235 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightnode
+
+import (
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/ltransaction"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//fakeNet delivers the commands between the subscribers of connected fake nodes
+type fakeNet struct {
+	host        networkmodel.PeerInfo
+	peers       map[peer.ID]*fakeNet
+	subscribers []pubsub.Subscriber
+}
+
+func newFakeNet(id string) *fakeNet {
+	return &fakeNet{host: networkmodel.PeerInfo{PeerId: peer.ID(id)}, peers: make(map[peer.ID]*fakeNet)}
+}
+
+func (n *fakeNet) connect(other *fakeNet) {
+	n.peers[other.host.PeerId] = other
+	other.peers[n.host.PeerId] = n
+}
+
+func (n *fakeNet) GetPeers() []networkmodel.PeerInfo {
+	peers := []networkmodel.PeerInfo{}
+	for _, other := range n.peers {
+		peers = append(peers, other.host)
+	}
+	return peers
+}
+
+func (n *fakeNet) UnicastHighProrityCommand(commandName string, message proto.Message, destination networkmodel.PeerInfo) {
+	data, _ := proto.Marshal(message)
+	if other, ok := n.peers[destination.PeerId]; ok {
+		other.deliver(commandName, data, n.host)
+	}
+}
+
+func (n *fakeNet) Listen(subscriber pubsub.Subscriber) {
+	n.subscribers = append(n.subscribers, subscriber)
+}
+
+func (n *fakeNet) deliver(commandName string, data []byte, source networkmodel.PeerInfo) {
+	for _, subscriber := range n.subscribers {
+		for _, topic := range subscriber.GetSubscribedTopics() {
+			if topic == commandName {
+				command := networkmodel.NewDappCmd(commandName, data, false)
+				subscriber.GetTopicHandler(topic)(networkmodel.NewDappRcvdCmdContext(command, source))
+			}
+		}
+	}
+}
+
+//createTestBlockchain returns a blockchain whose blocks reward the address, and that stores the value of the key of
+//the contract at height 2
+func createTestBlockchain(t *testing.T, addr account.Address, numOfBlks int, contract, key, value string) *lblockchain.Blockchain {
+	bc := lblockchain.CreateBlockchain(addr, storage.NewRamStorage(), nil, transactionpool.NewTransactionPool(nil, 128000), nil, 100000)
+	for i := 0; i < numOfBlks; i++ {
+		tailBlk, err := bc.GetTailBlock()
+		require.Nil(t, err)
+		cbtx := ltransaction.NewCoinbaseTX(addr, "", bc.GetMaxHeight()+1, common.NewAmount(0))
+		blk := block.NewBlockWithTimestamp([]*transaction.Transaction{&cbtx}, tailBlk, tailBlk.GetTimestamp()+5, addr.String())
+
+		parentState := scState.LoadScStateFromDatabase(bc.GetDb())
+		newState := parentState.DeepCopy()
+		if blk.GetHeight() == 2 {
+			newState.Set(contract, key, value)
+		}
+		stateRoot, err := lblock.CalculateStateRoot(blk, tailBlk, parentState, newState, storage.NewOverlay(bc.GetDb()))
+		require.Nil(t, err)
+		blk.SetStateRoot(stateRoot)
+		blk.SetHash(lblock.CalculateHash(blk, nil))
+
+		utxoIndex := lutxo.NewUTXOIndex(bc.GetUtxoCache())
+		utxoIndex.UpdateUtxos(blk.GetTransactions())
+		require.Nil(t, bc.AddBlockContextToTail(&lblockchain.BlockContext{Block: blk, UtxoIndex: utxoIndex, State: newState}))
+	}
+	return bc
+}
+
+func TestLightNode_Sync(t *testing.T) {
+	addr := account.NewAccount().GetAddress()
+	contract := account.NewContractTransactionAccount().GetAddress().String()
+	bc := createTestBlockchain(t, addr, 6, contract, "key", "value")
+
+	fullNet := newFakeNet("full")
+	lightNet := newFakeNet("light")
+	fullNet.connect(lightNet)
+	NewLightServer(fullNet, bc)
+	lightNode := NewLightNode(lightNet, NewHeaderChain(storage.NewRamStorage(), getGenesis(t, bc)))
+
+	//the proofs cannot be verified before the headers are synced
+	_, _, err := lightNode.GetUtxos(addr.String())
+	assert.Equal(t, ErrHeaderNotSynced, err)
+
+	lightNode.SyncHeaders()
+	assert.Equal(t, bc.GetMaxHeight(), lightNode.GetHeaderChain().GetMaxHeight())
+	tailHeader, err := lightNode.GetHeaderChain().GetTailHeader()
+	assert.Nil(t, err)
+	assert.Equal(t, bc.GetTailBlockHash(), tailHeader.Hash())
+
+	blk, err := bc.GetBlockByHeight(3)
+	require.Nil(t, err)
+	txid := blk.GetTransactions()[0].ID
+	tx, height, err := lightNode.GetTransaction(txid)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 3, height)
+	assert.Equal(t, txid, tx.ID)
+	_, _, err = lightNode.GetTransaction([]byte("unknown"))
+	assert.Equal(t, ErrNotFound, err)
+
+	utxos, height, err := lightNode.GetUtxos(addr.String())
+	assert.Nil(t, err)
+	assert.Equal(t, bc.GetMaxHeight(), height)
+	assert.Equal(t, 7, len(utxos))
+	_, _, err = lightNode.GetUtxos("invalid")
+	assert.Equal(t, ErrInvalidAddress, err)
+
+	value, _, err := lightNode.GetContractState(contract, "key")
+	assert.Nil(t, err)
+	assert.Equal(t, "value", value)
+	_, _, err = lightNode.GetContractState(contract, "unknown")
+	assert.Equal(t, ErrNotFound, err)
+
+	//the blocks for the election are fetched from the peers and cached
+	fetchedBlk, err := lightNode.GetBlockByHeight(3)
+	assert.Nil(t, err)
+	assert.Equal(t, blk.GetHash(), fetchedBlk.GetHash())
+	assert.Equal(t, len(blk.GetTransactions()), len(fetchedBlk.GetTransactions()))
+	assert.True(t, lightNode.blocks.Contains(blk.GetHash().String()))
+}
+
+func TestLightNode_VerifyProofs(t *testing.T) {
+	addr := account.NewAccount().GetAddress()
+	contract := account.NewContractTransactionAccount().GetAddress().String()
+	bc := createTestBlockchain(t, addr, 4, contract, "key", "value")
+	server := NewLightServer(nil, bc)
+	lightNode := NewLightNode(nil, NewHeaderChain(storage.NewRamStorage(), getGenesis(t, bc)))
+	require.Nil(t, lightNode.GetHeaderChain().AddHeaders(toSignedHeaders(server.getHeaders(&networkpb.GetHeaders{}))))
+
+	blk, err := bc.GetBlockByHeight(2)
+	require.Nil(t, err)
+	txid := blk.GetTransactions()[0].ID
+	txProof := server.getTransactionProof(txid)
+	_, _, err = lightNode.verifyTransactionProof(txid, txProof)
+	assert.Nil(t, err)
+	txProof.BlockHeight = 3
+	_, _, err = lightNode.verifyTransactionProof(txid, txProof)
+	assert.Equal(t, ErrProofInvalid, err)
+	_, _, err = lightNode.verifyTransactionProof([]byte("another"), server.getTransactionProof(txid))
+	assert.Equal(t, ErrProofInvalid, err)
+
+	utxoProof, err := server.getUtxoProof(addr.String())
+	require.Nil(t, err)
+	_, _, err = lightNode.verifyUtxoProof(account.NewTransactionAccountByAddress(addr).GetPubKeyHash(), utxoProof)
+	assert.Nil(t, err)
+	//the UTXOs of another address are rejected
+	other := account.NewAccount().GetPubKeyHash()
+	_, _, err = lightNode.verifyUtxoProof(other, utxoProof)
+	assert.Equal(t, ErrProofInvalid, err)
+	utxoProof.Utxos[0].Utxo.Amount = common.NewAmount(1).Bytes()
+	_, _, err = lightNode.verifyUtxoProof(account.NewTransactionAccountByAddress(addr).GetPubKeyHash(), utxoProof)
+	assert.Equal(t, ErrProofInvalid, err)
+
+	stateProof, err := server.getContractStateProof(contract, "key")
+	require.Nil(t, err)
+	stateProof.Value = "another"
+	_, _, err = lightNode.verifyContractStateProof(contract, "key", stateProof)
+	assert.Equal(t, ErrProofInvalid, err)
+
+	//a proof at a height above the synced tail is not verified
+	stateProof.BlockHeight = 5
+	_, _, err = lightNode.verifyContractStateProof(contract, "key", stateProof)
+	assert.Equal(t, ErrHeaderNotSynced, err)
+
+	//the blocks have to match the synced headers and their transactions
+	blocksProof := server.getBlocksByHeight(&networkpb.GetBlocksByHeight{StartHeight: 1})
+	assert.Equal(t, int(bc.GetMaxHeight()), len(blocksProof.GetBlocks()))
+	assert.Nil(t, lightNode.verifyBlocks(1, blocksProof))
+	assert.Equal(t, ErrBlockInvalid, lightNode.verifyBlocks(2, blocksProof))
+	blocksProof.Blocks[1].Transactions = blocksProof.Blocks[1].Transactions[1:]
+	assert.Equal(t, ErrBlockInvalid, lightNode.verifyBlocks(1, blocksProof))
+	assert.Equal(t, ErrNotFound, lightNode.verifyBlocks(1, &networkpb.ReturnBlocksByHeight{}))
+}
+
+func getGenesis(t *testing.T, bc *lblockchain.Blockchain) *block.Block {
+	genesis, err := bc.GetBlockByHeight(0)
+	require.Nil(t, err)
+	return genesis
+}
+
+func toSignedHeaders(result *networkpb.ReturnHeaders) []*block.SignedHeader {
+	headers := make([]*block.SignedHeader, len(result.GetHeaders()))
+	for i, headerPb := range result.GetHeaders() {
+		headers[i] = &block.SignedHeader{}
+		headers[i].FromProto(headerPb)
+	}
+	return headers
+}
@@ -0,0 +1,322 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package lightnode
+
+import (
+	"github.com/dappley/go-dappley/common/pubsub"
+	"github.com/dappley/go-dappley/common/trie"
+	triepb "github.com/dappley/go-dappley/common/trie/pb"
+	"github.com/dappley/go-dappley/core/account"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/state"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	utxopb "github.com/dappley/go-dappley/core/utxo/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/network/networkmodel"
+	networkpb "github.com/dappley/go-dappley/network/pb"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+//maxUtxoProofNum is the maximum number of UTXOs proven in one response
+const maxUtxoProofNum = 200
+
+var (
+	lightServerSubscribedTopics = []string{
+		GetHeadersRequest,
+		GetBlocksByHeightRequest,
+		GetTransactionProofRequest,
+		GetUtxoProofRequest,
+		GetContractStateProofRequest,
+	}
+)
+
+// LightServer answers the requests of light nodes from the blockchain of a full node. The UTXOs and contract states
+// are proven against the state root of the tail block.
+type LightServer struct {
+	bc   *lblockchain.Blockchain
+	node NetService
+}
+
+//NewLightServer returns a server of the blockchain to the light nodes connected to the node
+func NewLightServer(node NetService, bc *lblockchain.Blockchain) *LightServer {
+	server := &LightServer{bc: bc, node: node}
+	if node != nil {
+		node.Listen(server)
+	}
+	return server
+}
+
+func (server *LightServer) GetSubscribedTopics() []string {
+	return lightServerSubscribedTopics
+}
+
+func (server *LightServer) GetTopicHandler(topic string) pubsub.TopicHandler {
+	switch topic {
+	case GetHeadersRequest:
+		return server.GetHeadersRequestHandler
+	case GetBlocksByHeightRequest:
+		return server.GetBlocksByHeightRequestHandler
+	case GetTransactionProofRequest:
+		return server.GetTransactionProofRequestHandler
+	case GetUtxoProofRequest:
+		return server.GetUtxoProofRequestHandler
+	case GetContractStateProofRequest:
+		return server.GetContractStateProofRequestHandler
+	}
+	return nil
+}
+
+func (server *LightServer) GetHeadersRequestHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetHeaders{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetHeadersRequest",
+		}).Info("LightServer: parse data failed.")
+		return
+	}
+
+	server.node.UnicastHighProrityCommand(GetHeadersResponse, server.getHeaders(param), command.GetSource())
+}
+
+//getHeaders returns the signed headers on the main chain from the start height on
+func (server *LightServer) getHeaders(param *networkpb.GetHeaders) *networkpb.ReturnHeaders {
+	maxCount := param.GetMaxCount()
+	if maxCount == 0 || maxCount > maxGetHeadersNum {
+		maxCount = maxGetHeadersNum
+	}
+
+	result := &networkpb.ReturnHeaders{StartHeight: param.GetStartHeight()}
+	tailHeight := server.bc.GetMaxHeight()
+	for height := param.GetStartHeight(); height <= tailHeight && uint32(len(result.Headers)) < maxCount; height++ {
+		blk, err := server.bc.GetBlockByHeight(height)
+		if err != nil {
+			break
+		}
+		result.Headers = append(result.Headers, server.bc.GetSignedHeader(blk).ToProto().(*blockpb.SignedHeader))
+	}
+	return result
+}
+
+func (server *LightServer) GetBlocksByHeightRequestHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetBlocksByHeight{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetBlocksByHeightRequest",
+		}).Info("LightServer: parse data failed.")
+		return
+	}
+
+	server.node.UnicastHighProrityCommand(GetBlocksByHeightResponse, server.getBlocksByHeight(param), command.GetSource())
+}
+
+//getBlocksByHeight returns the blocks on the main chain from the start height on. The blocks stop at the first pruned
+//block, whose transactions are deleted
+func (server *LightServer) getBlocksByHeight(param *networkpb.GetBlocksByHeight) *networkpb.ReturnBlocksByHeight {
+	maxCount := param.GetMaxCount()
+	if maxCount == 0 || maxCount > maxGetBlocksNum {
+		maxCount = maxGetBlocksNum
+	}
+
+	result := &networkpb.ReturnBlocksByHeight{StartHeight: param.GetStartHeight()}
+	tailHeight := server.bc.GetMaxHeight()
+	for height := param.GetStartHeight(); height <= tailHeight && uint32(len(result.Blocks)) < maxCount; height++ {
+		if server.bc.IsPruned(height) {
+			break
+		}
+		blk, err := server.bc.GetBlockByHeight(height)
+		if err != nil {
+			break
+		}
+		result.Blocks = append(result.Blocks, blk.ToProto().(*blockpb.Block))
+	}
+	return result
+}
+
+func (server *LightServer) GetTransactionProofRequestHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetTransactionProof{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetTransactionProofRequest",
+		}).Info("LightServer: parse data failed.")
+		return
+	}
+
+	server.node.UnicastHighProrityCommand(GetTransactionProofResponse, server.getTransactionProof(param.GetTxid()), command.GetSource())
+}
+
+//getTransactionProof returns the transaction on the main chain with the Merkle branch of its block. The response
+//has no transaction if it is not on chain
+func (server *LightServer) getTransactionProof(txid []byte) *networkpb.ReturnTransactionProof {
+	result := &networkpb.ReturnTransactionProof{Txid: txid}
+	tx, blk, index, err := server.bc.GetTransactionByID(txid)
+	if err != nil {
+		return result
+	}
+
+	branch, err := lblock.GetTransactionMerkleBranch(blk, index, server.bc.GetForks())
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"height": blk.GetHeight(),
+		}).Warn("LightServer: failed to get the Merkle branch of the transaction.")
+		return result
+	}
+
+	result.Transaction = tx.ToProto().(*transactionpb.Transaction)
+	result.BlockHeight = blk.GetHeight()
+	result.Index = uint32(index)
+	result.NumTxs = uint32(len(blk.GetTransactions()))
+	for _, siblingHash := range branch {
+		result.Branch = append(result.Branch, siblingHash)
+	}
+	return result
+}
+
+func (server *LightServer) GetUtxoProofRequestHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetUtxoProof{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetUtxoProofRequest",
+		}).Info("LightServer: parse data failed.")
+		return
+	}
+
+	result, err := server.getUtxoProof(param.GetAddress())
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"address": param.GetAddress(),
+		}).Warn("LightServer: failed to prove the UTXOs of the address.")
+		return
+	}
+	server.node.UnicastHighProrityCommand(GetUtxoProofResponse, result, command.GetSource())
+}
+
+//getUtxoProof returns the UTXOs of the address with their proofs in the state of the tail block
+func (server *LightServer) getUtxoProof(address string) (*networkpb.ReturnUtxoProof, error) {
+	result := &networkpb.ReturnUtxoProof{Address: address}
+	acc := account.NewTransactionAccountByAddress(account.NewAddress(address))
+	if !acc.IsValid() {
+		return result, nil
+	}
+
+	tailBlk, err := server.bc.GetTailBlock()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := lblock.GetStateRoot(tailBlk, server.bc.GetDb())
+	if err != nil {
+		return nil, err
+	}
+	stateTrie, err := state.NewStateTrie(stateRoot, server.bc.GetDb())
+	if err != nil {
+		return nil, err
+	}
+	result.BlockHeight = tailBlk.GetHeight()
+
+	for _, u := range server.bc.GetUpdatedUTXOIndex().GetAllUTXOsByPubKeyHash(acc.GetPubKeyHash()).GetAllUtxos() {
+		if len(result.Utxos) >= maxUtxoProofNum {
+			break
+		}
+		//the UTXO index may be updated by a block after the tail was read
+		proof, err := stateTrie.ProveUTXO(u.Txid, u.TxIndex)
+		if err != nil {
+			continue
+		}
+		result.Utxos = append(result.Utxos, &networkpb.UtxoProof{
+			Utxo:  u.ToProto().(*utxopb.Utxo),
+			Proof: toStateProofPb(proof),
+		})
+	}
+	return result, nil
+}
+
+func (server *LightServer) GetContractStateProofRequestHandler(input interface{}) {
+	var command *networkmodel.DappRcvdCmdContext
+	command = input.(*networkmodel.DappRcvdCmdContext)
+
+	param := &networkpb.GetContractStateProof{}
+	if err := proto.Unmarshal(command.GetData(), param); err != nil {
+		logger.WithFields(logger.Fields{
+			"name": "GetContractStateProofRequest",
+		}).Info("LightServer: parse data failed.")
+		return
+	}
+
+	result, err := server.getContractStateProof(param.GetAddress(), param.GetKey())
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"address": param.GetAddress(),
+			"key":     param.GetKey(),
+		}).Warn("LightServer: failed to prove the contract state.")
+		return
+	}
+	server.node.UnicastHighProrityCommand(GetContractStateProofResponse, result, command.GetSource())
+}
+
+//getContractStateProof returns the value of the key in the storage of the contract with its proof in the state of
+//the tail block. The response has no value if the key is not in the storage
+func (server *LightServer) getContractStateProof(address, key string) (*networkpb.ReturnContractStateProof, error) {
+	result := &networkpb.ReturnContractStateProof{Address: address, Key: key}
+	tailBlk, err := server.bc.GetTailBlock()
+	if err != nil {
+		return nil, err
+	}
+	stateRoot, err := lblock.GetStateRoot(tailBlk, server.bc.GetDb())
+	if err != nil {
+		return nil, err
+	}
+	stateTrie, err := state.NewStateTrie(stateRoot, server.bc.GetDb())
+	if err != nil {
+		return nil, err
+	}
+	result.BlockHeight = tailBlk.GetHeight()
+
+	value, err := stateTrie.GetContractStorage(address, key)
+	if err != nil || value == "" {
+		return result, err
+	}
+	proof, err := stateTrie.ProveContractStorage(address, key)
+	if err != nil {
+		return nil, err
+	}
+	result.Value = value
+	result.Proof = toStateProofPb(proof)
+	return result, nil
+}
+
+func toStateProofPb(proof trie.MerkleProof) *networkpb.StateProof {
+	proofPb := &networkpb.StateProof{}
+	for _, node := range proof {
+		proofPb.Nodes = append(proofPb.Nodes, &triepb.Node{Val: node})
+	}
+	return proofPb
+}
@@ -191,6 +191,7 @@ func GetBalance(address account.Address, bc *lblockchain.Blockchain) (*common.Am
 
 func Send(senderAccount *account.Account, to account.Address, amount *common.Amount, tip *common.Amount, gasLimit *common.Amount, gasPrice *common.Amount, contract string, bc *lblockchain.Blockchain) ([]byte, string, error) {
 	sendTxParam := transaction.NewSendTxParam(senderAccount.GetAddress(), senderAccount.GetKeyPair(), to, amount, tip, gasLimit, gasPrice, contract)
+	sendTxParam.ChainID = bc.GetChainID()
 	return sendTo(sendTxParam, bc)
 }
 
@@ -206,6 +207,7 @@ func GetMinerAddress() string {
 func SendFromMiner(address account.Address, amount *common.Amount, bc *lblockchain.Blockchain) ([]byte, string, error) {
 	minerAccount := account.NewAccountByPrivateKey(minerPrivateKey)
 	sendTxParam := transaction.NewSendTxParam(minerAccount.GetAddress(), minerAccount.GetKeyPair(), address, amount, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), "")
+	sendTxParam.ChainID = bc.GetChainID()
 	return sendTo(sendTxParam, bc)
 }
 
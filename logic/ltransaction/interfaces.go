@@ -37,5 +37,5 @@ type ScEngine interface {
 // Decorator of transaction
 type TxDecorator interface {
 	Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error
-	Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error
+	Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error
 }
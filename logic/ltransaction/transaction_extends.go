@@ -19,6 +19,7 @@
 package ltransaction
 
 import (
+	"bytes"
 	"crypto/ecdsa"
 	"encoding/binary"
 	"encoding/hex"
@@ -79,6 +80,16 @@ type TxContractSend struct {
 	*transaction.Transaction
 }
 
+// TxVote transaction, locks stake to the voter and votes for a producer candidate
+type TxVote struct {
+	*transaction.Transaction
+}
+
+// TxEvidence transaction, records the evidence of a producer signing two blocks in one time slot
+type TxEvidence struct {
+	*transaction.Transaction
+}
+
 // Returns decorator of transaction
 func NewTxDecorator(tx *transaction.Transaction) TxDecorator {
 	// old data adapter
@@ -99,6 +110,10 @@ func NewTxDecorator(tx *transaction.Transaction) TxDecorator {
 		return &TxReward{tx}
 	case transaction.TxTypeContractSend:
 		return &TxContractSend{tx}
+	case transaction.TxTypeVote:
+		return &TxVote{tx}
+	case transaction.TxTypeEvidence:
+		return &TxEvidence{tx}
 	}
 	return nil
 }
@@ -107,7 +122,7 @@ func (tx *TxNormal) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error
 	return tx.Transaction.Sign(privKey, prevUtxos)
 }
 
-func (tx *TxNormal) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxNormal) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
@@ -116,14 +131,14 @@ func (tx *TxNormal) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error
 		}).Warn("Verify: cannot find vin while verifying normal tx")
 		return err
 	}
-	return tx.Transaction.Verify(prevUtxos)
+	return tx.Transaction.Verify(prevUtxos, chainID)
 }
 
 func (tx *TxContract) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
 	return tx.Transaction.Sign(privKey, prevUtxos)
 }
 
-func (tx *TxContract) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxContract) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
@@ -132,7 +147,7 @@ func (tx *TxContract) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) err
 		}).Warn("Verify: cannot find vin while verifying contract tx")
 		return err
 	}
-	err = tx.verifyInEstimate(utxoIndex, prevUtxos)
+	err = tx.verifyInEstimate(utxoIndex, prevUtxos, chainID)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
 			"txid":        hex.EncodeToString(tx.ID),
@@ -151,7 +166,7 @@ func (tx *TxCoinbase) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) err
 	return nil
 }
 
-func (tx *TxCoinbase) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxCoinbase) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	//TODO coinbase vout check need add tip
 	if tx.Vout[0].Value.Cmp(transaction.Subsidy) < 0 {
 		return errors.New("Transaction: subsidy check failed")
@@ -167,7 +182,7 @@ func (tx *TxGasReward) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) er
 	return nil
 }
 
-func (tx *TxGasReward) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxGasReward) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	return nil
 }
 
@@ -175,7 +190,7 @@ func (tx *TxGasChange) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) er
 	return nil
 }
 
-func (tx *TxGasChange) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxGasChange) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	return nil
 }
 
@@ -183,7 +198,7 @@ func (tx *TxReward) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error
 	return nil
 }
 
-func (tx *TxReward) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxReward) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	return nil
 }
 
@@ -191,10 +206,89 @@ func (tx *TxContractSend) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO)
 	return nil
 }
 
-func (tx *TxContractSend) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64) error {
+func (tx *TxContractSend) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
 	return nil
 }
 
+func (tx *TxVote) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return tx.Transaction.Sign(privKey, prevUtxos)
+}
+
+func (tx *TxVote) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
+	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
+	if err != nil {
+		logger.WithError(err).WithFields(logger.Fields{
+			"txid":        hex.EncodeToString(tx.ID),
+			"blockHeight": blockHeight,
+		}).Warn("Verify: cannot find vin while verifying vote tx")
+		return err
+	}
+	if err := tx.verifyVote(); err != nil {
+		return err
+	}
+	return tx.Transaction.Verify(prevUtxos, chainID)
+}
+
+// verifyVote checks that the stake is locked to the voter and the candidate is a valid address
+func (tx *TxVote) verifyVote() error {
+	if len(tx.Vin) == 0 || len(tx.Vout) <= transaction.VoteTxOutputIndex {
+		return ErrInvalidVoteStake
+	}
+	if _, err := account.IsValidPubKey(tx.Vin[0].PubKey); err != nil {
+		return err
+	}
+	voter := account.NewTransactionAccountByPubKey(tx.Vin[0].PubKey).GetPubKeyHash()
+	stake := tx.Vout[transaction.VoteTxOutputIndex]
+	if !bytes.Equal(stake.PubKeyHash, voter) || stake.Value == nil || stake.Value.IsZero() {
+		return ErrInvalidVoteStake
+	}
+	if !tx.GetCandidate().IsValid() {
+		return ErrInvalidVoteCandidate
+	}
+	return nil
+}
+
+// GetVoter returns the public key hash of the voter, to which the stake is locked
+func (tx *TxVote) GetVoter() account.PubKeyHash {
+	return tx.Vout[transaction.VoteTxOutputIndex].PubKeyHash
+}
+
+// GetCandidate returns the account of the producer candidate the tx votes for
+func (tx *TxVote) GetCandidate() *account.TransactionAccount {
+	return account.NewTransactionAccountByAddress(account.NewAddress(tx.Vout[transaction.VoteTxOutputIndex].Contract))
+}
+
+// GetStake returns the amount of stake backing the vote
+func (tx *TxVote) GetStake() *common.Amount {
+	return tx.Vout[transaction.VoteTxOutputIndex].Value
+}
+
+func (tx *TxEvidence) Sign(privKey ecdsa.PrivateKey, prevUtxos []*utxo.UTXO) error {
+	return nil
+}
+
+func (tx *TxEvidence) Verify(utxoIndex *lutxo.UTXOIndex, blockHeight uint64, chainID uint64) error {
+	_, err := tx.GetOffender()
+	return err
+}
+
+// GetEvidence returns the evidence carried by the transaction
+func (tx *TxEvidence) GetEvidence() (*block.Evidence, error) {
+	if len(tx.Vin) != 1 || len(tx.Vout) != 0 {
+		return nil, ErrInvalidEvidenceTx
+	}
+	return block.DeserializeEvidence(tx.Vin[0].PubKey)
+}
+
+// GetOffender verifies the evidence and returns the address of the producer that signed both blocks
+func (tx *TxEvidence) GetOffender() (string, error) {
+	evidence, err := tx.GetEvidence()
+	if err != nil {
+		return "", err
+	}
+	return evidence.Verify()
+}
+
 func NewTxContract(tx *transaction.Transaction) *TxContract {
 	adaptedTx := transaction.NewTxAdapter(tx)
 	if adaptedTx.IsContract() {
@@ -264,20 +358,20 @@ func (tx *TxContract) GetContractAddress() account.Address {
 	return tx.Address
 }
 
-// VerifyInEstimate returns whether the current tx in estimate mode is valid.
-func (tx *TxContract) VerifyInEstimate(utxoIndex *lutxo.UTXOIndex) error {
+// VerifyInEstimate returns whether the current tx in estimate mode is valid on the network of the chain id.
+func (tx *TxContract) VerifyInEstimate(utxoIndex *lutxo.UTXOIndex, chainID uint64) error {
 	prevUtxos, err := lutxo.FindVinUtxosInUtxoPool(utxoIndex, tx.Transaction)
 	if err != nil {
 		return err
 	}
-	return tx.verifyInEstimate(utxoIndex, prevUtxos)
+	return tx.verifyInEstimate(utxoIndex, prevUtxos, chainID)
 }
 
-func (tx *TxContract) verifyInEstimate(utxoIndex *lutxo.UTXOIndex, prevUtxos []*utxo.UTXO) error {
+func (tx *TxContract) verifyInEstimate(utxoIndex *lutxo.UTXOIndex, prevUtxos []*utxo.UTXO, chainID uint64) error {
 	if tx.IsScheduleContract() && !tx.IsContractDeployed(utxoIndex) {
 		return errors.New("Transaction: contract state check failed")
 	}
-	err := tx.Transaction.Verify(prevUtxos)
+	err := tx.Transaction.Verify(prevUtxos, chainID)
 	return err
 }
 
@@ -397,7 +491,7 @@ func NewRewardTx(blockHeight uint64, rewards map[string]string) transaction.Tran
 		acc := account.NewTransactionAccountByAddress(account.NewAddress(address))
 		txOutputs = append(txOutputs, *transactionbase.NewTXOutput(amt, acc))
 	}
-	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, txOutputs, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeReward}
+	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, txOutputs, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeReward, 0}
 
 	tx.ID = tx.Hash()
 
@@ -425,7 +519,7 @@ func NewGasRewardTx(to *account.TransactionAccount, blockHeight uint64, actualGa
 	fee := actualGasCount.Mul(gasPrice)
 	txin := transactionbase.TXInput{nil, -1, getUniqueByte(blockHeight, uniqueNum), transaction.GasRewardData}
 	txout := transactionbase.NewTXOutput(fee, to)
-	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeGasReward}
+	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeGasReward, 0}
 	tx.ID = tx.Hash()
 	return tx, nil
 }
@@ -444,7 +538,7 @@ func NewGasChangeTx(to *account.TransactionAccount, blockHeight uint64, actualGa
 
 	txin := transactionbase.TXInput{nil, -1, getUniqueByte(blockHeight, uniqueNum), transaction.GasChangeData}
 	txout := transactionbase.NewTXOutput(changeValue, to)
-	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeGasChange}
+	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeGasChange, 0}
 
 	tx.ID = tx.Hash()
 	return tx, nil
@@ -460,7 +554,7 @@ func NewCoinbaseTX(to account.Address, data string, blockHeight uint64, tip *com
 	toAccount := account.NewTransactionAccountByAddress(to)
 	txin := transactionbase.TXInput{nil, -1, bh, []byte(data)}
 	txout := transactionbase.NewTXOutput(transaction.Subsidy.Add(tip), toAccount)
-	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeCoinbase}
+	tx := transaction.Transaction{nil, []transactionbase.TXInput{txin}, []transactionbase.TXOutput{*txout}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeCoinbase, 0}
 	tx.ID = tx.Hash()
 
 	return tx
@@ -488,6 +582,46 @@ func NewUTXOTransaction(utxos []*utxo.UTXO, sendTxParam transaction.SendTxParam)
 		sendTxParam.GasPrice,
 		time.Now().UnixNano() / 1e6,
 		txType,
+		sendTxParam.ChainID,
+	}
+	tx.ID = tx.Hash()
+
+	err = tx.Sign(sendTxParam.SenderKeyPair.GetPrivateKey(), utxos)
+	if err != nil {
+		return transaction.Transaction{}, err
+	}
+
+	return tx, nil
+}
+
+// NewVoteTX creates a vote transaction. The stake (sendTxParam.Amount) stays locked to the sender as long as
+// the vote output is unspent, and the vote is cast for sendTxParam.To
+func NewVoteTX(utxos []*utxo.UTXO, sendTxParam transaction.SendTxParam) (transaction.Transaction, error) {
+	voterAccount := account.NewTransactionAccountByAddress(sendTxParam.From)
+	candidateAccount := account.NewTransactionAccountByAddress(sendTxParam.To)
+	if !candidateAccount.IsValid() {
+		return transaction.Transaction{}, ErrInvalidVoteCandidate
+	}
+	sum := transaction.CalculateUtxoSum(utxos)
+	change, err := transaction.CalculateChange(sum, sendTxParam.Amount, sendTxParam.Tip, common.NewAmount(0), common.NewAmount(0))
+	if err != nil {
+		return transaction.Transaction{}, err
+	}
+
+	outputs := []transactionbase.TXOutput{*transactionbase.NewTxOut(sendTxParam.Amount, voterAccount, candidateAccount.GetAddress().String())}
+	if !change.IsZero() {
+		outputs = append(outputs, *transactionbase.NewTXOutput(change, voterAccount))
+	}
+
+	tx := transaction.Transaction{
+		Vin:        prepareInputLists(utxos, sendTxParam.SenderKeyPair.GetPublicKey(), nil),
+		Vout:       outputs,
+		Tip:        sendTxParam.Tip,
+		GasLimit:   common.NewAmount(0),
+		GasPrice:   common.NewAmount(0),
+		CreateTime: time.Now().UnixNano() / 1e6,
+		Type:       transaction.TxTypeVote,
+		ChainID:    sendTxParam.ChainID,
 	}
 	tx.ID = tx.Hash()
 
@@ -535,6 +669,7 @@ func NewContractTransferTX(utxos []*utxo.UTXO, contractAddr, toAddr account.Addr
 		gasPrice,
 		time.Now().UnixNano() / 1e6,
 		transaction.TxTypeContractSend,
+		0,
 	}
 	tx.ID = tx.Hash()
 
@@ -594,3 +729,22 @@ func getUniqueByte(height uint64, uniqueNum int) []byte {
 	bh[1] = bUnique[1]
 	return bh
 }
+
+// NewEvidenceTx creates a transaction that carries the evidence of a double-minting producer. The transaction has
+// no inputs to spend and no outputs, so that every node creates the same transaction from the same evidence
+func NewEvidenceTx(evidence *block.Evidence) (transaction.Transaction, error) {
+	rawBytes, err := evidence.Serialize()
+	if err != nil {
+		return transaction.Transaction{}, err
+	}
+	tx := transaction.Transaction{
+		Vin:      []transactionbase.TXInput{{Txid: nil, Vout: -1, Signature: nil, PubKey: rawBytes}},
+		Vout:     []transactionbase.TXOutput{},
+		Tip:      common.NewAmount(0),
+		GasLimit: common.NewAmount(0),
+		GasPrice: common.NewAmount(0),
+		Type:     transaction.TxTypeEvidence,
+	}
+	tx.ID = tx.Hash()
+	return tx, nil
+}
@@ -21,17 +21,22 @@ var (
 	ErrInvalidGasPrice = errors.New("invalid gas price, should be in (0, 10^12]")
 	ErrInvalidGasLimit = errors.New("invalid gas limit, should be in (0, 5*10^10]")
 
+	ErrInvalidVoteCandidate = errors.New("invalid vote candidate address")
+	ErrInvalidVoteStake     = errors.New("vote stake must be locked to the voter and greater than 0")
+	ErrInvalidEvidenceTx    = errors.New("evidence tx must carry the evidence in its only input and have no outputs")
+
 	// vm error
 	ErrExecutionFailed       = errors.New("execution failed")
 	ErrUnsupportedSourceType = errors.New("unsupported source type")
 	ErrLoadError             = errors.New("contract load error")
 )
 
-// VerifyTransaction ensures signature of transactions is correct or verifies against blockHeight if it's a coinbase transactions
-func VerifyTransaction(utxoIndex *lutxo.UTXOIndex, tx *transaction.Transaction, blockHeight uint64) error {
+// VerifyTransaction ensures signature of transactions is correct or verifies against blockHeight if it's a coinbase transactions.
+// The signed transactions have to be signed for the network of the chain id
+func VerifyTransaction(utxoIndex *lutxo.UTXOIndex, tx *transaction.Transaction, blockHeight uint64, chainID uint64) error {
 	txDecorator := NewTxDecorator(tx)
 	if txDecorator != nil {
-		return txDecorator.Verify(utxoIndex, blockHeight)
+		return txDecorator.Verify(utxoIndex, blockHeight, chainID)
 	}
 	return nil
 }
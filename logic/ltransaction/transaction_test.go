@@ -10,6 +10,7 @@ import (
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core"
 	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/core/scState"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transactionbase"
@@ -54,7 +55,7 @@ func TestSign(t *testing.T) {
 	txout := []transactionbase.TXOutput{
 		{common.NewAmount(19), ta.GetPubKeyHash(), ""},
 	}
-	tx := &transaction.Transaction{nil, txin, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}
+	tx := &transaction.Transaction{nil, txin, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}
 
 	// ltransaction.Sign the transaction
 	err := NewTxDecorator(tx).Sign(*privKey, prevTXs)
@@ -113,16 +114,16 @@ func TestVerifyCoinbaseTransaction(t *testing.T) {
 	binary.BigEndian.PutUint64(bh1, 5)
 	txin1 := transactionbase.TXInput{nil, -1, bh1, []byte("Reward to test")}
 	txout1 := transactionbase.NewTXOutput(transaction.Subsidy, account.NewTransactionAccountByAddress(account.NewAddress("13ZRUc4Ho3oK3Cw56PhE5rmaum9VBeAn5F")))
-	var t6 = transaction.Transaction{nil, []transactionbase.TXInput{txin1}, []transactionbase.TXOutput{*txout1}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeCoinbase}
+	var t6 = transaction.Transaction{nil, []transactionbase.TXInput{txin1}, []transactionbase.TXOutput{*txout1}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeCoinbase, 0}
 
 	// test valid coinbase transaction
-	err5 := VerifyTransaction(&lutxo.UTXOIndex{}, &t5, 5)
+	err5 := VerifyTransaction(&lutxo.UTXOIndex{}, &t5, 5, 0)
 	assert.Nil(t, err5)
-	err6 := VerifyTransaction(&lutxo.UTXOIndex{}, &t6, 5)
+	err6 := VerifyTransaction(&lutxo.UTXOIndex{}, &t6, 5, 0)
 	assert.Nil(t, err6)
 
 	// test coinbase transaction with incorrect blockHeight
-	err5 = VerifyTransaction(&lutxo.UTXOIndex{}, &t5, 10)
+	err5 = VerifyTransaction(&lutxo.UTXOIndex{}, &t5, 10, 0)
 	assert.NotNil(t, err5)
 
 	// test coinbase transaction with incorrect Subsidy
@@ -130,8 +131,8 @@ func TestVerifyCoinbaseTransaction(t *testing.T) {
 	binary.BigEndian.PutUint64(bh2, 5)
 	txin2 := transactionbase.TXInput{nil, -1, bh2, []byte(nil)}
 	txout2 := transactionbase.NewTXOutput(common.NewAmount(9), account.NewTransactionAccountByAddress(account.NewAddress("13ZRUc4Ho3oK3Cw56PhE5rmaum9VBeAn5F")))
-	var t7 = transaction.Transaction{nil, []transactionbase.TXInput{txin2}, []transactionbase.TXOutput{*txout2}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeCoinbase}
-	err7 := VerifyTransaction(&lutxo.UTXOIndex{}, &t7, 5)
+	var t7 = transaction.Transaction{nil, []transactionbase.TXInput{txin2}, []transactionbase.TXOutput{*txout2}, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeCoinbase, 0}
+	err7 := VerifyTransaction(&lutxo.UTXOIndex{}, &t7, 5, 0)
 	assert.NotNil(t, err7)
 
 }
@@ -172,12 +173,12 @@ func TestVerifyNoCoinbaseTransaction(t *testing.T) {
 		signWith []byte
 		ok       error
 	}{
-		{"normal", transaction.Transaction{nil, txin1, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}, privKeyByte, nil},
-		{"previous tx not found with wrong pubkey", transaction.Transaction{nil, txin2, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}, privKeyByte, transaction.ErrTXInputNotFound},
-		{"previous tx not found with wrong Txid", transaction.Transaction{nil, txin3, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}, privKeyByte, transaction.ErrTXInputNotFound},
-		{"previous tx not found with wrong TxIndex", transaction.Transaction{nil, txin4, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}, privKeyByte, transaction.ErrTXInputNotFound},
-		{"ID invalid", transaction.Transaction{nil, txin1, txout2, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}, privKeyByte, errors.New("Transaction: ID is invalid")},
-		{"ltransaction.Sign invalid", transaction.Transaction{nil, txin1, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal}, wrongPrivKeyByte, errors.New("Transaction: ID is invalid")},
+		{"normal", transaction.Transaction{nil, txin1, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}, privKeyByte, nil},
+		{"previous tx not found with wrong pubkey", transaction.Transaction{nil, txin2, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}, privKeyByte, transaction.ErrTXInputNotFound},
+		{"previous tx not found with wrong Txid", transaction.Transaction{nil, txin3, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}, privKeyByte, transaction.ErrTXInputNotFound},
+		{"previous tx not found with wrong TxIndex", transaction.Transaction{nil, txin4, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}, privKeyByte, transaction.ErrTXInputNotFound},
+		{"ID invalid", transaction.Transaction{nil, txin1, txout2, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}, privKeyByte, errors.New("Transaction: ID is invalid")},
+		{"ltransaction.Sign invalid", transaction.Transaction{nil, txin1, txout, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), 0, transaction.TxTypeNormal, 0}, wrongPrivKeyByte, errors.New("Transaction: ID is invalid")},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -192,7 +193,7 @@ func TestVerifyNoCoinbaseTransaction(t *testing.T) {
 			}
 
 			// Verify the signatures
-			err := VerifyTransaction(utxoIndex, &tt.tx, 0)
+			err := VerifyTransaction(utxoIndex, &tt.tx, 0, 0)
 			assert.Equal(t, tt.ok, err)
 		})
 	}
@@ -237,8 +238,8 @@ func TestInvalidExecutionTx(t *testing.T) {
 	executionTx.ID = executionTx.Hash()
 	NewTxDecorator(executionTx).Sign(account.GenerateKeyPairByPrivateKey(prikey1).GetPrivateKey(), utxoIndex.GetAllUTXOsByPubKeyHash(ta1.GetPubKeyHash()).GetAllUtxos())
 
-	err1 := VerifyTransaction(lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage())), executionTx, 0)
-	err2 := VerifyTransaction(utxoIndex, executionTx, 0)
+	err1 := VerifyTransaction(lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage())), executionTx, 0, 0)
+	err2 := VerifyTransaction(utxoIndex, executionTx, 0, 0)
 	assert.NotNil(t, err1)
 	assert.Nil(t, err2)
 }
@@ -285,7 +286,7 @@ func TestInvalidTipTx(t *testing.T) {
 	executionTx.ID = executionTx.Hash()
 	NewTxDecorator(executionTx).Sign(account.GenerateKeyPairByPrivateKey(prikey1).GetPrivateKey(), utxoIndex.GetAllUTXOsByPubKeyHash(ta1.GetPubKeyHash()).GetAllUtxos())
 
-	err := VerifyTransaction(utxoIndex, executionTx, 0)
+	err := VerifyTransaction(utxoIndex, executionTx, 0, 0)
 	assert.NotNil(t, err)
 }
 
@@ -468,6 +469,7 @@ func TestTransaction_GetContractAddress(t *testing.T) {
 				common.NewAmount(0),
 				0,
 				transaction.TxTypeContract,
+				0,
 			}
 			ctx := NewTxContract(tx)
 			if ctx != nil {
@@ -496,3 +498,101 @@ func TestNewCoinbaseTX(t *testing.T) {
 	assert.NotEqual(t, t1, t3)
 	assert.NotEqual(t, t1.ID, t3.ID)
 }
+
+func TestVerifyVoteTransaction(t *testing.T) {
+	voter := account.NewAccount()
+	candidate := account.NewAccount()
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage()))
+	utxoTx := utxo.NewUTXOTx()
+	utxoTx.PutUtxo(utxo.NewUTXO(*transactionbase.NewTXOutput(common.NewAmount(10), account.NewTransactionAccountByAddress(voter.GetAddress())), []byte{1}, 0, utxo.UtxoNormal))
+	utxoIndex.SetIndexAdd(map[string]*utxo.UTXOTx{
+		voter.GetPubKeyHash().String(): &utxoTx,
+	})
+	sendTxParam := transaction.NewSendTxParam(voter.GetAddress(), voter.GetKeyPair(), candidate.GetAddress(), common.NewAmount(7), common.NewAmount(1), nil, nil, "")
+
+	tx, err := NewVoteTX(utxoIndex.GetAllUTXOsByPubKeyHash(voter.GetPubKeyHash()).GetAllUtxos(), sendTxParam)
+	assert.Nil(t, err)
+	assert.Equal(t, transaction.TxTypeVote, tx.Type)
+	assert.Equal(t, 2, len(tx.Vout))
+	assert.Equal(t, common.NewAmount(2), tx.Vout[1].Value)
+
+	vote := NewTxDecorator(&tx).(*TxVote)
+	assert.Equal(t, voter.GetPubKeyHash(), vote.GetVoter())
+	assert.Equal(t, candidate.GetAddress(), vote.GetCandidate().GetAddress())
+	assert.Equal(t, common.NewAmount(7), vote.GetStake())
+	assert.Nil(t, VerifyTransaction(utxoIndex, &tx, 0, 0))
+
+	// the stake must stay locked to the voter
+	stolenStake := tx.DeepCopy()
+	stolenStake.Vout[0].PubKeyHash = candidate.GetPubKeyHash()
+	assert.Equal(t, ErrInvalidVoteStake, VerifyTransaction(utxoIndex, &stolenStake, 0, 0))
+
+	invalidCandidate := tx.DeepCopy()
+	invalidCandidate.Vout[0].Contract = "invalid"
+	assert.Equal(t, ErrInvalidVoteCandidate, VerifyTransaction(utxoIndex, &invalidCandidate, 0, 0))
+
+	sendTxParam.To = account.NewAddress("invalid")
+	_, err = NewVoteTX(utxoIndex.GetAllUTXOsByPubKeyHash(voter.GetPubKeyHash()).GetAllUtxos(), sendTxParam)
+	assert.Equal(t, ErrInvalidVoteCandidate, err)
+}
+
+func TestVerifyTransactionChainID(t *testing.T) {
+	sender := account.NewAccount()
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage()))
+	utxoTx := utxo.NewUTXOTx()
+	utxoTx.PutUtxo(utxo.NewUTXO(*transactionbase.NewTXOutput(common.NewAmount(10), account.NewTransactionAccountByAddress(sender.GetAddress())), []byte{1}, 0, utxo.UtxoNormal))
+	utxoIndex.SetIndexAdd(map[string]*utxo.UTXOTx{
+		sender.GetPubKeyHash().String(): &utxoTx,
+	})
+	utxos := utxoIndex.GetAllUTXOsByPubKeyHash(sender.GetPubKeyHash()).GetAllUtxos()
+
+	sendTxParam := transaction.NewSendTxParam(sender.GetAddress(), sender.GetKeyPair(), account.NewAccount().GetAddress(), common.NewAmount(7), common.NewAmount(1), common.NewAmount(0), common.NewAmount(0), "")
+	sendTxParam.ChainID = 1
+	tx, err := NewUTXOTransaction(utxos, sendTxParam)
+	assert.Nil(t, err)
+	assert.EqualValues(t, 1, tx.ChainID)
+	assert.Nil(t, VerifyTransaction(utxoIndex, &tx, 0, 1))
+
+	// the transaction cannot be replayed on another network
+	assert.Equal(t, transaction.ErrChainIDMismatch, VerifyTransaction(utxoIndex, &tx, 0, 2))
+	assert.Equal(t, transaction.ErrChainIDMismatch, VerifyTransaction(utxoIndex, &tx, 0, 0))
+
+	// nor can its chain id be changed since it is signed
+	replayedTx := tx.DeepCopy()
+	replayedTx.ChainID = 2
+	assert.NotNil(t, VerifyTransaction(utxoIndex, &replayedTx, 0, 2))
+	replayedTx.ID = replayedTx.Hash()
+	assert.NotNil(t, VerifyTransaction(utxoIndex, &replayedTx, 0, 2))
+}
+
+func TestVerifyEvidenceTransaction(t *testing.T) {
+	producer := account.NewAccount()
+	privKey := producer.GetKeyPair().GetPrivateKey()
+	privData, err := secp256k1.FromECDSAPrivateKey(&privKey)
+	assert.Nil(t, err)
+	newSignedHeader := func(txsHash []byte) *block.SignedHeader {
+		header := &block.SignedHeader{PrevHash: []byte("prevhash"), TxsHash: txsHash, Timestamp: 100, Height: 10}
+		header.Signature, err = secp256k1.Sign(header.Hash(), privData)
+		assert.Nil(t, err)
+		return header
+	}
+	evidence := block.NewEvidence(newSignedHeader([]byte("txs1")), newSignedHeader([]byte("txs2")))
+
+	tx, err := NewEvidenceTx(evidence)
+	assert.Nil(t, err)
+	//the transaction survives the network encoding
+	decodedTx := &transaction.Transaction{}
+	decodedTx.FromProto(tx.ToProto())
+	assert.Equal(t, tx.ID, decodedTx.Hash())
+	assert.Nil(t, VerifyTransaction(nil, decodedTx, 0, 0))
+	offender, err := (&TxEvidence{Transaction: decodedTx}).GetOffender()
+	assert.Nil(t, err)
+	assert.Equal(t, producer.GetAddress().String(), offender)
+
+	invalidEvidenceTx, err := NewEvidenceTx(block.NewEvidence(evidence.First, evidence.First))
+	assert.Nil(t, err)
+	assert.Equal(t, block.ErrEvidenceSameBlock, VerifyTransaction(nil, &invalidEvidenceTx, 0, 0))
+
+	invalidEvidenceTx.Vout = append(invalidEvidenceTx.Vout, *transactionbase.NewTXOutput(common.NewAmount(1), account.NewTransactionAccountByAddress(producer.GetAddress())))
+	assert.Equal(t, ErrInvalidEvidenceTx, VerifyTransaction(nil, &invalidEvidenceTx, 0, 0))
+}
@@ -73,12 +73,24 @@ func (utxos *UTXOIndex) IsIndexAddExist(pubKeyHash account.PubKeyHash) bool {
 	return ok
 }
 func (utxos *UTXOIndex) Save() error {
+	return utxos.save(utxos.cache)
+}
+
+//SaveToStorage saves the changes of the UTXO index into the storage instead of the database of its cache, e.g. into
+//an overlay that is committed later with other writes. The returned cache layer holds the changes until it is
+//committed after the storage
+func (utxos *UTXOIndex) SaveToStorage(db storage.Storage) (*utxo.UTXOCache, error) {
+	layer := utxos.cache.WithStorage(db)
+	return layer, utxos.save(layer)
+}
+
+func (utxos *UTXOIndex) save(cache *utxo.UTXOCache) error {
 	utxos.mutex.Lock()
 	defer utxos.mutex.Unlock()
 
 	//save utxo to db/cache
 	for pubkey, utxoTx := range utxos.indexAdd {
-		err := utxos.cache.AddUtxos(utxoTx, pubkey)
+		err := cache.AddUtxos(utxoTx, pubkey)
 		if err != nil {
 			return err
 		}
@@ -86,7 +98,7 @@ func (utxos *UTXOIndex) Save() error {
 
 	//delete utxo from db/cache which in indexRemove
 	for pubkey, utxoTx := range utxos.indexRemove {
-		err := utxos.cache.RemoveUtxos(utxoTx, pubkey)
+		err := cache.RemoveUtxos(utxoTx, pubkey)
 		if err != nil {
 			return err
 		}
@@ -197,7 +209,7 @@ func (utxos *UTXOIndex) GetUTXOsByAmount(pubkeyHash account.PubKeyHash, amount *
 
 func (utxos *UTXOIndex) UpdateUtxo(tx *transaction.Transaction) bool {
 	adaptedTx := transaction.NewTxAdapter(tx)
-	if adaptedTx.IsNormal() || adaptedTx.IsContract() || adaptedTx.IsContractSend() {
+	if adaptedTx.IsNormal() || adaptedTx.IsContract() || adaptedTx.IsContractSend() || adaptedTx.IsVote() {
 		for _, txin := range tx.Vin {
 			isContract, _ := account.PubKeyHash(txin.PubKey).IsContract()
 			// spent contract utxo
@@ -246,7 +258,7 @@ func (utxos *UTXOIndex) UndoTxsInBlock(blk *block.Block, db storage.Storage) err
 			return err
 		}
 		adaptedTx := transaction.NewTxAdapter(tx)
-		if adaptedTx.IsCoinbase() || adaptedTx.IsRewardTx() || adaptedTx.IsGasRewardTx() || adaptedTx.IsGasChangeTx() {
+		if adaptedTx.IsCoinbase() || adaptedTx.IsRewardTx() || adaptedTx.IsGasRewardTx() || adaptedTx.IsGasChangeTx() || adaptedTx.IsEvidence() {
 			continue
 		}
 		err = utxos.unspendVinsInTx(tx, db)
@@ -275,3 +275,37 @@ func TestUTXOIndex_DeepCopy(t *testing.T) {
 
 	assert.EqualValues(t, utxoCopy.indexAdd[ta1.GetPubKeyHash().String()], utxoCopy2.indexAdd[ta1.GetPubKeyHash().String()])
 }
+
+func TestUTXOIndex_SaveToStorage(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+	cache := utxo.NewUTXOCache(db)
+	txout := transactionbase.TXOutput{common.NewAmount(5), ta1.GetPubKeyHash(), ""}
+
+	//the writes into an overlay that is never committed do not reach the cache
+	utxoIndex := NewUTXOIndex(cache)
+	utxoIndex.AddUTXO(txout, []byte{1}, 0)
+	_, err := utxoIndex.SaveToStorage(storage.NewOverlay(db))
+	assert.Nil(t, err)
+	assert.Equal(t, 0, NewUTXOIndex(cache).GetAllUTXOsByPubKeyHash(ta1.GetPubKeyHash()).Size())
+
+	utxoIndex = NewUTXOIndex(cache)
+	utxoIndex.AddUTXO(txout, []byte{1}, 0)
+	overlay := storage.NewOverlay(db)
+	layer, err := utxoIndex.SaveToStorage(overlay)
+	assert.Nil(t, err)
+	assert.Nil(t, overlay.Commit())
+	layer.Commit()
+	utxos := NewUTXOIndex(cache).GetAllUTXOsByPubKeyHash(ta1.GetPubKeyHash())
+	assert.Equal(t, 1, utxos.Size())
+
+	//the cache reads the committed removal once the layer is committed
+	utxoIndex = NewUTXOIndex(cache)
+	utxoIndex.SetindexRemove(map[string]*utxo.UTXOTx{ta1.GetPubKeyHash().String(): utxos})
+	overlay = storage.NewOverlay(db)
+	layer, err = utxoIndex.SaveToStorage(overlay)
+	assert.Nil(t, err)
+	assert.Nil(t, overlay.Commit())
+	layer.Commit()
+	assert.Equal(t, 0, NewUTXOIndex(cache).GetAllUTXOsByPubKeyHash(ta1.GetPubKeyHash()).Size())
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package migration
+
+import (
+	"encoding/binary"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+	logger "github.com/sirupsen/logrus"
+)
+
+var schemaVersionKey = []byte("schemaVersion")
+
+var (
+	ErrVersionTooNew   = errors.New("database is written by a newer version of the node")
+	ErrVersionOutdated = errors.New("database schema is outdated and has to be migrated")
+	ErrBackupExists    = errors.New("backup path already exists")
+)
+
+// Migration converts a database of the previous schema version to its version. A migration that adds an index built
+// from the blocks is a reindex of the blockchain instead of a conversion
+type Migration struct {
+	Version     uint64
+	Description string
+	Migrate     func(db storage.Storage) error
+	Reindex     bool
+}
+
+//migrations are the changes of the database format in the order of their versions. A change of the format is added
+//here as a migration with the next version
+var migrations = []*Migration{
+	{
+		Version:     1,
+		Description: "convert the UTXO index serialized in one value to UTXO lists by public key hash",
+		Migrate:     migrateUTXOIndex,
+	},
+	{
+		Version:     2,
+		Description: "move the contract storage serialized in one value to keyed items",
+		Migrate:     scState.MigrateLegacyState,
+	},
+	{
+		Version:     3,
+		Description: "build the state trie of every block, the transaction index and the address history",
+		Reindex:     true,
+	},
+}
+
+//CurrentVersion returns the schema version of the databases written by this version of the node
+func CurrentVersion() uint64 {
+	return migrations[len(migrations)-1].Version
+}
+
+//GetVersion returns the schema version of the database. A database without version is written by a version of the
+//node before the versioning and has the version 0
+func GetVersion(db storage.Storage) uint64 {
+	rawBytes, err := db.Get(schemaVersionKey)
+	if err != nil || len(rawBytes) != 8 {
+		return 0
+	}
+	return binary.BigEndian.Uint64(rawBytes)
+}
+
+//SetVersion writes the schema version into the database
+func SetVersion(db storage.Storage, version uint64) error {
+	return db.Put(schemaVersionKey, util.UintToHex(version))
+}
+
+//CheckVersion returns an error if the database is not of the current schema version
+func CheckVersion(db storage.Storage) error {
+	version := GetVersion(db)
+	if version > CurrentVersion() {
+		return ErrVersionTooNew
+	}
+	if version < CurrentVersion() {
+		return ErrVersionOutdated
+	}
+	return nil
+}
+
+//GetPendingMigrations returns the migrations to run on the database in order
+func GetPendingMigrations(db storage.Storage) ([]*Migration, error) {
+	version := GetVersion(db)
+	if version > CurrentVersion() {
+		return nil, ErrVersionTooNew
+	}
+	var pending []*Migration
+	for _, migration := range migrations {
+		if migration.Version > version {
+			pending = append(pending, migration)
+		}
+	}
+	return pending, nil
+}
+
+//Migrate brings the database to the current schema version by running the pending migrations in order. The database
+//is copied into a new database of the engine at the backup path before if the path is not empty. Every migration is
+//written with its version in one batch, so that a migration interrupted by a crash is run again on the next start. The
+//reindex migrations run the input reindex on the database, which writes the blocks one by one, and are run again from
+//the start if interrupted, as the version is only written after the reindex
+func Migrate(db storage.Storage, backupEngine string, backupPath string, reindex func(db storage.Storage) error) error {
+	pending, err := GetPendingMigrations(db)
+	if err != nil || len(pending) == 0 {
+		return err
+	}
+
+	logger.WithFields(logger.Fields{
+		"from_version": GetVersion(db),
+		"to_version":   CurrentVersion(),
+	}).Info("Migration: the database schema is outdated.")
+
+	if backupPath != "" {
+		if err := Backup(db, backupEngine, backupPath); err != nil {
+			return err
+		}
+	}
+
+	for i, migration := range pending {
+		migrationLogger := logger.WithFields(logger.Fields{
+			"version":     migration.Version,
+			"description": migration.Description,
+			"step":        i + 1,
+			"num_of_step": len(pending),
+		})
+		migrationLogger.Info("Migration: is migrating the database.")
+		start := time.Now()
+
+		if migration.Reindex {
+			if err := reindex(db); err != nil {
+				migrationLogger.WithError(err).Error("Migration: failed to reindex the database.")
+				return err
+			}
+			if err := SetVersion(db, migration.Version); err != nil {
+				return err
+			}
+			migrationLogger.WithFields(logger.Fields{
+				"elapsed": time.Since(start).String(),
+			}).Info("Migration: reindexed the database.")
+			continue
+		}
+
+		overlay := storage.NewOverlay(db)
+		if err := migration.Migrate(overlay); err != nil {
+			migrationLogger.WithError(err).Error("Migration: failed to migrate the database.")
+			return err
+		}
+		if err := SetVersion(overlay, migration.Version); err != nil {
+			return err
+		}
+		numOfWrite := overlay.Len()
+		if err := overlay.Commit(); err != nil {
+			migrationLogger.WithError(err).Error("Migration: failed to write the migrated database.")
+			return err
+		}
+
+		migrationLogger.WithFields(logger.Fields{
+			"num_of_write": numOfWrite,
+			"elapsed":      time.Since(start).String(),
+		}).Info("Migration: migrated the database.")
+	}
+	return nil
+}
+
+//Backup copies all keys of the database into a new database of the engine at the path
+func Backup(db storage.Storage, engine string, path string) error {
+	if _, err := os.Stat(path); err == nil {
+		return ErrBackupExists
+	}
+	backup, err := storage.OpenStorage(engine, path)
+	if err != nil {
+		return err
+	}
+	defer backup.Close()
+
+	count, err := storage.CopyStorage(db, backup)
+	if err != nil {
+		return err
+	}
+	logger.WithFields(logger.Fields{
+		"path":       path,
+		"num_of_key": count,
+	}).Info("Migration: backed up the database.")
+	return nil
+}
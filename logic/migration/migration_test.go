@@ -0,0 +1,126 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package migration
+
+import (
+	"bytes"
+	"encoding/gob"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMigrate(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	//a database written before the versioning with the legacy UTXO index and contract storage
+	address := "dastXXWLe5pxbRYFhcyUq8T3wb5srWkHKa"
+	pubKeyHash := account.NewTransactionAccountByAddress(account.NewAddress(address)).GetPubKeyHash()
+	txid1, _ := hex.DecodeString("948c984f0cdcefc4f977efcd93ae37360cc5165dfc3657f07e72306cd0e6a354")
+	txid2, _ := hex.DecodeString("4fef1c385b0cbda4092cfe245329bb18e580480e07a880ebcefe1fa7e24a089f")
+	legacyIndex := map[string][]*utxo.UTXO{
+		address: {
+			{TXOutput: transactionbase.TXOutput{Value: transaction.Subsidy, PubKeyHash: pubKeyHash}, Txid: txid1, UtxoType: utxo.UtxoNormal},
+			{TXOutput: transactionbase.TXOutput{Value: transaction.Subsidy, PubKeyHash: pubKeyHash}, Txid: txid2, UtxoType: utxo.UtxoNormal},
+		},
+	}
+	var encoded bytes.Buffer
+	require.Nil(t, gob.NewEncoder(&encoded).Encode(legacyIndex))
+	require.Nil(t, db.Put([]byte(legacyUTXOIndexKey), encoded.Bytes()))
+	legacyState := scState.NewScState()
+	legacyState.Set("contract", "key", "value")
+	rawBytes, err := proto.Marshal(legacyState.ToProto())
+	require.Nil(t, err)
+	require.Nil(t, db.Put([]byte("scState"), rawBytes))
+
+	assert.EqualValues(t, 0, GetVersion(db))
+	assert.Equal(t, ErrVersionOutdated, CheckVersion(db))
+	pending, err := GetPendingMigrations(db)
+	require.Nil(t, err)
+	assert.Len(t, pending, len(migrations))
+
+	backupPath := filepath.Join(os.TempDir(), "migration_test_backup.db")
+	defer os.RemoveAll(backupPath)
+	numOfReindex := 0
+	reindex := func(reindexed storage.Storage) error {
+		//the blockchain is reindexed after the conversions
+		assert.Equal(t, "value", scState.LoadScStateFromDatabase(reindexed).Get("contract", "key"))
+		numOfReindex++
+		return nil
+	}
+	require.Nil(t, Migrate(db, storage.LevelDBEngine, backupPath, reindex))
+	assert.Equal(t, CurrentVersion(), GetVersion(db))
+	assert.Equal(t, 1, numOfReindex)
+	assert.Nil(t, CheckVersion(db))
+
+	utxos := lutxo.NewUTXOIndex(utxo.NewUTXOCache(db)).GetAllUTXOsByPubKeyHash(pubKeyHash)
+	assert.Equal(t, 2, utxos.Size())
+	_, err = db.Get([]byte(legacyUTXOIndexKey))
+	assert.Equal(t, storage.ErrKeyInvalid, err)
+	assert.Equal(t, "value", scState.LoadScStateFromDatabase(db).Get("contract", "key"))
+
+	//the backup keeps the database before the migrations
+	backup, err := storage.OpenStorage(storage.LevelDBEngine, backupPath)
+	require.Nil(t, err)
+	assert.EqualValues(t, 0, GetVersion(backup))
+	_, err = backup.Get([]byte(legacyUTXOIndexKey))
+	assert.Nil(t, err)
+	backup.Close()
+
+	//a migrated database is not migrated again and an existing backup is not overwritten
+	assert.Nil(t, Migrate(db, storage.LevelDBEngine, backupPath, reindex))
+	assert.Equal(t, 1, numOfReindex)
+	require.Nil(t, SetVersion(db, 0))
+	assert.Equal(t, ErrBackupExists, Migrate(db, storage.LevelDBEngine, backupPath, reindex))
+}
+
+func TestMigrate_ReindexFailed(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	//the version is not written if the reindex fails, so that it is run again
+	require.Nil(t, SetVersion(db, 2))
+	reindexErr := errors.New("reindex failed")
+	assert.Equal(t, reindexErr, Migrate(db, "", "", func(storage.Storage) error { return reindexErr }))
+	assert.EqualValues(t, 2, GetVersion(db))
+}
+
+func TestMigrate_VersionTooNew(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	require.Nil(t, SetVersion(db, CurrentVersion()+1))
+	assert.Equal(t, ErrVersionTooNew, CheckVersion(db))
+	assert.Equal(t, ErrVersionTooNew, Migrate(db, "", "", nil))
+	assert.Equal(t, CurrentVersion()+1, GetVersion(db))
+}
@@ -0,0 +1,74 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package migration
+
+import (
+	"bytes"
+	"encoding/gob"
+
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/storage"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	//legacyUTXOIndexKey is the key of the UTXO index serialized in one value by earlier versions
+	legacyUTXOIndexKey = "utxo"
+	//legacyContractUtxoKey is the address of the contract creation UTXOs in the legacy UTXO index
+	legacyContractUtxoKey = "ContractUtxos"
+)
+
+//migrateUTXOIndex adds the UTXOs of the legacy UTXO index, which maps the addresses to their UTXOs, to the UTXO lists of
+//their public key hashes and removes the legacy index
+func migrateUTXOIndex(db storage.Storage) error {
+	rawBytes, err := db.Get([]byte(legacyUTXOIndexKey))
+	if err != nil || len(rawBytes) == 0 {
+		return nil
+	}
+
+	legacyIndex := make(map[string][]*utxo.UTXO)
+	if err := gob.NewDecoder(bytes.NewReader(rawBytes)).Decode(&legacyIndex); err != nil {
+		return err
+	}
+
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(db))
+	numOfUtxo := 0
+	for address, utxos := range legacyIndex {
+		if address == legacyContractUtxoKey {
+			continue
+		}
+		for _, u := range utxos {
+			utxoIndex.AddUTXO(u.TXOutput, u.Txid, u.TxIndex)
+			numOfUtxo++
+		}
+	}
+	if err := utxoIndex.Save(); err != nil {
+		return err
+	}
+	if err := db.Del([]byte(legacyUTXOIndexKey)); err != nil {
+		return err
+	}
+
+	logger.WithFields(logger.Fields{
+		"num_of_address": len(legacyIndex),
+		"num_of_utxo":    numOfUtxo,
+	}).Info("Migration: converted the legacy UTXO index.")
+	return nil
+}
@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.23.0
+// 	protoc        v3.13.0
+// source: github.com/dappley/go-dappley/logic/snapshot/pb/snapshot.proto
+
+package snapshotpb
+
+import (
+	pb "github.com/dappley/go-dappley/core/block/pb"
+	pb2 "github.com/dappley/go-dappley/core/scState/pb"
+	pb1 "github.com/dappley/go-dappley/core/utxo/pb"
+	proto "github.com/golang/protobuf/proto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version        uint32             `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	Genesis        *pb.Block          `protobuf:"bytes,2,opt,name=genesis,proto3" json:"genesis,omitempty"`
+	Headers        []*pb.SignedHeader `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	Block          *pb.Block          `protobuf:"bytes,4,opt,name=block,proto3" json:"block,omitempty"`
+	Utxos          []*pb1.Utxo        `protobuf:"bytes,5,rep,name=utxos,proto3" json:"utxos,omitempty"`
+	ScState        *pb2.ScState       `protobuf:"bytes,6,opt,name=sc_state,json=scState,proto3" json:"sc_state,omitempty"`
+	ElectionBlocks []*pb.Block        `protobuf:"bytes,9,rep,name=election_blocks,json=electionBlocks,proto3" json:"election_blocks,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Snapshot) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Snapshot) GetGenesis() *pb.Block {
+	if x != nil {
+		return x.Genesis
+	}
+	return nil
+}
+
+func (x *Snapshot) GetHeaders() []*pb.SignedHeader {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Snapshot) GetBlock() *pb.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+func (x *Snapshot) GetUtxos() []*pb1.Utxo {
+	if x != nil {
+		return x.Utxos
+	}
+	return nil
+}
+
+func (x *Snapshot) GetScState() *pb2.ScState {
+	if x != nil {
+		return x.ScState
+	}
+	return nil
+}
+
+func (x *Snapshot) GetElectionBlocks() []*pb.Block {
+	if x != nil {
+		return x.ElectionBlocks
+	}
+	return nil
+}
+
+var File_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto protoreflect.FileDescriptor
+
+var file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDesc = []byte{
+	0x0a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2f, 0x70,
+	0x62, 0x2f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x0a, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x70, 0x62, 0x1a, 0x37, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
+	0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70,
+	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f, 0x70,
+	0x62, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3b, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
+	0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x73, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x70, 0x62, 0x2f, 0x73, 0x63, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbd, 0x02, 0x0a, 0x08, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x07, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0c, 0x2e, 0x75, 0x74, 0x78, 0x6f, 0x70, 0x62, 0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05,
+	0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x2d, 0x0a, 0x08, 0x73, 0x63, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x63, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x70, 0x62, 0x2e, 0x53, 0x63, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x73, 0x63, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x0f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x0e, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x4a, 0x04, 0x08,
+	0x07, 0x10, 0x08, 0x4a, 0x04, 0x08, 0x08, 0x10, 0x09, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescOnce sync.Once
+	file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescData = file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDesc
+)
+
+func file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescGZIP() []byte {
+	file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescOnce.Do(func() {
+		file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescData = protoimpl.X.CompressGZIP(file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescData)
+	})
+	return file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDescData
+}
+
+var file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_goTypes = []interface{}{
+	(*Snapshot)(nil),        // 0: snapshotpb.Snapshot
+	(*pb.Block)(nil),        // 1: blockpb.Block
+	(*pb.SignedHeader)(nil), // 2: blockpb.SignedHeader
+	(*pb1.Utxo)(nil),        // 3: utxopb.Utxo
+	(*pb2.ScState)(nil),     // 4: scstatepb.ScState
+}
+var file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_depIdxs = []int32{
+	1, // 0: snapshotpb.Snapshot.genesis:type_name -> blockpb.Block
+	2, // 1: snapshotpb.Snapshot.headers:type_name -> blockpb.SignedHeader
+	1, // 2: snapshotpb.Snapshot.block:type_name -> blockpb.Block
+	3, // 3: snapshotpb.Snapshot.utxos:type_name -> utxopb.Utxo
+	4, // 4: snapshotpb.Snapshot.sc_state:type_name -> scstatepb.ScState
+	1, // 5: snapshotpb.Snapshot.election_blocks:type_name -> blockpb.Block
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_init() }
+func file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_init() {
+	if File_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_goTypes,
+		DependencyIndexes: file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_depIdxs,
+		MessageInfos:      file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_msgTypes,
+	}.Build()
+	File_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto = out.File
+	file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_rawDesc = nil
+	file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_goTypes = nil
+	file_github_com_dappley_go_dappley_logic_snapshot_pb_snapshot_proto_depIdxs = nil
+}
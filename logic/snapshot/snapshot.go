@@ -0,0 +1,472 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package snapshot
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"sort"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/scState"
+	scstatepb "github.com/dappley/go-dappley/core/scState/pb"
+	"github.com/dappley/go-dappley/core/state"
+	"github.com/dappley/go-dappley/core/transaction"
+	"github.com/dappley/go-dappley/core/transactionbase"
+	"github.com/dappley/go-dappley/core/utxo"
+	utxopb "github.com/dappley/go-dappley/core/utxo/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	snapshotpb "github.com/dappley/go-dappley/logic/snapshot/pb"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/golang/protobuf/proto"
+	logger "github.com/sirupsen/logrus"
+)
+
+const snapshotVersion = 2
+
+var (
+	ErrHeightAboveLIB      = errors.New("snapshot: height is above the LIB")
+	ErrVersionNotSupported = errors.New("snapshot: version not supported")
+	ErrBlockHashInvalid    = errors.New("snapshot: block hash does not match the block")
+	ErrGenesisNotLinked    = errors.New("snapshot: headers do not link to the genesis block")
+	ErrGenesisMismatch     = errors.New("snapshot: genesis block is not the local genesis block")
+	ErrHeadersIncomplete   = errors.New("snapshot: headers do not cover every height below the block")
+	ErrUntrustedBlock      = errors.New("snapshot: block is not the trusted block at the snapshot height")
+	ErrTrustedHashMissing  = errors.New("snapshot: the hash of the trusted block is required")
+	ErrStateRootMismatch   = errors.New("snapshot: state does not match the state root of the block")
+	ErrStateRootMissing    = errors.New("snapshot: block does not carry its state root")
+)
+
+// Snapshot is the state of the blockchain after a block at or below the LIB. It holds the UTXO set and the contract
+// storage after the block, with the headers from the genesis block to the block and the blocks whose votes are
+// standing in the election. A node that imports a snapshot starts with the block as its tail and LIB, and syncs the
+// blocks after it from its peers.
+type Snapshot struct {
+	genesis        *block.Block
+	headers        []*block.SignedHeader
+	blk            *block.Block
+	utxos          []*utxo.UTXO
+	scState        *scState.ScState
+	electionBlocks []*block.Block
+}
+
+// snapshotChain is the chain of the headers in a snapshot. The blocks of the election are returned with their bodies and
+// the other blocks below the block of the snapshot without transactions
+type snapshotChain struct {
+	s              *Snapshot
+	electionBlocks map[uint64]*block.Block
+}
+
+//Export returns the snapshot of the blockchain after the block at the input height. The election is optional. The
+//bodies of the blocks that changed its tally must not be pruned, since the importer tallies the votes again. The
+//block has to carry its state root, so that the state can be verified by the importer
+func Export(bc *lblockchain.Blockchain, height uint64, election *consensus.Election) (*Snapshot, error) {
+	if height > bc.GetLIBHeight() {
+		return nil, ErrHeightAboveLIB
+	}
+	blk, err := bc.GetBlockByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	if len(blk.GetStateRoot()) == 0 {
+		return nil, ErrStateRootMissing
+	}
+	genesis, err := bc.GetBlockByHeight(0)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Snapshot{genesis: genesis, blk: blk}
+	for h := uint64(1); h < height; h++ {
+		headerBlk, err := bc.GetBlockByHeight(h)
+		if err != nil {
+			return nil, err
+		}
+		s.headers = append(s.headers, bc.GetSignedHeader(headerBlk))
+	}
+
+	utxoIndex, contractState, err := lblockchain.RevertUtxoAndScStateAtBlockHash(bc.GetDb(), bc, blk.GetHash())
+	if err != nil {
+		return nil, err
+	}
+	pubKeyHashes, err := getOwners(bc.GetDb(), blk, contractState)
+	if err != nil {
+		return nil, err
+	}
+	for _, pubKeyHash := range pubKeyHashes {
+		utxos := utxoIndex.GetAllUTXOsByPubKeyHash(pubKeyHash).GetAllUtxos()
+		sort.Slice(utxos, func(i, j int) bool {
+			return utxos[i].GetUTXOKey() < utxos[j].GetUTXOKey()
+		})
+		s.utxos = append(s.utxos, utxos...)
+	}
+	s.scState = contractState
+
+	if election != nil {
+		checkpoint, err := election.GetCheckpoint(height)
+		if err != nil {
+			return nil, err
+		}
+		//the genesis block and the block of the snapshot are exported with their bodies anyway
+		for _, h := range checkpoint.GetVoteHeights() {
+			if h == 0 || h >= height {
+				continue
+			}
+			if bc.IsPruned(h) {
+				return nil, lblockchain.ErrBlockPruned
+			}
+			electionBlk, err := bc.GetBlockByHeight(h)
+			if err != nil {
+				return nil, err
+			}
+			s.electionBlocks = append(s.electionBlocks, electionBlk)
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"height":    height,
+		"hash":      blk.GetHash().String(),
+		"num_utxos": len(s.utxos),
+	}).Info("Snapshot: exported the blockchain state.")
+	return s, nil
+}
+
+//Import verifies the snapshot and writes it into a database without blockchain. The genesis block of the snapshot has
+//to be the local genesis block, the block has to be the trusted block and the state in the snapshot has to match the
+//state root in its header. Unless the dynasty is nil, as it is for instantly sealed blocks, the headers are verified
+//against the producers elected by the votes in the blocks of the snapshot, and the checkpoint of the election is
+//tallied again from these blocks instead of being taken from the snapshot. The votes in the last epoch before the block
+//only elect the producers of the blocks after it, so they are only as trustworthy as the trusted hash
+func Import(db storage.Storage, s *Snapshot, genesis *block.Block, trustedHash hash.Hash, forks *block.Forks, dynasty *consensus.Dynasty, schedule *consensus.DynastySchedule, epochLength uint64) error {
+	if len(trustedHash) == 0 {
+		return ErrTrustedHashMissing
+	}
+	if err := s.verifyBlocks(genesis, trustedHash, forks); err != nil {
+		return err
+	}
+
+	var election *consensus.Election
+	if dynasty != nil {
+		chain, err := s.newSnapshotChain(forks)
+		if err != nil {
+			return err
+		}
+		if schedule == nil {
+			schedule = consensus.NewDynastySchedule(nil, dynasty.GetProducers())
+		}
+		election = consensus.NewElection(chain, epochLength, dynasty.GetMaxProducers(), schedule)
+		verifier := consensus.NewHeaderVerifier(dynasty, schedule, chain)
+		verifier.SetForks(forks)
+		verifier.SetElection(election)
+		for _, header := range s.headers {
+			if err := verifier.Verify(header); err != nil {
+				return err
+			}
+		}
+		if s.blk.GetHeight() > 0 {
+			if err := verifier.Verify(lblock.NewSignedHeader(s.blk, forks)); err != nil {
+				return err
+			}
+		}
+	}
+
+	//the trie nodes of the state are kept in the database for the state roots of the next blocks
+	stateTrie, err := state.NewStateTrie(nil, db)
+	if err != nil {
+		return err
+	}
+	for _, u := range s.utxos {
+		if err := stateTrie.PutUTXO(u.Txid, u.TxIndex, &u.TXOutput); err != nil {
+			return err
+		}
+	}
+	if err := stateTrie.ApplyContractStorage(scState.NewScState().GetChanges(s.scState)); err != nil {
+		return err
+	}
+	if len(s.blk.GetStateRoot()) == 0 {
+		return ErrStateRootMissing
+	}
+	if !bytes.Equal(stateTrie.RootHash(), s.blk.GetStateRoot()) {
+		return ErrStateRootMismatch
+	}
+
+	var checkpoint *consensus.ElectionCheckpoint
+	if election != nil {
+		if checkpoint, err = election.GetCheckpoint(s.blk.GetHeight()); err != nil {
+			return err
+		}
+		//the stakes of the standing votes have to be unspent after the block
+		if err := checkpoint.VerifyStakes(s.utxos); err != nil {
+			return err
+		}
+	}
+
+	if err := state.SaveStateRoot(db, s.blk.GetHash(), s.blk.GetStateRoot()); err != nil {
+		return err
+	}
+	if err := s.saveTxJournals(db); err != nil {
+		return err
+	}
+	utxoIndex := lutxo.NewUTXOIndex(utxo.NewUTXOCache(db))
+	utxoIndex.SetIndexAdd(s.getUtxosByOwner())
+	if err := lblockchain.RestoreBlockchain(db, s.genesis, s.headers, s.blk, utxoIndex, s.scState); err != nil {
+		return err
+	}
+	if checkpoint != nil {
+		if err := checkpoint.Save(db); err != nil {
+			return err
+		}
+	}
+
+	logger.WithFields(logger.Fields{
+		"height":    s.blk.GetHeight(),
+		"hash":      s.blk.GetHash().String(),
+		"num_utxos": len(s.utxos),
+	}).Info("Snapshot: imported the blockchain state.")
+	return nil
+}
+
+//GetBlock returns the block after which the state is taken
+func (s *Snapshot) GetBlock() *block.Block {
+	return s.blk
+}
+
+//verifyBlocks checks the hashes of the blocks in the snapshot and that the headers link the local genesis block to the
+//block at every height in between
+func (s *Snapshot) verifyBlocks(genesis *block.Block, trustedHash hash.Hash, forks *block.Forks) error {
+	if s.genesis == nil || s.blk == nil || s.scState == nil {
+		return ErrBlockHashInvalid
+	}
+	if s.genesis.GetHeight() != 0 || !lblock.VerifyHash(s.genesis, forks) || !lblock.VerifyHash(s.blk, forks) {
+		return ErrBlockHashInvalid
+	}
+	if !s.genesis.GetHash().Equals(genesis.GetHash()) {
+		return ErrGenesisMismatch
+	}
+	if !s.blk.GetHash().Equals(trustedHash) {
+		return ErrUntrustedBlock
+	}
+
+	if s.blk.GetHeight() == 0 {
+		if !s.blk.GetHash().Equals(s.genesis.GetHash()) {
+			return ErrGenesisNotLinked
+		}
+		return nil
+	}
+	if uint64(len(s.headers)) != s.blk.GetHeight()-1 {
+		return ErrHeadersIncomplete
+	}
+	prevHash := s.genesis.GetHash()
+	for i, header := range s.headers {
+		if header.Height != uint64(i)+1 {
+			return ErrHeadersIncomplete
+		}
+		if !header.PrevHash.Equals(prevHash) {
+			return ErrGenesisNotLinked
+		}
+		prevHash = header.Hash()
+	}
+	if !s.blk.GetPrevHash().Equals(prevHash) {
+		return ErrGenesisNotLinked
+	}
+	return nil
+}
+
+//newSnapshotChain returns the chain of the verified headers of the snapshot. The blocks of the election have to match
+//the headers at their heights
+func (s *Snapshot) newSnapshotChain(forks *block.Forks) (*snapshotChain, error) {
+	chain := &snapshotChain{s: s, electionBlocks: make(map[uint64]*block.Block)}
+	for _, blk := range s.electionBlocks {
+		height := blk.GetHeight()
+		if height == 0 || height >= s.blk.GetHeight() || !lblock.VerifyHash(blk, forks) {
+			return nil, ErrBlockHashInvalid
+		}
+		if !blk.GetHash().Equals(s.headers[height-1].Hash()) {
+			return nil, ErrBlockHashInvalid
+		}
+		chain.electionBlocks[height] = blk
+	}
+	return chain, nil
+}
+
+func (chain *snapshotChain) GetMaxHeight() uint64 {
+	return chain.s.blk.GetHeight()
+}
+
+func (chain *snapshotChain) GetBlockByHeight(height uint64) (*block.Block, error) {
+	switch {
+	case height == 0:
+		return chain.s.genesis, nil
+	case height == chain.s.blk.GetHeight():
+		return chain.s.blk, nil
+	case height > chain.s.blk.GetHeight():
+		return nil, lblockchain.ErrBlockDoesNotExist
+	}
+	if blk, ok := chain.electionBlocks[height]; ok {
+		return blk, nil
+	}
+	return block.NewHeaderOnlyBlock(chain.s.headers[height-1]), nil
+}
+
+//saveTxJournals saves the outputs of the UTXOs by transaction, so that the blocks after the snapshot can be rolled back
+func (s *Snapshot) saveTxJournals(db storage.Storage) error {
+	vouts := make(map[string][]transactionbase.TXOutput)
+	for _, u := range s.utxos {
+		txVouts := vouts[string(u.Txid)]
+		for len(txVouts) <= u.TxIndex {
+			txVouts = append(txVouts, transactionbase.TXOutput{})
+		}
+		txVouts[u.TxIndex] = u.TXOutput
+		vouts[string(u.Txid)] = txVouts
+	}
+	for txid, txVouts := range vouts {
+		if err := transaction.NewTxJournal([]byte(txid), txVouts).Save(db); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Snapshot) getUtxosByOwner() map[string]*utxo.UTXOTx {
+	utxosByOwner := make(map[string]*utxo.UTXOTx)
+	for _, u := range s.utxos {
+		utxoTx, ok := utxosByOwner[u.PubKeyHash.String()]
+		if !ok {
+			newUtxoTx := utxo.NewUTXOTx()
+			utxoTx = &newUtxoTx
+			utxosByOwner[u.PubKeyHash.String()] = utxoTx
+		}
+		utxoTx.PutUtxo(&utxo.UTXO{TXOutput: u.TXOutput, Txid: u.Txid, TxIndex: u.TxIndex, UtxoType: u.UtxoType, NextUtxoKey: []byte{}})
+	}
+	return utxosByOwner
+}
+
+//getOwners returns the public key hashes of the unspent outputs in the state trie of the block in a fixed order. The
+//contract storage after the block is skipped in the trie
+func getOwners(db storage.Storage, blk *block.Block, contractState *scState.ScState) ([]account.PubKeyHash, error) {
+	stateTrie, err := state.NewStateTrie(blk.GetStateRoot(), db)
+	if err != nil {
+		return nil, err
+	}
+	txouts, err := stateTrie.GetUTXOOutputs(scState.NewScState().GetChanges(contractState))
+	if err != nil {
+		return nil, err
+	}
+
+	owners := make(map[string]bool)
+	pubKeyHashes := []account.PubKeyHash{}
+	for _, txout := range txouts {
+		if owners[string(txout.PubKeyHash)] {
+			continue
+		}
+		owners[string(txout.PubKeyHash)] = true
+		pubKeyHashes = append(pubKeyHashes, txout.PubKeyHash)
+	}
+	sort.Slice(pubKeyHashes, func(i, j int) bool {
+		return bytes.Compare(pubKeyHashes[i], pubKeyHashes[j]) < 0
+	})
+	return pubKeyHashes, nil
+}
+
+//SaveToFile writes the snapshot into the file
+func (s *Snapshot) SaveToFile(path string) error {
+	rawBytes, err := proto.Marshal(s.ToProto())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(path, rawBytes, 0644)
+}
+
+//LoadFromFile reads the snapshot from the file
+func LoadFromFile(path string) (*Snapshot, error) {
+	rawBytes, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	snapshotPb := &snapshotpb.Snapshot{}
+	if err := proto.Unmarshal(rawBytes, snapshotPb); err != nil {
+		return nil, err
+	}
+	if snapshotPb.GetVersion() != snapshotVersion {
+		return nil, ErrVersionNotSupported
+	}
+	s := &Snapshot{}
+	s.FromProto(snapshotPb)
+	return s, nil
+}
+
+func (s *Snapshot) ToProto() proto.Message {
+	snapshotPb := &snapshotpb.Snapshot{
+		Version: snapshotVersion,
+		Genesis: s.genesis.ToProto().(*blockpb.Block),
+		Block:   s.blk.ToProto().(*blockpb.Block),
+		ScState: s.scState.ToProto().(*scstatepb.ScState),
+	}
+	for _, header := range s.headers {
+		snapshotPb.Headers = append(snapshotPb.Headers, header.ToProto().(*blockpb.SignedHeader))
+	}
+	for _, u := range s.utxos {
+		snapshotPb.Utxos = append(snapshotPb.Utxos, u.ToProto().(*utxopb.Utxo))
+	}
+	for _, blk := range s.electionBlocks {
+		snapshotPb.ElectionBlocks = append(snapshotPb.ElectionBlocks, blk.ToProto().(*blockpb.Block))
+	}
+	return snapshotPb
+}
+
+func (s *Snapshot) FromProto(pb proto.Message) {
+	snapshotPb := pb.(*snapshotpb.Snapshot)
+	if snapshotPb.GetGenesis() != nil {
+		s.genesis = &block.Block{}
+		s.genesis.FromProto(snapshotPb.GetGenesis())
+	}
+	if snapshotPb.GetBlock() != nil {
+		s.blk = &block.Block{}
+		s.blk.FromProto(snapshotPb.GetBlock())
+	}
+	s.headers = []*block.SignedHeader{}
+	for _, headerPb := range snapshotPb.GetHeaders() {
+		header := &block.SignedHeader{}
+		header.FromProto(headerPb)
+		s.headers = append(s.headers, header)
+	}
+	s.utxos = []*utxo.UTXO{}
+	for _, utxoPb := range snapshotPb.GetUtxos() {
+		u := &utxo.UTXO{}
+		u.FromProto(utxoPb)
+		s.utxos = append(s.utxos, u)
+	}
+	if snapshotPb.GetScState() != nil {
+		s.scState = scState.NewScState()
+		s.scState.FromProto(snapshotPb.GetScState())
+	}
+	s.electionBlocks = []*block.Block{}
+	for _, blkPb := range snapshotPb.GetElectionBlocks() {
+		blk := &block.Block{}
+		blk.FromProto(blkPb)
+		s.electionBlocks = append(s.electionBlocks, blk)
+	}
+}
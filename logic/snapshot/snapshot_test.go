@@ -0,0 +1,178 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package snapshot
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/core/account"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/utxo"
+	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lblockchain/mocks"
+	"github.com/dappley/go-dappley/logic/lutxo"
+	"github.com/dappley/go-dappley/logic/transactionpool"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const minerAddress = "16PencPNnF8CiSx2EBGEd1axhf7vuHCouj"
+
+func TestExportImport(t *testing.T) {
+	//the LIB is at height 4
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(10)
+	libBlk, err := bc.GetLIB()
+	require.Nil(t, err)
+	require.EqualValues(t, 4, libBlk.GetHeight())
+
+	_, err = Export(bc, 5, nil)
+	assert.Equal(t, ErrHeightAboveLIB, err)
+
+	s, err := Export(bc, 4, nil)
+	require.Nil(t, err)
+	genesis, err := bc.GetBlockByHeight(0)
+	require.Nil(t, err)
+	assert.Equal(t, libBlk.GetHash(), s.GetBlock().GetHash())
+	//the headers link the genesis block to the block
+	assert.Len(t, s.headers, 3)
+	assert.EqualValues(t, 1, s.headers[0].Height)
+	//the genesis block and the four blocks each pay the miner once
+	assert.Len(t, s.utxos, 5)
+
+	path := filepath.Join(os.TempDir(), "snapshot_test.dat")
+	require.Nil(t, s.SaveToFile(path))
+	defer os.Remove(path)
+	loaded, err := LoadFromFile(path)
+	require.Nil(t, err)
+
+	_, err = LoadFromFile(filepath.Join(os.TempDir(), "snapshot_test_missing.dat"))
+	assert.NotNil(t, err)
+	require.Nil(t, ioutil.WriteFile(path+".bad", []byte{0x08, 0x01}, 0644))
+	defer os.Remove(path + ".bad")
+	_, err = LoadFromFile(path + ".bad")
+	assert.Equal(t, ErrVersionNotSupported, err)
+
+	db := storage.NewRamStorage()
+	defer db.Close()
+	assert.Equal(t, ErrTrustedHashMissing, Import(db, loaded, genesis, nil, nil, nil, nil, 0))
+	otherGenesis := lblockchain.NewGenesisBlock(account.NewAddress(minerAddress), common.NewAmount(1))
+	assert.Equal(t, ErrGenesisMismatch, Import(db, loaded, otherGenesis, libBlk.GetHash(), nil, nil, nil, 0))
+	assert.Equal(t, ErrUntrustedBlock, Import(db, loaded, genesis, bc.GetTailBlockHash(), nil, nil, nil, 0))
+	require.Nil(t, Import(db, loaded, genesis, libBlk.GetHash(), nil, nil, nil, 0))
+	assert.Equal(t, lblockchain.ErrBlockchainExists, Import(db, loaded, genesis, libBlk.GetHash(), nil, nil, nil, 0))
+
+	libPolicy := &mocks.LIBPolicy{}
+	libPolicy.On("GetProducers").Return(nil)
+	libPolicy.On("GetMinConfirmationNum").Return(6)
+	libPolicy.On("IsBypassingLibCheck").Return(true)
+	imported, err := lblockchain.GetBlockchain(db, libPolicy, transactionpool.NewTransactionPool(nil, 128000), nil, 100000)
+	require.Nil(t, err)
+	assert.Equal(t, libBlk.GetHash(), imported.GetTailBlockHash())
+	assert.Equal(t, libBlk.GetHash(), imported.GetLIBHash())
+	assert.True(t, imported.IsPruned(3))
+
+	pubKeyHash := account.NewTransactionAccountByAddress(account.NewAddress(minerAddress)).GetPubKeyHash()
+	utxos := lutxo.NewUTXOIndex(utxo.NewUTXOCache(db)).GetAllUTXOsByPubKeyHash(pubKeyHash)
+	assert.Equal(t, 5, utxos.Size())
+
+	//the imported node keeps extending the chain
+	lblockchain.AddBlockToGeneratedBlockchain(imported, 2)
+	assert.EqualValues(t, 6, imported.GetMaxHeight())
+	utxos = lutxo.NewUTXOIndex(utxo.NewUTXOCache(db)).GetAllUTXOsByPubKeyHash(pubKeyHash)
+	assert.Equal(t, 7, utxos.Size())
+}
+
+func TestImport_StateRootMismatch(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(10)
+	s, err := Export(bc, bc.GetLIBHeight(), nil)
+	require.Nil(t, err)
+	genesis, err := bc.GetBlockByHeight(0)
+	require.Nil(t, err)
+
+	s.utxos[0].Value = common.NewAmount(1)
+	db := storage.NewRamStorage()
+	defer db.Close()
+	assert.Equal(t, ErrStateRootMismatch, Import(db, s, genesis, bc.GetLIBHash(), nil, nil, nil, 0))
+	_, err = lblockchain.GetBlockchain(db, nil, nil, nil, 100000)
+	assert.NotNil(t, err)
+}
+
+func TestImport_BlockHashInvalid(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(10)
+	s, err := Export(bc, bc.GetLIBHeight(), nil)
+	require.Nil(t, err)
+	genesis, err := bc.GetBlockByHeight(0)
+	require.Nil(t, err)
+
+	s.blk.SetStateRoot([]byte("fake state root"))
+	db := storage.NewRamStorage()
+	defer db.Close()
+	assert.Equal(t, ErrBlockHashInvalid, Import(db, s, genesis, bc.GetLIBHash(), nil, nil, nil, 0))
+}
+
+func TestImport_HeadersNotLinked(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(10)
+	s, err := Export(bc, bc.GetLIBHeight(), nil)
+	require.Nil(t, err)
+	genesis, err := bc.GetBlockByHeight(0)
+	require.Nil(t, err)
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	headers := s.headers
+	s.headers = headers[1:]
+	assert.Equal(t, ErrHeadersIncomplete, Import(db, s, genesis, bc.GetLIBHash(), nil, nil, nil, 0))
+
+	s.headers = append([]*block.SignedHeader{}, headers...)
+	forged := *s.headers[0]
+	forged.PrevHash = s.blk.GetHash()
+	s.headers[0] = &forged
+	assert.Equal(t, ErrGenesisNotLinked, Import(db, s, genesis, bc.GetLIBHash(), nil, nil, nil, 0))
+}
+
+func TestImport_VerifyHeaders(t *testing.T) {
+	bc := lblockchain.GenerateMockBlockchainWithCoinbaseTxOnly(10)
+	s, err := Export(bc, bc.GetLIBHeight(), nil)
+	require.Nil(t, err)
+	genesis, err := bc.GetBlockByHeight(0)
+	require.Nil(t, err)
+	db := storage.NewRamStorage()
+	defer db.Close()
+	dynasty := consensus.NewDynasty([]string{minerAddress}, 1, 15)
+
+	//the blocks of the election have to match the headers
+	electionBlk, err := bc.GetBlockByHeight(2)
+	require.Nil(t, err)
+	electionBlk.SetStateRoot([]byte("fake state root"))
+	s.electionBlocks = []*block.Block{electionBlk}
+	assert.Equal(t, ErrBlockHashInvalid, Import(db, s, genesis, bc.GetLIBHash(), nil, dynasty, nil, 0))
+
+	//the headers have to be signed by the dynasty. The mock blocks are not stamped at the start of their slots
+	s.electionBlocks = nil
+	forks := &block.Forks{SlotTimestampHeight: 100}
+	assert.Equal(t, consensus.ErrHeaderInvalidSignature, Import(db, s, genesis, bc.GetLIBHash(), forks, dynasty, nil, 0))
+	_, err = lblockchain.GetBlockchain(db, nil, nil, nil, 100000)
+	assert.NotNil(t, err)
+}
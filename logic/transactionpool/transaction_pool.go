@@ -175,8 +175,9 @@ func (txPool *TransactionPool) GetAllTransactions() []*transaction.Transaction {
 	return txs
 }
 
-//PopTransactionWithMostTips pops the transactions with the most tips
-func (txPool *TransactionPool) PopTransactionWithMostTips(utxoIndex *lutxo.UTXOIndex) *transaction.TransactionNode {
+//PopTransactionWithMostTips pops the transactions with the most tips. The transaction is dropped with its children if it
+//is not valid on the UTXO index or not signed for the chain id
+func (txPool *TransactionPool) PopTransactionWithMostTips(utxoIndex *lutxo.UTXOIndex, chainID uint64) *transaction.TransactionNode {
 	txPool.mutex.Lock()
 	defer txPool.mutex.Unlock()
 
@@ -187,7 +188,7 @@ func (txPool *TransactionPool) PopTransactionWithMostTips(utxoIndex *lutxo.UTXOI
 	//remove the transaction from tip order
 	txPool.tipOrder = txPool.tipOrder[1:]
 
-	if err := ltransaction.VerifyTransaction(utxoIndex, txNode.Value, 0); err == nil {
+	if err := ltransaction.VerifyTransaction(utxoIndex, txNode.Value, 0, chainID); err == nil {
 		txPool.insertChildrenIntoSortedWaitlist(txNode)
 		txPool.removeTransaction(txNode)
 	} else {
@@ -569,6 +570,10 @@ func (txPool *TransactionPool) BroadcastTxHandler(input interface{}) {
 
 	tx := &transaction.Transaction{}
 	tx.FromProto(txpb)
+	//evidence is broadcast on its own and verified by the consensus before it is added to the pool
+	if tx.IsEvidence() {
+		return
+	}
 	//TODO: Check if the transaction is generated from running a smart contract
 	//utxoIndex := lutxo.NewUTXOIndex(n.GetBlockchain().GetUtxoCache())
 	//if tx.IsFromContract(utxoIndex) {
@@ -618,6 +623,9 @@ func (txPool *TransactionPool) BroadcastBatchTxsHandler(input interface{}) {
 		//if tx.IsFromContract(utxoIndex) {
 		//	return
 		//}
+		if tx.IsEvidence() {
+			continue
+		}
 		tx.CreateTime = -1
 		txPool.Push(tx)
 	}
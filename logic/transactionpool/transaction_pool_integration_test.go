@@ -146,38 +146,38 @@ func TestTransactionPool_VerifyDependentTransactions(t *testing.T) {
 	//tx3-tx4-tx5
 
 	// test a transaction whose Vin is from UtxoIndex
-	err1 := ltransaction.VerifyTransaction(utxoIndex, dependentTx2, 0)
+	err1 := ltransaction.VerifyTransaction(utxoIndex, dependentTx2, 0, 0)
 	assert.Nil(t, err1)
 	txPool.Push(*dependentTx2)
 
 	// test a transaction whose Vin is from another transaction in transaction pool
 	utxoIndex2 := *utxoIndex.DeepCopy()
 	utxoIndex2.UpdateUtxos(txPool.GetTransactions())
-	err2 := ltransaction.VerifyTransaction(&utxoIndex2, dependentTx3, 0)
+	err2 := ltransaction.VerifyTransaction(&utxoIndex2, dependentTx3, 0, 0)
 	assert.Nil(t, err2)
 	txPool.Push(*dependentTx3)
 
 	// test a transaction whose Vin is from another two transactions in transaction pool
 	utxoIndex3 := *utxoIndex.DeepCopy()
 	utxoIndex3.UpdateUtxos(txPool.GetTransactions())
-	err3 := ltransaction.VerifyTransaction(&utxoIndex3, dependentTx4, 0)
+	err3 := ltransaction.VerifyTransaction(&utxoIndex3, dependentTx4, 0, 0)
 	assert.Nil(t, err3)
 	txPool.Push(*dependentTx4)
 
 	// test a transaction whose Vin is from another transaction in transaction pool and UtxoIndex
 	utxoIndex4 := *utxoIndex.DeepCopy()
 	utxoIndex4.UpdateUtxos(txPool.GetTransactions())
-	err4 := ltransaction.VerifyTransaction(&utxoIndex4, dependentTx5, 0)
+	err4 := ltransaction.VerifyTransaction(&utxoIndex4, dependentTx5, 0, 0)
 	assert.Nil(t, err4)
 	txPool.Push(*dependentTx5)
 
 	// test UTXOs not found for parent transactions
-	err5 := ltransaction.VerifyTransaction(lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage())), dependentTx3, 0)
+	err5 := ltransaction.VerifyTransaction(lutxo.NewUTXOIndex(utxo.NewUTXOCache(storage.NewRamStorage())), dependentTx3, 0, 0)
 	assert.NotNil(t, err5)
 
 	// test a standalone transaction
 	txPool.Push(tx1)
-	err6 := ltransaction.VerifyTransaction(utxoIndex, &tx1, 0)
+	err6 := ltransaction.VerifyTransaction(utxoIndex, &tx1, 0, 0)
 	assert.NotNil(t, err6)
 }
 
@@ -206,7 +206,7 @@ func TestTransactionPool_PopTransactionsWithMostTipsNoDependency(t *testing.T) {
 	}
 
 	//pop out the transactions with most tips
-	poppedTx := txPool.PopTransactionWithMostTips(utxoIndex)
+	poppedTx := txPool.PopTransactionWithMostTips(utxoIndex, 0)
 	assert.Equal(t, txs[3], poppedTx.Value)
 }
 
@@ -236,7 +236,7 @@ func TestTransactionPool_PopTransactionsWithMostTipsWithDependency(t *testing.T)
 		txs = append(txs, &tx)
 	}
 	//pop out the transactions with most tips. Each tx is about 263 bytes
-	poppedTx := txPool.PopTransactionWithMostTips(utxoIndex)
+	poppedTx := txPool.PopTransactionWithMostTips(utxoIndex, 0)
 
 	//tx 0 should be popped first since it is the parent of all other transactions
 	assert.Equal(t, txs[0], poppedTx.Value)
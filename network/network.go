@@ -81,7 +81,7 @@ func (net *Network) StartNewPingService(interval time.Duration) error {
 
 //Start starts the network
 func (net *Network) Start(listenPort int, privKey crypto.PrivKey) error {
-	host := networkmodel.NewHost(listenPort, privKey, net.streamManager.StreamHandler)
+	host := networkmodel.NewHost(listenPort, privKey, net.streamManager.GetProtocolID(), net.streamManager.StreamHandler)
 	net.streamManager.Start(host)
 	net.connectToAllPeers()
 	net.peerManager.Start()
@@ -7,6 +7,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/protocol"
 	ma "github.com/multiformats/go-multiaddr"
 	logger "github.com/sirupsen/logrus"
 )
@@ -17,11 +18,21 @@ const (
 
 type Host struct {
 	host.Host
-	Info PeerInfo
+	Info       PeerInfo
+	protocolID protocol.ID
 }
 
-//NewHost starts a p2p host with a listening port, network private key and a stream handler
-func NewHost(listenPort int, privKey crypto.PrivKey, handler network.StreamHandler) *Host {
+//GetProtocolID returns the protocol of the network with the genesis block. Peers with another genesis block do not
+//support the protocol and are refused when the stream is negotiated
+func GetProtocolID(genesisHash []byte) protocol.ID {
+	if len(genesisHash) == 0 {
+		return ProtocalName
+	}
+	return protocol.ID(fmt.Sprintf("%s/%x", ProtocalName, genesisHash))
+}
+
+//NewHost starts a p2p host with a listening port, network private key, the protocol of its streams and a stream handler
+func NewHost(listenPort int, privKey crypto.PrivKey, protocolID protocol.ID, handler network.StreamHandler) *Host {
 	h, addrs, err := createBasicHost(listenPort, privKey)
 	if err != nil {
 		logger.WithError(err).Error("Network: Failed to create host.")
@@ -35,17 +46,21 @@ func NewHost(listenPort int, privKey crypto.PrivKey, handler network.StreamHandl
 		return nil
 	}
 
-	h.SetStreamHandler(ProtocalName, handler)
+	h.SetStreamHandler(protocolID, handler)
 
 	return &Host{
 		h,
 		info,
+		protocolID,
 	}
 }
 
 //GetPeerInfo returns the peerInfo of the host
 func (host *Host) GetPeerInfo() PeerInfo { return host.Info }
 
+//GetProtocolID returns the protocol of the streams of the host
+func (host *Host) GetProtocolID() protocol.ID { return host.protocolID }
+
 //create basic host. Returns host object, host address and error
 func createBasicHost(listenPort int, priv crypto.PrivKey) (host.Host, []ma.Multiaddr, error) {
 
@@ -3,6 +3,7 @@ package networkmodel
 type PeerConnectionConfig struct {
 	maxConnectionOutCount int
 	maxConnectionInCount  int
+	genesisHash           []byte
 }
 
 //NewPeerConnectionConfig creates a new NewPeerConnectionConfig instance
@@ -10,6 +11,7 @@ func NewPeerConnectionConfig(maxConnectionOutCount int, maxConnectionInCount int
 	return PeerConnectionConfig{
 		maxConnectionOutCount,
 		maxConnectionInCount,
+		nil,
 	}
 }
 
@@ -30,3 +32,11 @@ func (config *PeerConnectionConfig) SetMaxConnectionOutCount(maxConnectionOutCou
 func (config *PeerConnectionConfig) SetMaxConnectionInCount(maxConnectionInCount int) {
 	config.maxConnectionInCount = maxConnectionInCount
 }
+
+//GetGenesisHash gets the hash of the genesis block that peers have to share
+func (config *PeerConnectionConfig) GetGenesisHash() []byte { return config.genesisHash }
+
+//SetGenesisHash sets the hash of the genesis block that peers have to share
+func (config *PeerConnectionConfig) SetGenesisHash(genesisHash []byte) {
+	config.genesisHash = genesisHash
+}
@@ -44,6 +44,8 @@ const (
 	test_port12
 	test_port13
 	test_port14
+	test_port15
+	test_port16
 )
 
 func initNode(port int, seedPeer networkmodel.PeerInfo, db storage.Storage) (*Node, error) {
@@ -89,6 +91,32 @@ func TestNetwork_AddStream(t *testing.T) {
 	assert.Len(t, n1.network.GetHost().Network().Peerstore().Peers(), 2)
 }
 
+func TestNode_GenesisMismatch(t *testing.T) {
+	db := storage.NewRamStorage()
+	defer db.Close()
+
+	newNode := func(port int, genesisHash []byte) *Node {
+		config := networkmodel.PeerConnectionConfig{}
+		config.SetGenesisHash(genesisHash)
+		n := NewNodeWithConfig(db, config, nil)
+		assert.Nil(t, n.Start(port, ""))
+		return n
+	}
+	n1 := newNode(test_port14, []byte("genesis1"))
+	defer n1.Stop()
+	n2 := newNode(test_port15, []byte("genesis2"))
+	defer n2.Stop()
+	n3 := newNode(test_port16, []byte("genesis1"))
+	defer n3.Stop()
+
+	//a peer with another genesis block does not support the protocol
+	assert.NotNil(t, n2.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+	assert.Len(t, n2.GetPeers(), 0)
+
+	assert.Nil(t, n3.GetNetwork().ConnectToSeed(n1.GetHostPeerInfo()))
+	assert.Len(t, n3.GetPeers(), 1)
+}
+
 func TestNode_SyncPeers(t *testing.T) {
 
 	db1 := storage.NewRamStorage()
@@ -16,7 +16,7 @@ func TestNewPingService(t *testing.T) {
 	require.Error(t, err)
 
 	// invalid duration
-	_, err = NewPingService(networkmodel.NewHost(0, nil, nil), 0)
+	_, err = NewPingService(networkmodel.NewHost(0, nil, networkmodel.ProtocalName, nil), 0)
 	require.Error(t, err)
 }
 
@@ -31,7 +31,7 @@ func TestPingService_Stop(t *testing.T) {
 }
 
 func startPingService(t *testing.T) *PingService {
-	h0 := networkmodel.NewHost(0, nil, nil)
+	h0 := networkmodel.NewHost(0, nil, networkmodel.ProtocalName, nil)
 	ps, err := NewPingService(h0, time.Second)
 	require.Nil(t, err)
 	err = ps.Start(func() map[peer.ID]networkmodel.PeerInfo {
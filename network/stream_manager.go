@@ -11,6 +11,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/libp2p/go-libp2p-core/peerstore"
+	"github.com/libp2p/go-libp2p-core/protocol"
 	ma "github.com/multiformats/go-multiaddr"
 	logger "github.com/sirupsen/logrus"
 
@@ -33,6 +34,7 @@ type StreamManager struct {
 	onStreamStopCb           OnStreamCbFunc
 	onStreamConnectedCb      OnStreamCbFunc
 	ping                     *PingService
+	protocolID               protocol.ID
 
 	mutex sync.RWMutex
 }
@@ -47,6 +49,7 @@ func NewStreamManager(config networkmodel.PeerConnectionConfig, streamMessageRec
 		streamStopNotificationCh: make(chan *Stream, 10),
 		onStreamStopCb:           onStreamStopCb,
 		onStreamConnectedCb:      onStreamConnectedCb,
+		protocolID:               networkmodel.GetProtocolID(config.GetGenesisHash()),
 		mutex:                    sync.RWMutex{},
 	}
 }
@@ -60,6 +63,9 @@ func (sm *StreamManager) Start(host *networkmodel.Host) {
 //GetStreams returns all currently connected streams
 func (sm *StreamManager) GetStreams() map[peer.ID]*StreamInfo { return sm.streams }
 
+//GetProtocolID returns the protocol of the streams, which only peers with the same genesis block support
+func (sm *StreamManager) GetProtocolID() protocol.ID { return sm.protocolID }
+
 //GetConnectionManager returns its connectionManager instance
 func (sm *StreamManager) GetConnectionManager() *ConnectionManager { return sm.connectionManager }
 
@@ -237,7 +243,7 @@ func (sm *StreamManager) connectPeer(peerInfo networkmodel.PeerInfo, connectionT
 	}
 
 	sm.host.Peerstore().AddAddrs(peerInfo.PeerId, peerInfo.Addrs, peerstore.PermanentAddrTTL)
-	s, err := sm.host.NewStream(context.Background(), peerInfo.PeerId, networkmodel.ProtocalName)
+	s, err := sm.host.NewStream(context.Background(), peerInfo.PeerId, sm.protocolID)
 	if err != nil {
 		logger.WithError(err).WithFields(logger.Fields{
 			"PeerId":  peerInfo.PeerId,
@@ -291,7 +297,7 @@ func (sm *StreamManager) Stop() {
 		streamInfo.stream.StopStream()
 	}
 
-	sm.host.RemoveStreamHandler(networkmodel.ProtocalName)
+	sm.host.RemoveStreamHandler(sm.protocolID)
 	err := sm.host.Close()
 	if err != nil {
 		logger.WithError(err).Warn("StreamManager: host was not closed properly.")
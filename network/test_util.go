@@ -42,7 +42,7 @@ func FakeNodeWithPidAndAddr(db Storage, pid, addr string) *Node {
 	peerid, _ := peer.IDB58Decode(pid)
 	maddr, _ := ma.NewMultiaddr(addr)
 	peerInfo := networkmodel.PeerInfo{PeerId: peerid, Addrs: []ma.Multiaddr{maddr}}
-	node.network.streamManager.host = &networkmodel.Host{nil, peerInfo}
+	node.network.streamManager.host = &networkmodel.Host{Info: peerInfo}
 
 	return node
 }
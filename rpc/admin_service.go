@@ -43,7 +43,8 @@ import (
 type AdminRpcService struct {
 	bm      *lblockchain.BlockchainManager
 	node    *network.Node
-	dynasty *consensus.Dynasty
+	dpos    *consensus.DPOS
+	seal    *consensus.InstantSeal
 	mutex   sync.Mutex
 }
 
@@ -61,7 +62,7 @@ func (adminRpcService *AdminRpcService) RpcAddProducer(ctx context.Context, in *
 	if len(address) == 0 || !addressAccount.IsValid() {
 		return nil, status.Error(codes.InvalidArgument, account.ErrInvalidAddress.Error())
 	}
-	err := adminRpcService.dynasty.AddProducer(address)
+	err := adminRpcService.dpos.AddProducer(address, in.GetActivationHeight())
 	if err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
@@ -75,6 +76,41 @@ func (adminRpcService *AdminRpcService) RpcGetPeerInfo(ctx context.Context, in *
 	}, nil
 }
 
+//RpcGetProducerStats returns the slots filled and missed by each producer within the requested window
+func (adminRpcService *AdminRpcService) RpcGetProducerStats(ctx context.Context, in *rpcpb.GetProducerStatsRequest) (*rpcpb.GetProducerStatsResponse, error) {
+	if adminRpcService.dpos == nil || adminRpcService.dpos.GetLivenessTracker() == nil {
+		return nil, status.Error(codes.FailedPrecondition, "producer stats are only supported for DPOS Consensus")
+	}
+
+	window := in.GetWindow()
+	if window == 0 {
+		window = consensus.DefaultLivenessWindow
+	}
+	if window > consensus.MaxLivenessWindow {
+		return nil, status.Error(codes.InvalidArgument, "window exceeds the maximum number of slots")
+	}
+
+	result := &rpcpb.GetProducerStatsResponse{Window: window}
+	for _, stats := range adminRpcService.dpos.GetLivenessTracker().GetProducerStats(time.Now().Unix(), window) {
+		result.Stats = append(result.Stats, &rpcpb.ProducerStats{
+			Address:  stats.Producer,
+			Produced: stats.Produced,
+			Missed:   stats.Missed,
+			Uptime:   stats.GetUptime(),
+		})
+	}
+	return result, nil
+}
+
+//RpcSealBlock requests the instant seal consensus to produce a block immediately
+func (adminRpcService *AdminRpcService) RpcSealBlock(ctx context.Context, in *rpcpb.SealBlockRequest) (*rpcpb.SealBlockResponse, error) {
+	if adminRpcService.seal == nil {
+		return nil, status.Error(codes.FailedPrecondition, "sealing blocks on demand is only supported for instant seal consensus")
+	}
+	adminRpcService.seal.Seal()
+	return &rpcpb.SealBlockResponse{}, nil
+}
+
 //unlock the account through rpc service
 func (adminRpcService *AdminRpcService) RpcUnlockAccount(ctx context.Context, in *rpcpb.UnlockAccountRequest) (*rpcpb.UnlockAccountResponse, error) {
 	err := logic.SetUnLockAccount()
@@ -23,6 +23,7 @@ import (
 	"github.com/dappley/go-dappley/common/log"
 	"github.com/dappley/go-dappley/consensus"
 	"github.com/dappley/go-dappley/logic/lblockchain"
+	"github.com/dappley/go-dappley/logic/lightnode"
 	"net"
 	"sync"
 
@@ -60,6 +61,9 @@ type Server struct {
 	bm            *lblockchain.BlockchainManager
 	dpos          *consensus.DPOS
 	metricsConfig *MetricsServiceConfig
+	seal          *consensus.InstantSeal
+	lightNode     *lightnode.LightNode
+	chainID       uint64
 }
 
 func NewGrpcServer(node *network.Node, bm *lblockchain.BlockchainManager, dpos *consensus.DPOS, adminPassword string) *Server {
@@ -73,7 +77,20 @@ func NewGrpcServerWithMetrics(node *network.Node, bm *lblockchain.BlockchainMana
 		adminPassword,
 		bm,
 		dpos,
-		config}
+		config,
+		nil,
+		nil,
+		0}
+}
+
+//NewLightGrpcServer returns the rpc server of a light node, which serves the queries verified by the light node only
+func NewLightGrpcServer(lightNode *lightnode.LightNode, chainID uint64) *Server {
+	return &Server{srv: grpc.NewServer(), lightNode: lightNode, chainID: chainID}
+}
+
+//SetInstantSeal sets the instant seal consensus that produces blocks on request
+func (s *Server) SetInstantSeal(seal *consensus.InstantSeal) {
+	s.seal = seal
 }
 
 func (s *Server) Start(port uint32) {
@@ -91,10 +108,14 @@ func (s *Server) Start(port uint32) {
 		}
 
 		srv := grpc.NewServer(grpc.UnaryInterceptor(s.AuthInterceptor))
-		rpcpb.RegisterRpcServiceServer(srv, &RpcService{s.bm, s.node, s.dpos.GetDynasty(),nil,0,sync.Mutex{}})
-		rpcpb.RegisterAdminServiceServer(srv, &AdminRpcService{s.bm, s.node, s.dpos.GetDynasty(),sync.Mutex{}})
-		if s.metricsConfig != nil {
-			rpcpb.RegisterMetricServiceServer(srv, NewMetricsService(s.node, s.bm, s.dpos, s.metricsConfig, port))
+		if s.lightNode != nil {
+			rpcpb.RegisterRpcServiceServer(srv, &LightRpcService{lightNode: s.lightNode, chainID: s.chainID})
+		} else {
+			rpcpb.RegisterRpcServiceServer(srv, &RpcService{s.bm, s.node, s.dpos.GetDynasty(), nil, 0, sync.Mutex{}})
+			rpcpb.RegisterAdminServiceServer(srv, &AdminRpcService{s.bm, s.node, s.dpos, s.seal, sync.Mutex{}})
+			if s.metricsConfig != nil {
+				rpcpb.RegisterMetricServiceServer(srv, NewMetricsService(s.node, s.bm, s.dpos, s.metricsConfig, port))
+			}
 		}
 
 		if err := srv.Serve(lis); err != nil {
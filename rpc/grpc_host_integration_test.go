@@ -47,6 +47,7 @@ import (
 	"time"
 
 	"github.com/dappley/go-dappley/util"
+	"github.com/dappley/go-dappley/sdk"
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/core/account"
@@ -92,7 +93,7 @@ func CreateProducer(producerAddr, addr account.Address, db storage.Storage, txPo
 	bpConsensus.On("ProduceBlock", mock.Anything).Run(func(args mock.Arguments) {
 		args.Get(0).(func(process func(*block.Block), deadline deadline.Deadline))(
 			func(blk *block.Block) {
-				hash := lblock.CalculateHash(blk)
+				hash := lblock.CalculateHash(blk, nil)
 				blk.SetHash(hash)
 			},
 			deadline.NewUnlimitedDeadline(),
@@ -583,6 +584,137 @@ func TestRpcGetBlockByHeight(t *testing.T) {
 	assert.Equal(t, lblockchain.ErrBlockDoesNotExist.Error(), status.Convert(err).Message())
 }
 
+func TestRpcGetTransaction(t *testing.T) {
+	rpcContext, err := createRpcTestContext(9)
+	if err != nil {
+		panic(err)
+	}
+	defer rpcContext.destroyContext()
+
+	rpcContext.bp.Start()
+
+	for rpcContext.bm.Getblockchain().GetMaxHeight() < 30 {
+	}
+
+	rpcContext.bp.Stop()
+	util.WaitDoneOrTimeout(func() bool {
+		return !rpcContext.bp.IsProducingBlock()
+	}, 20)
+	time.Sleep(time.Second)
+
+	conn, err := grpc.Dial(fmt.Sprint(":", rpcContext.serverPort), grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	c := rpcpb.NewRpcServiceClient(conn)
+
+	bc := rpcContext.bm.Getblockchain()
+	block20, err := bc.GetBlockByHeight(20)
+	assert.Nil(t, err)
+	tx := block20.GetTransactions()[0]
+	response, err := c.RpcGetTransaction(context.Background(), &rpcpb.GetTransactionRequest{Id: tx.ID})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(tx.ID), response.GetTransaction().GetId())
+	assert.Equal(t, []byte(block20.GetHash()), response.GetBlockHash())
+	assert.Equal(t, uint64(20), response.GetBlockHeight())
+	assert.Equal(t, uint32(0), response.GetIndex())
+	assert.Equal(t, bc.GetMaxHeight()-19, response.GetConfirmations())
+	assert.Equal(t, bc.GetLIBHeight() >= 20, response.GetIsIrreversible())
+
+	response, err = c.RpcGetTransaction(context.Background(), &rpcpb.GetTransactionRequest{Id: []byte("noexists")})
+	assert.Nil(t, response)
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, lblockchain.ErrTransactionNotFound.Error(), status.Convert(err).Message())
+}
+
+func TestRpcGetTransactionProof(t *testing.T) {
+	rpcContext, err := createRpcTestContext(15)
+	if err != nil {
+		panic(err)
+	}
+	defer rpcContext.destroyContext()
+
+	rpcContext.bp.Start()
+
+	for rpcContext.bm.Getblockchain().GetMaxHeight() < 5 {
+	}
+
+	rpcContext.bp.Stop()
+	util.WaitDoneOrTimeout(func() bool {
+		return !rpcContext.bp.IsProducingBlock()
+	}, 20)
+	time.Sleep(time.Second)
+
+	conn, err := grpc.Dial(fmt.Sprint(":", rpcContext.serverPort), grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	c := rpcpb.NewRpcServiceClient(conn)
+
+	blk, err := rpcContext.bm.Getblockchain().GetBlockByHeight(3)
+	assert.Nil(t, err)
+	tx := blk.GetTransactions()[0]
+	response, err := c.RpcGetTransactionProof(context.Background(), &rpcpb.GetTransactionProofRequest{Id: tx.ID})
+	assert.Nil(t, err)
+	assert.Equal(t, []byte(blk.GetHash()), response.GetBlockHeader().GetHash())
+	assert.Equal(t, uint32(len(blk.GetTransactions())), response.GetNumTxs())
+	assert.True(t, sdk.VerifyTransactionProofResponse(response))
+
+	//the proof does not hold for another transaction
+	otherTx := ltransaction.NewCoinbaseTX(rpcContext.account.GetAddress(), "", 100, common.NewAmount(0))
+	assert.False(t, sdk.VerifyTransactionProof(otherTx.ToProto().(*transactionpb.Transaction), response.GetBlockHeader(), response.GetIndex(), response.GetNumTxs(), response.GetBranch()))
+
+	_, err = c.RpcGetTransactionProof(context.Background(), &rpcpb.GetTransactionProofRequest{Id: []byte("noexists")})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestRpcGetAddressHistory(t *testing.T) {
+	rpcContext, err := createRpcTestContext(10)
+	if err != nil {
+		panic(err)
+	}
+	defer rpcContext.destroyContext()
+
+	rpcContext.bp.Start()
+
+	for rpcContext.bm.Getblockchain().GetMaxHeight() < 5 {
+	}
+
+	rpcContext.bp.Stop()
+	util.WaitDoneOrTimeout(func() bool {
+		return !rpcContext.bp.IsProducingBlock()
+	}, 20)
+	time.Sleep(time.Second)
+
+	conn, err := grpc.Dial(fmt.Sprint(":", rpcContext.serverPort), grpc.WithInsecure())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+	c := rpcpb.NewRpcServiceClient(conn)
+
+	//the producer receives the coinbase output of every block
+	address := rpcContext.account.GetAddress().String()
+	response, err := c.RpcGetAddressHistory(context.Background(), &rpcpb.GetAddressHistoryRequest{Address: address, StartHeight: 1, EndHeight: 4, MaxCount: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(response.GetEntries()))
+	assert.NotEqual(t, uint64(0), response.GetNextCursor())
+
+	response, err = c.RpcGetAddressHistory(context.Background(), &rpcpb.GetAddressHistoryRequest{Address: address, StartHeight: 1, EndHeight: 4, Cursor: response.GetNextCursor()})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(0), response.GetNextCursor())
+	for _, entry := range response.GetEntries() {
+		assert.True(t, entry.GetBlockHeight() >= 3 && entry.GetBlockHeight() <= 4)
+	}
+
+	_, err = c.RpcGetAddressHistory(context.Background(), &rpcpb.GetAddressHistoryRequest{Address: "invalid"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	_, err = c.RpcGetAddressHistory(context.Background(), &rpcpb.GetAddressHistoryRequest{Address: address, MaxCount: MaxGetHistoryCount + 1})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
 func GetUTXOsfromAmount(inputUTXOs []*utxo.UTXO, amount *common.Amount, tip *common.Amount, gasLimit *common.Amount, gasPrice *common.Amount) ([]*utxo.UTXO, error) {
 	if tip != nil {
 		amount = amount.Add(tip)
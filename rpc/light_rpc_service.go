@@ -0,0 +1,154 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+package rpc
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/dappley/go-dappley/common"
+	"github.com/dappley/go-dappley/core/block"
+	"github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/transaction/pb"
+	"github.com/dappley/go-dappley/core/utxo/pb"
+	"github.com/dappley/go-dappley/logic/lightnode"
+	"github.com/dappley/go-dappley/rpc/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// LightRpcService serves the queries of a light node. The light node keeps the block headers only, so the
+// transactions, UTXOs and contract states are fetched from full peers and returned only if their proofs lead to a
+// synced header. The other methods of the rpc service are not implemented.
+type LightRpcService struct {
+	rpcpb.UnimplementedRpcServiceServer
+	lightNode *lightnode.LightNode
+	chainID   uint64
+}
+
+func (rpcService *LightRpcService) IsPrivate() bool { return false }
+
+func (rpcService *LightRpcService) RpcGetVersion(ctx context.Context, in *rpcpb.GetVersionRequest) (*rpcpb.GetVersionResponse, error) {
+	clientProtoVersions := strings.Split(in.GetProtoVersion(), ".")
+	if len(clientProtoVersions) != 3 {
+		return nil, status.Error(codes.InvalidArgument, "proto version not supported")
+	}
+	if strings.Split(ProtoVersion, ".")[0] != clientProtoVersions[0] {
+		return nil, status.Error(codes.Unimplemented, "major version mismatch")
+	}
+	return &rpcpb.GetVersionResponse{ProtoVersion: ProtoVersion, ServerVersion: ""}, nil
+}
+
+//RpcGetBlockchainInfo returns the tail of the synced header chain
+func (rpcService *LightRpcService) RpcGetBlockchainInfo(ctx context.Context, in *rpcpb.GetBlockchainInfoRequest) (*rpcpb.GetBlockchainInfoResponse, error) {
+	header, err := rpcService.lightNode.GetHeaderChain().GetTailHeader()
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &rpcpb.GetBlockchainInfoResponse{
+		TailBlockHash: header.Hash(),
+		BlockHeight:   header.Height,
+		Timestamp:     header.Timestamp,
+		ChainId:       rpcService.chainID,
+	}, nil
+}
+
+//RpcGetTransaction returns the transaction with the input id with the synced header of the block that contains it
+func (rpcService *LightRpcService) RpcGetTransaction(ctx context.Context, in *rpcpb.GetTransactionRequest) (*rpcpb.GetTransactionResponse, error) {
+	tx, height, err := rpcService.lightNode.GetTransaction(in.GetId())
+	if err != nil {
+		return nil, lightNodeError(err)
+	}
+	header, err := rpcService.lightNode.GetHeaderChain().GetHeader(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	confirmations := uint64(0)
+	if maxHeight := rpcService.lightNode.GetMaxHeight(); maxHeight >= height {
+		confirmations = maxHeight - height + 1
+	}
+	return &rpcpb.GetTransactionResponse{
+		Transaction:    tx.ToProto().(*transactionpb.Transaction),
+		BlockHash:      header.Hash(),
+		BlockHeight:    height,
+		BlockTimestamp: header.Timestamp,
+		Confirmations:  confirmations,
+	}, nil
+}
+
+//RpcGetUTXO returns the proven UTXOs of the address with the synced header of the block of their state
+func (rpcService *LightRpcService) RpcGetUTXO(ctx context.Context, in *rpcpb.GetUTXORequest) (*rpcpb.GetUTXOResponse, error) {
+	utxos, height, err := rpcService.lightNode.GetUtxos(in.GetAddress())
+	if err != nil {
+		return nil, lightNodeError(err)
+	}
+	header, err := rpcService.lightNode.GetHeaderChain().GetHeader(height)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &rpcpb.GetUTXOResponse{}
+	for _, utxo := range utxos {
+		response.Utxos = append(response.Utxos, utxo.ToProto().(*utxopb.Utxo))
+	}
+	response.BlockHeaders = append(response.BlockHeaders, block.NewHeaderOnlyBlock(header).GetHeader().ToProto().(*blockpb.BlockHeader))
+	return response, nil
+}
+
+//RpcGetBalance returns the sum of the proven UTXOs of the address
+func (rpcService *LightRpcService) RpcGetBalance(ctx context.Context, in *rpcpb.GetBalanceRequest) (*rpcpb.GetBalanceResponse, error) {
+	utxos, _, err := rpcService.lightNode.GetUtxos(in.GetAddress())
+	if err != nil {
+		return nil, lightNodeError(err)
+	}
+
+	amount := common.NewAmount(0)
+	for _, utxo := range utxos {
+		amount = amount.Add(utxo.Value)
+	}
+	return &rpcpb.GetBalanceResponse{Amount: amount.Int64()}, nil
+}
+
+//RpcContractQuery returns the proven value of the key in the storage of the contract. The storage cannot be queried
+//by value, since a missing value cannot be proven
+func (rpcService *LightRpcService) RpcContractQuery(ctx context.Context, in *rpcpb.ContractQueryRequest) (*rpcpb.ContractQueryResponse, error) {
+	if in.GetContractAddr() == "" || in.GetKey() == "" {
+		return nil, status.Error(codes.InvalidArgument, "contract query params error")
+	}
+	value, _, err := rpcService.lightNode.GetContractState(in.GetContractAddr(), in.GetKey())
+	if err != nil {
+		return nil, lightNodeError(err)
+	}
+	// storage data has been JSON.stringfy before
+	value, _ = strconv.Unquote(value)
+	return &rpcpb.ContractQueryResponse{Key: in.GetKey(), Value: value}, nil
+}
+
+//lightNodeError returns the rpc status of the error of a light node request
+func lightNodeError(err error) error {
+	switch err {
+	case lightnode.ErrInvalidAddress:
+		return status.Error(codes.InvalidArgument, err.Error())
+	case lightnode.ErrNotFound:
+		return status.Error(codes.NotFound, err.Error())
+	default:
+		return status.Error(codes.Unavailable, err.Error())
+	}
+}
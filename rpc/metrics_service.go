@@ -102,7 +102,9 @@ func (ms *MetricsService) RpcSetNodeConfig(ctx context.Context, request *rpcpb.S
 		case rpcpb.SetNodeConfigRequest_MAX_PRODUCERS:
 			ms.dpos.GetDynasty().SetMaxProducers(int(request.GetMaxProducers()))
 		case rpcpb.SetNodeConfigRequest_PRODUCERS:
-			ms.dpos.GetDynasty().SetProducers(request.GetProducers())
+			if err := ms.dpos.ScheduleProducers(request.GetProducers(), 0); err != nil {
+				return nil, status.Error(codes.FailedPrecondition, err.Error())
+			}
 		}
 	}
 	return ms.getNodeConfig(), nil
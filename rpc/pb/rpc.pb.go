@@ -9,9 +9,10 @@ package rpcpb
 import (
 	context "context"
 	pb3 "github.com/dappley/go-dappley/core/block/pb"
+	pb4 "github.com/dappley/go-dappley/core/history/pb"
 	pb "github.com/dappley/go-dappley/core/transaction/pb"
 	pb2 "github.com/dappley/go-dappley/core/utxo/pb"
-	pb4 "github.com/dappley/go-dappley/metrics/pb"
+	pb5 "github.com/dappley/go-dappley/metrics/pb"
 	pb1 "github.com/dappley/go-dappley/network/pb"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
@@ -89,7 +90,7 @@ func (x SetNodeConfigRequest_ConfigType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use SetNodeConfigRequest_ConfigType.Descriptor instead.
 func (SetNodeConfigRequest_ConfigType) EnumDescriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{46, 0}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{59, 0}
 }
 
 type CreateAccountRequest struct {
@@ -182,7 +183,8 @@ type AddProducerRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ActivationHeight uint64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
 func (x *AddProducerRequest) Reset() {
@@ -224,6 +226,13 @@ func (x *AddProducerRequest) GetAddress() string {
 	return ""
 }
 
+func (x *AddProducerRequest) GetActivationHeight() uint64 {
+	if x != nil {
+		return x.ActivationHeight
+	}
+	return 0
+}
+
 type GetBalanceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -467,6 +476,53 @@ func (*GetPeerInfoRequest) Descriptor() ([]byte, []int) {
 	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{6}
 }
 
+type GetProducerStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"` // number of time slots, the default window is used if 0
+}
+
+func (x *GetProducerStatsRequest) Reset() {
+	*x = GetProducerStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProducerStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProducerStatsRequest) ProtoMessage() {}
+
+func (x *GetProducerStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProducerStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetProducerStatsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetProducerStatsRequest) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
 type GetBlockchainInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -476,7 +532,7 @@ type GetBlockchainInfoRequest struct {
 func (x *GetBlockchainInfoRequest) Reset() {
 	*x = GetBlockchainInfoRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -489,7 +545,7 @@ func (x *GetBlockchainInfoRequest) String() string {
 func (*GetBlockchainInfoRequest) ProtoMessage() {}
 
 func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -502,7 +558,45 @@ func (x *GetBlockchainInfoRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockchainInfoRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockchainInfoRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{7}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+type SealBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SealBlockRequest) Reset() {
+	*x = SealBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealBlockRequest) ProtoMessage() {}
+
+func (x *SealBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealBlockRequest.ProtoReflect.Descriptor instead.
+func (*SealBlockRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{9}
 }
 
 type AddPeerRequest struct {
@@ -516,7 +610,7 @@ type AddPeerRequest struct {
 func (x *AddPeerRequest) Reset() {
 	*x = AddPeerRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -529,7 +623,7 @@ func (x *AddPeerRequest) String() string {
 func (*AddPeerRequest) ProtoMessage() {}
 
 func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -542,7 +636,7 @@ func (x *AddPeerRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPeerRequest.ProtoReflect.Descriptor instead.
 func (*AddPeerRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{8}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AddPeerRequest) GetFullAddress() string {
@@ -563,7 +657,7 @@ type GetVersionRequest struct {
 func (x *GetVersionRequest) Reset() {
 	*x = GetVersionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -576,7 +670,7 @@ func (x *GetVersionRequest) String() string {
 func (*GetVersionRequest) ProtoMessage() {}
 
 func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -589,7 +683,7 @@ func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
 func (*GetVersionRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{9}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetVersionRequest) GetProtoVersion() string {
@@ -610,7 +704,7 @@ type GetUTXORequest struct {
 func (x *GetUTXORequest) Reset() {
 	*x = GetUTXORequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -623,7 +717,7 @@ func (x *GetUTXORequest) String() string {
 func (*GetUTXORequest) ProtoMessage() {}
 
 func (x *GetUTXORequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -636,7 +730,7 @@ func (x *GetUTXORequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUTXORequest.ProtoReflect.Descriptor instead.
 func (*GetUTXORequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{10}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUTXORequest) GetAddress() string {
@@ -658,7 +752,7 @@ type GetBlocksRequest struct {
 func (x *GetBlocksRequest) Reset() {
 	*x = GetBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -671,7 +765,7 @@ func (x *GetBlocksRequest) String() string {
 func (*GetBlocksRequest) ProtoMessage() {}
 
 func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +778,7 @@ func (x *GetBlocksRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksRequest.ProtoReflect.Descriptor instead.
 func (*GetBlocksRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{11}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetBlocksRequest) GetStartBlockHashes() [][]byte {
@@ -712,7 +806,7 @@ type GetBlockByHashRequest struct {
 func (x *GetBlockByHashRequest) Reset() {
 	*x = GetBlockByHashRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -725,7 +819,7 @@ func (x *GetBlockByHashRequest) String() string {
 func (*GetBlockByHashRequest) ProtoMessage() {}
 
 func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -738,7 +832,7 @@ func (x *GetBlockByHashRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByHashRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockByHashRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{12}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetBlockByHashRequest) GetHash() []byte {
@@ -759,7 +853,7 @@ type GetBlockByHeightRequest struct {
 func (x *GetBlockByHeightRequest) Reset() {
 	*x = GetBlockByHeightRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -772,7 +866,7 @@ func (x *GetBlockByHeightRequest) String() string {
 func (*GetBlockByHeightRequest) ProtoMessage() {}
 
 func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,7 +879,7 @@ func (x *GetBlockByHeightRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByHeightRequest.ProtoReflect.Descriptor instead.
 func (*GetBlockByHeightRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{13}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetBlockByHeightRequest) GetHeight() uint64 {
@@ -806,7 +900,7 @@ type SendTransactionRequest struct {
 func (x *SendTransactionRequest) Reset() {
 	*x = SendTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -819,7 +913,7 @@ func (x *SendTransactionRequest) String() string {
 func (*SendTransactionRequest) ProtoMessage() {}
 
 func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -832,7 +926,7 @@ func (x *SendTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTransactionRequest.ProtoReflect.Descriptor instead.
 func (*SendTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{14}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *SendTransactionRequest) GetTransaction() *pb.Transaction {
@@ -853,7 +947,7 @@ type SendBatchTransactionRequest struct {
 func (x *SendBatchTransactionRequest) Reset() {
 	*x = SendBatchTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -866,7 +960,7 @@ func (x *SendBatchTransactionRequest) String() string {
 func (*SendBatchTransactionRequest) ProtoMessage() {}
 
 func (x *SendBatchTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -879,7 +973,7 @@ func (x *SendBatchTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendBatchTransactionRequest.ProtoReflect.Descriptor instead.
 func (*SendBatchTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{15}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *SendBatchTransactionRequest) GetTransactions() []*pb.Transaction {
@@ -898,7 +992,7 @@ type GetNewTransactionRequest struct {
 func (x *GetNewTransactionRequest) Reset() {
 	*x = GetNewTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -911,7 +1005,7 @@ func (x *GetNewTransactionRequest) String() string {
 func (*GetNewTransactionRequest) ProtoMessage() {}
 
 func (x *GetNewTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1018,7 @@ func (x *GetNewTransactionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewTransactionRequest.ProtoReflect.Descriptor instead.
 func (*GetNewTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{16}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{18}
 }
 
 type SubscribeRequest struct {
@@ -938,7 +1032,7 @@ type SubscribeRequest struct {
 func (x *SubscribeRequest) Reset() {
 	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -951,7 +1045,7 @@ func (x *SubscribeRequest) String() string {
 func (*SubscribeRequest) ProtoMessage() {}
 
 func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -964,7 +1058,7 @@ func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
 func (*SubscribeRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{17}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *SubscribeRequest) GetTopics() []string {
@@ -983,7 +1077,7 @@ type MetricsServiceRequest struct {
 func (x *MetricsServiceRequest) Reset() {
 	*x = MetricsServiceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -996,7 +1090,7 @@ func (x *MetricsServiceRequest) String() string {
 func (*MetricsServiceRequest) ProtoMessage() {}
 
 func (x *MetricsServiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,7 +1103,7 @@ func (x *MetricsServiceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricsServiceRequest.ProtoReflect.Descriptor instead.
 func (*MetricsServiceRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{18}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{20}
 }
 
 type GetLastIrreversibleBlockRequest struct {
@@ -1021,7 +1115,7 @@ type GetLastIrreversibleBlockRequest struct {
 func (x *GetLastIrreversibleBlockRequest) Reset() {
 	*x = GetLastIrreversibleBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1034,7 +1128,7 @@ func (x *GetLastIrreversibleBlockRequest) String() string {
 func (*GetLastIrreversibleBlockRequest) ProtoMessage() {}
 
 func (x *GetLastIrreversibleBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,34 +1141,34 @@ func (x *GetLastIrreversibleBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetLastIrreversibleBlockRequest.ProtoReflect.Descriptor instead.
 func (*GetLastIrreversibleBlockRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{19}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{21}
 }
 
-type EstimateGasRequest struct {
+type GetFinalityCertificateRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	BlockHash []byte `protobuf:"bytes,1,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 }
 
-func (x *EstimateGasRequest) Reset() {
-	*x = EstimateGasRequest{}
+func (x *GetFinalityCertificateRequest) Reset() {
+	*x = GetFinalityCertificateRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EstimateGasRequest) String() string {
+func (x *GetFinalityCertificateRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateGasRequest) ProtoMessage() {}
+func (*GetFinalityCertificateRequest) ProtoMessage() {}
 
-func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20]
+func (x *GetFinalityCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,41 +1179,43 @@ func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateGasRequest.ProtoReflect.Descriptor instead.
-func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use GetFinalityCertificateRequest.ProtoReflect.Descriptor instead.
+func (*GetFinalityCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *EstimateGasRequest) GetTransaction() *pb.Transaction {
+func (x *GetFinalityCertificateRequest) GetBlockHash() []byte {
 	if x != nil {
-		return x.Transaction
+		return x.BlockHash
 	}
 	return nil
 }
 
-type GasPriceRequest struct {
+type GetTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *GasPriceRequest) Reset() {
-	*x = GasPriceRequest{}
+func (x *GetTransactionRequest) Reset() {
+	*x = GetTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GasPriceRequest) String() string {
+func (x *GetTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GasPriceRequest) ProtoMessage() {}
+func (*GetTransactionRequest) ProtoMessage() {}
 
-func (x *GasPriceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21]
+func (x *GetTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1130,38 +1226,43 @@ func (x *GasPriceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GasPriceRequest.ProtoReflect.Descriptor instead.
-func (*GasPriceRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{23}
 }
 
-type ContractQueryRequest struct {
+func (x *GetTransactionRequest) GetId() []byte {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+type GetTransactionProofRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ContractAddr string `protobuf:"bytes,1,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
-	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value        string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	Id []byte `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *ContractQueryRequest) Reset() {
-	*x = ContractQueryRequest{}
+func (x *GetTransactionProofRequest) Reset() {
+	*x = GetTransactionProofRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ContractQueryRequest) String() string {
+func (x *GetTransactionProofRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContractQueryRequest) ProtoMessage() {}
+func (*GetTransactionProofRequest) ProtoMessage() {}
 
-func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22]
+func (x *GetTransactionProofRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,55 +1273,47 @@ func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContractQueryRequest.ProtoReflect.Descriptor instead.
-func (*ContractQueryRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *ContractQueryRequest) GetContractAddr() string {
-	if x != nil {
-		return x.ContractAddr
-	}
-	return ""
-}
-
-func (x *ContractQueryRequest) GetKey() string {
-	if x != nil {
-		return x.Key
-	}
-	return ""
+// Deprecated: Use GetTransactionProofRequest.ProtoReflect.Descriptor instead.
+func (*GetTransactionProofRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *ContractQueryRequest) GetValue() string {
+func (x *GetTransactionProofRequest) GetId() []byte {
 	if x != nil {
-		return x.Value
+		return x.Id
 	}
-	return ""
+	return nil
 }
 
-type AddProducerResponse struct {
+type GetAddressHistoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Address     string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StartHeight uint64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64 `protobuf:"varint,3,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	Cursor      uint64 `protobuf:"varint,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	MaxCount    uint32 `protobuf:"varint,5,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
 }
 
-func (x *AddProducerResponse) Reset() {
-	*x = AddProducerResponse{}
+func (x *GetAddressHistoryRequest) Reset() {
+	*x = GetAddressHistoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddProducerResponse) String() string {
+func (x *GetAddressHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProducerResponse) ProtoMessage() {}
+func (*GetAddressHistoryRequest) ProtoMessage() {}
 
-func (x *AddProducerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23]
+func (x *GetAddressHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,34 +1324,255 @@ func (x *AddProducerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProducerResponse.ProtoReflect.Descriptor instead.
-func (*AddProducerResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetAddressHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetAddressHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{25}
 }
 
-type UnlockAccountResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *GetAddressHistoryRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
 }
 
-func (x *UnlockAccountResponse) Reset() {
-	*x = UnlockAccountResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetAddressHistoryRequest) GetStartHeight() uint64 {
+	if x != nil {
+		return x.StartHeight
 	}
+	return 0
 }
 
-func (x *UnlockAccountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+func (x *GetAddressHistoryRequest) GetEndHeight() uint64 {
+	if x != nil {
+		return x.EndHeight
+	}
+	return 0
+}
+
+func (x *GetAddressHistoryRequest) GetCursor() uint64 {
+	if x != nil {
+		return x.Cursor
+	}
+	return 0
+}
+
+func (x *GetAddressHistoryRequest) GetMaxCount() uint32 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+type EstimateGasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+}
+
+func (x *EstimateGasRequest) Reset() {
+	*x = EstimateGasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EstimateGasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EstimateGasRequest) ProtoMessage() {}
+
+func (x *EstimateGasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EstimateGasRequest.ProtoReflect.Descriptor instead.
+func (*EstimateGasRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *EstimateGasRequest) GetTransaction() *pb.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+type GasPriceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GasPriceRequest) Reset() {
+	*x = GasPriceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GasPriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GasPriceRequest) ProtoMessage() {}
+
+func (x *GasPriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GasPriceRequest.ProtoReflect.Descriptor instead.
+func (*GasPriceRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+type ContractQueryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContractAddr string `protobuf:"bytes,1,opt,name=contract_addr,json=contractAddr,proto3" json:"contract_addr,omitempty"`
+	Key          string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value        string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *ContractQueryRequest) Reset() {
+	*x = ContractQueryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContractQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContractQueryRequest) ProtoMessage() {}
+
+func (x *ContractQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ContractQueryRequest.ProtoReflect.Descriptor instead.
+func (*ContractQueryRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ContractQueryRequest) GetContractAddr() string {
+	if x != nil {
+		return x.ContractAddr
+	}
+	return ""
+}
+
+func (x *ContractQueryRequest) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *ContractQueryRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type AddProducerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddProducerResponse) Reset() {
+	*x = AddProducerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddProducerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddProducerResponse) ProtoMessage() {}
+
+func (x *AddProducerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddProducerResponse.ProtoReflect.Descriptor instead.
+func (*AddProducerResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+type UnlockAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UnlockAccountResponse) Reset() {
+	*x = UnlockAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnlockAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
 
 func (*UnlockAccountResponse) ProtoMessage() {}
 
 func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1271,7 +1585,7 @@ func (x *UnlockAccountResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlockAccountResponse.ProtoReflect.Descriptor instead.
 func (*UnlockAccountResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{24}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{30}
 }
 
 type GetBalanceResponse struct {
@@ -1285,7 +1599,7 @@ type GetBalanceResponse struct {
 func (x *GetBalanceResponse) Reset() {
 	*x = GetBalanceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1298,7 +1612,7 @@ func (x *GetBalanceResponse) String() string {
 func (*GetBalanceResponse) ProtoMessage() {}
 
 func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1311,7 +1625,7 @@ func (x *GetBalanceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBalanceResponse.ProtoReflect.Descriptor instead.
 func (*GetBalanceResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{25}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *GetBalanceResponse) GetAmount() int64 {
@@ -1330,7 +1644,7 @@ type SendFromMinerResponse struct {
 func (x *SendFromMinerResponse) Reset() {
 	*x = SendFromMinerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1343,7 +1657,7 @@ func (x *SendFromMinerResponse) String() string {
 func (*SendFromMinerResponse) ProtoMessage() {}
 
 func (x *SendFromMinerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1356,7 +1670,7 @@ func (x *SendFromMinerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendFromMinerResponse.ProtoReflect.Descriptor instead.
 func (*SendFromMinerResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{26}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{32}
 }
 
 type SendResponse struct {
@@ -1371,7 +1685,7 @@ type SendResponse struct {
 func (x *SendResponse) Reset() {
 	*x = SendResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1384,7 +1698,7 @@ func (x *SendResponse) String() string {
 func (*SendResponse) ProtoMessage() {}
 
 func (x *SendResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1397,7 +1711,7 @@ func (x *SendResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendResponse.ProtoReflect.Descriptor instead.
 func (*SendResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{27}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *SendResponse) GetContractAddress() string {
@@ -1425,7 +1739,7 @@ type GetPeerInfoResponse struct {
 func (x *GetPeerInfoResponse) Reset() {
 	*x = GetPeerInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1438,7 +1752,7 @@ func (x *GetPeerInfoResponse) String() string {
 func (*GetPeerInfoResponse) ProtoMessage() {}
 
 func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1451,7 +1765,7 @@ func (x *GetPeerInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetPeerInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetPeerInfoResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{28}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *GetPeerInfoResponse) GetPeerList() []*pb1.PeerInfo {
@@ -1461,6 +1775,132 @@ func (x *GetPeerInfoResponse) GetPeerList() []*pb1.PeerInfo {
 	return nil
 }
 
+type ProducerStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string  `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Produced uint64  `protobuf:"varint,2,opt,name=produced,proto3" json:"produced,omitempty"`
+	Missed   uint64  `protobuf:"varint,3,opt,name=missed,proto3" json:"missed,omitempty"`
+	Uptime   float64 `protobuf:"fixed64,4,opt,name=uptime,proto3" json:"uptime,omitempty"` // percentage of the slots filled by the producer
+}
+
+func (x *ProducerStats) Reset() {
+	*x = ProducerStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProducerStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProducerStats) ProtoMessage() {}
+
+func (x *ProducerStats) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProducerStats.ProtoReflect.Descriptor instead.
+func (*ProducerStats) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ProducerStats) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ProducerStats) GetProduced() uint64 {
+	if x != nil {
+		return x.Produced
+	}
+	return 0
+}
+
+func (x *ProducerStats) GetMissed() uint64 {
+	if x != nil {
+		return x.Missed
+	}
+	return 0
+}
+
+func (x *ProducerStats) GetUptime() float64 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+type GetProducerStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Window uint64           `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Stats  []*ProducerStats `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+}
+
+func (x *GetProducerStatsResponse) Reset() {
+	*x = GetProducerStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetProducerStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProducerStatsResponse) ProtoMessage() {}
+
+func (x *GetProducerStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProducerStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetProducerStatsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetProducerStatsResponse) GetWindow() uint64 {
+	if x != nil {
+		return x.Window
+	}
+	return 0
+}
+
+func (x *GetProducerStatsResponse) GetStats() []*ProducerStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
 type GetBlockchainInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1470,12 +1910,13 @@ type GetBlockchainInfoResponse struct {
 	BlockHeight   uint64   `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	Producers     []string `protobuf:"bytes,3,rep,name=producers,proto3" json:"producers,omitempty"` // all producers' addresses
 	Timestamp     int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ChainId       uint64   `protobuf:"varint,5,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
 }
 
 func (x *GetBlockchainInfoResponse) Reset() {
 	*x = GetBlockchainInfoResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1488,7 +1929,7 @@ func (x *GetBlockchainInfoResponse) String() string {
 func (*GetBlockchainInfoResponse) ProtoMessage() {}
 
 func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1501,7 +1942,7 @@ func (x *GetBlockchainInfoResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockchainInfoResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockchainInfoResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{29}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *GetBlockchainInfoResponse) GetTailBlockHash() []byte {
@@ -1532,6 +1973,13 @@ func (x *GetBlockchainInfoResponse) GetTimestamp() int64 {
 	return 0
 }
 
+func (x *GetBlockchainInfoResponse) GetChainId() uint64 {
+	if x != nil {
+		return x.ChainId
+	}
+	return 0
+}
+
 type AddPeerResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1541,7 +1989,7 @@ type AddPeerResponse struct {
 func (x *AddPeerResponse) Reset() {
 	*x = AddPeerResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1554,7 +2002,7 @@ func (x *AddPeerResponse) String() string {
 func (*AddPeerResponse) ProtoMessage() {}
 
 func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1567,7 +2015,45 @@ func (x *AddPeerResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddPeerResponse.ProtoReflect.Descriptor instead.
 func (*AddPeerResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{30}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{38}
+}
+
+type SealBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SealBlockResponse) Reset() {
+	*x = SealBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SealBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SealBlockResponse) ProtoMessage() {}
+
+func (x *SealBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SealBlockResponse.ProtoReflect.Descriptor instead.
+func (*SealBlockResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{39}
 }
 
 type GetVersionResponse struct {
@@ -1582,7 +2068,7 @@ type GetVersionResponse struct {
 func (x *GetVersionResponse) Reset() {
 	*x = GetVersionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1595,7 +2081,7 @@ func (x *GetVersionResponse) String() string {
 func (*GetVersionResponse) ProtoMessage() {}
 
 func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,7 +2094,7 @@ func (x *GetVersionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionResponse.ProtoReflect.Descriptor instead.
 func (*GetVersionResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{31}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *GetVersionResponse) GetProtoVersion() string {
@@ -1637,7 +2123,7 @@ type GetUTXOResponse struct {
 func (x *GetUTXOResponse) Reset() {
 	*x = GetUTXOResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1650,7 +2136,7 @@ func (x *GetUTXOResponse) String() string {
 func (*GetUTXOResponse) ProtoMessage() {}
 
 func (x *GetUTXOResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,7 +2149,7 @@ func (x *GetUTXOResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUTXOResponse.ProtoReflect.Descriptor instead.
 func (*GetUTXOResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{32}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *GetUTXOResponse) GetUtxos() []*pb2.Utxo {
@@ -1691,7 +2177,7 @@ type GetBlocksResponse struct {
 func (x *GetBlocksResponse) Reset() {
 	*x = GetBlocksResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1704,7 +2190,7 @@ func (x *GetBlocksResponse) String() string {
 func (*GetBlocksResponse) ProtoMessage() {}
 
 func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1717,7 +2203,7 @@ func (x *GetBlocksResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlocksResponse.ProtoReflect.Descriptor instead.
 func (*GetBlocksResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{33}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *GetBlocksResponse) GetBlocks() []*pb3.Block {
@@ -1738,7 +2224,7 @@ type GetBlockByHashResponse struct {
 func (x *GetBlockByHashResponse) Reset() {
 	*x = GetBlockByHashResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1751,7 +2237,7 @@ func (x *GetBlockByHashResponse) String() string {
 func (*GetBlockByHashResponse) ProtoMessage() {}
 
 func (x *GetBlockByHashResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1764,7 +2250,7 @@ func (x *GetBlockByHashResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByHashResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockByHashResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{34}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *GetBlockByHashResponse) GetBlock() *pb3.Block {
@@ -1785,7 +2271,7 @@ type GetBlockByHeightResponse struct {
 func (x *GetBlockByHeightResponse) Reset() {
 	*x = GetBlockByHeightResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1798,7 +2284,7 @@ func (x *GetBlockByHeightResponse) String() string {
 func (*GetBlockByHeightResponse) ProtoMessage() {}
 
 func (x *GetBlockByHeightResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1811,7 +2297,7 @@ func (x *GetBlockByHeightResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBlockByHeightResponse.ProtoReflect.Descriptor instead.
 func (*GetBlockByHeightResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{35}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{44}
 }
 
 func (x *GetBlockByHeightResponse) GetBlock() *pb3.Block {
@@ -1832,7 +2318,7 @@ type SendTransactionResponse struct {
 func (x *SendTransactionResponse) Reset() {
 	*x = SendTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1845,7 +2331,7 @@ func (x *SendTransactionResponse) String() string {
 func (*SendTransactionResponse) ProtoMessage() {}
 
 func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1858,7 +2344,7 @@ func (x *SendTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTransactionResponse.ProtoReflect.Descriptor instead.
 func (*SendTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{36}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{45}
 }
 
 func (x *SendTransactionResponse) GetGeneratedContractAddress() string {
@@ -1877,7 +2363,7 @@ type SendBatchTransactionResponse struct {
 func (x *SendBatchTransactionResponse) Reset() {
 	*x = SendBatchTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1890,7 +2376,7 @@ func (x *SendBatchTransactionResponse) String() string {
 func (*SendBatchTransactionResponse) ProtoMessage() {}
 
 func (x *SendBatchTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1903,7 +2389,7 @@ func (x *SendBatchTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendBatchTransactionResponse.ProtoReflect.Descriptor instead.
 func (*SendBatchTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{37}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{46}
 }
 
 type SendTransactionStatus struct {
@@ -1919,7 +2405,7 @@ type SendTransactionStatus struct {
 func (x *SendTransactionStatus) Reset() {
 	*x = SendTransactionStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1932,7 +2418,7 @@ func (x *SendTransactionStatus) String() string {
 func (*SendTransactionStatus) ProtoMessage() {}
 
 func (x *SendTransactionStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1945,7 +2431,7 @@ func (x *SendTransactionStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SendTransactionStatus.ProtoReflect.Descriptor instead.
 func (*SendTransactionStatus) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{38}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *SendTransactionStatus) GetTxid() []byte {
@@ -1980,7 +2466,7 @@ type GetNewTransactionResponse struct {
 func (x *GetNewTransactionResponse) Reset() {
 	*x = GetNewTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1993,7 +2479,7 @@ func (x *GetNewTransactionResponse) String() string {
 func (*GetNewTransactionResponse) ProtoMessage() {}
 
 func (x *GetNewTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2006,7 +2492,7 @@ func (x *GetNewTransactionResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNewTransactionResponse.ProtoReflect.Descriptor instead.
 func (*GetNewTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{39}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *GetNewTransactionResponse) GetTransaction() *pb.Transaction {
@@ -2027,7 +2513,7 @@ type SubscribeResponse struct {
 func (x *SubscribeResponse) Reset() {
 	*x = SubscribeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2040,7 +2526,7 @@ func (x *SubscribeResponse) String() string {
 func (*SubscribeResponse) ProtoMessage() {}
 
 func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2053,7 +2539,7 @@ func (x *SubscribeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubscribeResponse.ProtoReflect.Descriptor instead.
 func (*SubscribeResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{40}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *SubscribeResponse) GetData() string {
@@ -2069,23 +2555,303 @@ type GetAllTransactionsRequest struct {
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetAllTransactionsRequest) Reset() {
-	*x = GetAllTransactionsRequest{}
+func (x *GetAllTransactionsRequest) Reset() {
+	*x = GetAllTransactionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTransactionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTransactionsRequest) ProtoMessage() {}
+
+func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTransactionsRequest.ProtoReflect.Descriptor instead.
+func (*GetAllTransactionsRequest) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+type GetAllTransactionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*pb.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *GetAllTransactionsResponse) Reset() {
+	*x = GetAllTransactionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAllTransactionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAllTransactionsResponse) ProtoMessage() {}
+
+func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAllTransactionsResponse.ProtoReflect.Descriptor instead.
+func (*GetAllTransactionsResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetAllTransactionsResponse) GetTransactions() []*pb.Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+type GetLastIrreversibleBlockResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+}
+
+func (x *GetLastIrreversibleBlockResponse) Reset() {
+	*x = GetLastIrreversibleBlockResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLastIrreversibleBlockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLastIrreversibleBlockResponse) ProtoMessage() {}
+
+func (x *GetLastIrreversibleBlockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLastIrreversibleBlockResponse.ProtoReflect.Descriptor instead.
+func (*GetLastIrreversibleBlockResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetLastIrreversibleBlockResponse) GetBlock() *pb3.Block {
+	if x != nil {
+		return x.Block
+	}
+	return nil
+}
+
+type GetFinalityCertificateResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Certificate *pb3.FinalityCertificate `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+}
+
+func (x *GetFinalityCertificateResponse) Reset() {
+	*x = GetFinalityCertificateResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFinalityCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFinalityCertificateResponse) ProtoMessage() {}
+
+func (x *GetFinalityCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFinalityCertificateResponse.ProtoReflect.Descriptor instead.
+func (*GetFinalityCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *GetFinalityCertificateResponse) GetCertificate() *pb3.FinalityCertificate {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+type GetTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction    *pb.Transaction `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	BlockHash      []byte          `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockHeight    uint64          `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTimestamp int64           `protobuf:"varint,4,opt,name=block_timestamp,json=blockTimestamp,proto3" json:"block_timestamp,omitempty"`
+	Index          uint32          `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	Confirmations  uint64          `protobuf:"varint,6,opt,name=confirmations,proto3" json:"confirmations,omitempty"`
+	IsIrreversible bool            `protobuf:"varint,7,opt,name=is_irreversible,json=isIrreversible,proto3" json:"is_irreversible,omitempty"`
+}
+
+func (x *GetTransactionResponse) Reset() {
+	*x = GetTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransactionResponse) ProtoMessage() {}
+
+func (x *GetTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTransactionResponse) GetTransaction() *pb.Transaction {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *GetTransactionResponse) GetBlockHash() []byte {
+	if x != nil {
+		return x.BlockHash
+	}
+	return nil
+}
+
+func (x *GetTransactionResponse) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *GetTransactionResponse) GetBlockTimestamp() int64 {
+	if x != nil {
+		return x.BlockTimestamp
+	}
+	return 0
+}
+
+func (x *GetTransactionResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *GetTransactionResponse) GetConfirmations() uint64 {
+	if x != nil {
+		return x.Confirmations
+	}
+	return 0
+}
+
+func (x *GetTransactionResponse) GetIsIrreversible() bool {
+	if x != nil {
+		return x.IsIrreversible
+	}
+	return false
+}
+
+type GetTransactionProofResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transaction *pb.Transaction  `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	BlockHeader *pb3.BlockHeader `protobuf:"bytes,2,opt,name=block_header,json=blockHeader,proto3" json:"block_header,omitempty"`
+	Index       uint32           `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	NumTxs      uint32           `protobuf:"varint,4,opt,name=num_txs,json=numTxs,proto3" json:"num_txs,omitempty"`
+	Branch      [][]byte         `protobuf:"bytes,5,rep,name=branch,proto3" json:"branch,omitempty"` // sibling hashes on the path to the merkle root, from the bottom level up
+}
+
+func (x *GetTransactionProofResponse) Reset() {
+	*x = GetTransactionProofResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetAllTransactionsRequest) String() string {
+func (x *GetTransactionProofResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAllTransactionsRequest) ProtoMessage() {}
+func (*GetTransactionProofResponse) ProtoMessage() {}
 
-func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41]
+func (x *GetTransactionProofResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,83 +2862,72 @@ func (x *GetAllTransactionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAllTransactionsRequest.ProtoReflect.Descriptor instead.
-func (*GetAllTransactionsRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{41}
-}
-
-type GetAllTransactionsResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Transactions []*pb.Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+// Deprecated: Use GetTransactionProofResponse.ProtoReflect.Descriptor instead.
+func (*GetTransactionProofResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *GetAllTransactionsResponse) Reset() {
-	*x = GetAllTransactionsResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *GetTransactionProofResponse) GetTransaction() *pb.Transaction {
+	if x != nil {
+		return x.Transaction
 	}
+	return nil
 }
 
-func (x *GetAllTransactionsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetTransactionProofResponse) GetBlockHeader() *pb3.BlockHeader {
+	if x != nil {
+		return x.BlockHeader
+	}
+	return nil
 }
 
-func (*GetAllTransactionsResponse) ProtoMessage() {}
-
-func (x *GetAllTransactionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *GetTransactionProofResponse) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetAllTransactionsResponse.ProtoReflect.Descriptor instead.
-func (*GetAllTransactionsResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{42}
+func (x *GetTransactionProofResponse) GetNumTxs() uint32 {
+	if x != nil {
+		return x.NumTxs
+	}
+	return 0
 }
 
-func (x *GetAllTransactionsResponse) GetTransactions() []*pb.Transaction {
+func (x *GetTransactionProofResponse) GetBranch() [][]byte {
 	if x != nil {
-		return x.Transactions
+		return x.Branch
 	}
 	return nil
 }
 
-type GetLastIrreversibleBlockResponse struct {
+type GetAddressHistoryResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Block *pb3.Block `protobuf:"bytes,1,opt,name=block,proto3" json:"block,omitempty"`
+	Entries    []*pb4.HistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	NextCursor uint64              `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
 }
 
-func (x *GetLastIrreversibleBlockResponse) Reset() {
-	*x = GetLastIrreversibleBlockResponse{}
+func (x *GetAddressHistoryResponse) Reset() {
+	*x = GetAddressHistoryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetLastIrreversibleBlockResponse) String() string {
+func (x *GetAddressHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetLastIrreversibleBlockResponse) ProtoMessage() {}
+func (*GetAddressHistoryResponse) ProtoMessage() {}
 
-func (x *GetLastIrreversibleBlockResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43]
+func (x *GetAddressHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,30 +2938,37 @@ func (x *GetLastIrreversibleBlockResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetLastIrreversibleBlockResponse.ProtoReflect.Descriptor instead.
-func (*GetLastIrreversibleBlockResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use GetAddressHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetAddressHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *GetLastIrreversibleBlockResponse) GetBlock() *pb3.Block {
+func (x *GetAddressHistoryResponse) GetEntries() []*pb4.HistoryEntry {
 	if x != nil {
-		return x.Block
+		return x.Entries
 	}
 	return nil
 }
 
+func (x *GetAddressHistoryResponse) GetNextCursor() uint64 {
+	if x != nil {
+		return x.NextCursor
+	}
+	return 0
+}
+
 type GetStatsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Stats *pb4.Metrics `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	Stats *pb5.Metrics `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
 }
 
 func (x *GetStatsResponse) Reset() {
 	*x = GetStatsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2219,7 +2981,7 @@ func (x *GetStatsResponse) String() string {
 func (*GetStatsResponse) ProtoMessage() {}
 
 func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2232,10 +2994,10 @@ func (x *GetStatsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetStatsResponse.ProtoReflect.Descriptor instead.
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{44}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetStatsResponse) GetStats() *pb4.Metrics {
+func (x *GetStatsResponse) GetStats() *pb5.Metrics {
 	if x != nil {
 		return x.Stats
 	}
@@ -2261,7 +3023,7 @@ type GetNodeConfigResponse struct {
 func (x *GetNodeConfigResponse) Reset() {
 	*x = GetNodeConfigResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2274,7 +3036,7 @@ func (x *GetNodeConfigResponse) String() string {
 func (*GetNodeConfigResponse) ProtoMessage() {}
 
 func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,7 +3049,7 @@ func (x *GetNodeConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetNodeConfigResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{45}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *GetNodeConfigResponse) GetTxPoolLimit() uint32 {
@@ -2370,7 +3132,7 @@ type SetNodeConfigRequest struct {
 func (x *SetNodeConfigRequest) Reset() {
 	*x = SetNodeConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2383,7 +3145,7 @@ func (x *SetNodeConfigRequest) String() string {
 func (*SetNodeConfigRequest) ProtoMessage() {}
 
 func (x *SetNodeConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2396,7 +3158,7 @@ func (x *SetNodeConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SetNodeConfigRequest.ProtoReflect.Descriptor instead.
 func (*SetNodeConfigRequest) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{46}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *SetNodeConfigRequest) GetUpdatedConfigs() []SetNodeConfigRequest_ConfigType {
@@ -2459,7 +3221,7 @@ type EstimateGasResponse struct {
 func (x *EstimateGasResponse) Reset() {
 	*x = EstimateGasResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2472,7 +3234,7 @@ func (x *EstimateGasResponse) String() string {
 func (*EstimateGasResponse) ProtoMessage() {}
 
 func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2485,7 +3247,7 @@ func (x *EstimateGasResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EstimateGasResponse.ProtoReflect.Descriptor instead.
 func (*EstimateGasResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{47}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *EstimateGasResponse) GetGasCount() []byte {
@@ -2506,7 +3268,7 @@ type GasPriceResponse struct {
 func (x *GasPriceResponse) Reset() {
 	*x = GasPriceResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2519,7 +3281,7 @@ func (x *GasPriceResponse) String() string {
 func (*GasPriceResponse) ProtoMessage() {}
 
 func (x *GasPriceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2532,7 +3294,7 @@ func (x *GasPriceResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GasPriceResponse.ProtoReflect.Descriptor instead.
 func (*GasPriceResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{48}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *GasPriceResponse) GetGasPrice() []byte {
@@ -2554,7 +3316,7 @@ type ContractQueryResponse struct {
 func (x *ContractQueryResponse) Reset() {
 	*x = ContractQueryResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
+		mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2567,7 +3329,7 @@ func (x *ContractQueryResponse) String() string {
 func (*ContractQueryResponse) ProtoMessage() {}
 
 func (x *ContractQueryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49]
+	mi := &file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2580,7 +3342,7 @@ func (x *ContractQueryResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ContractQueryResponse.ProtoReflect.Descriptor instead.
 func (*ContractQueryResponse) Descriptor() ([]byte, []int) {
-	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{49}
+	return file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *ContractQueryResponse) GetKey() string {
@@ -2614,382 +3376,509 @@ var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc = []byte{
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x35, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
 	0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70,
 	0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2f, 0x70,
-	0x62, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69,
+	0x62, 0x2f, 0x75, 0x74, 0x78, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x3b, 0x67, 0x69,
 	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
-	0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x77,
-	0x6f, 0x72, 0x6b, 0x2f, 0x70, 0x62, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61,
-	0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79,
-	0x2f, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x14, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x12, 0x41,
-	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2d, 0x0a, 0x11, 0x47,
-	0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x65,
-	0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x53,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e,
-	0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16,
-	0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x74, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12,
-	0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09,
-	0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74,
-	0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x0e, 0x41,
-	0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
-	0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79,
-	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x68,
-	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x56, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d, 0x0a,
-	0x1b, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c,
-	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a, 0x18,
-	0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f,
-	0x70, 0x69, 0x63, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21, 0x0a,
-	0x1f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x52, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a, 0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
-	0x41, 0x64, 0x64, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x15, 0x0a, 0x13,
-	0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2c, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x65,
-	0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12,
-	0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x78,
-	0x69, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x09, 0x70, 0x65, 0x65,
-	0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e,
-	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x62, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73, 0x74, 0x22, 0xa2, 0x01, 0x0a, 0x19,
+	0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x63, 0x6f, 0x72, 0x65,
+	0x2f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x2f, 0x70, 0x62, 0x2f, 0x68, 0x69, 0x73, 0x74,
+	0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x67, 0x6f,
+	0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
+	0x2f, 0x70, 0x62, 0x2f, 0x70, 0x65, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x38,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x61, 0x70, 0x70, 0x6c,
+	0x65, 0x79, 0x2f, 0x67, 0x6f, 0x2d, 0x64, 0x61, 0x70, 0x70, 0x6c, 0x65, 0x79, 0x2f, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2f, 0x70, 0x62, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x36, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x22, 0x16, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5b, 0x0a, 0x12, 0x41, 0x64, 0x64, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x10, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x2d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x3e, 0x0a, 0x14, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d,
+	0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x69, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x03, 0x74, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x1a, 0x0a, 0x18,
 	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x69,
-	0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0d, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
-	0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72,
-	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25,
-	0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x74, 0x78, 0x6f, 0x70, 0x62,
-	0x2e, 0x55, 0x74, 0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x0d,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x73, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24,
-	0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62,
-	0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x40, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52,
-	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x3c, 0x0a, 0x1a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22,
-	0x1e, 0x0a, 0x1c, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x59, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
-	0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1b,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x47,
-	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61,
-	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x20, 0x47, 0x65, 0x74,
-	0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x12, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x33, 0x0a, 0x0e,
+	0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6c, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x22, 0x38, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2a, 0x0a, 0x0e, 0x47,
+	0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5d, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x22, 0x31, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
+	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x56, 0x0a, 0x16, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5d,
+	0x0a, 0x1b, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a,
+	0x18, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2a, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x21,
+	0x0a, 0x1f, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x3e, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79,
+	0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x27, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2c, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f,
+	0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x02, 0x69, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6e, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x12, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70,
+	0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x63, 0x0a,
+	0x14, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x55, 0x6e, 0x6c,
+	0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x2c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0x17, 0x0a, 0x15, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4d, 0x0a, 0x0c, 0x53, 0x65, 0x6e,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x22, 0x47, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x30, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x70, 0x62, 0x2e, 0x50,
+	0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x4c, 0x69, 0x73,
+	0x74, 0x22, 0x75, 0x0a, 0x0d, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73,
+	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x06, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x5e, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x2a, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xbd, 0x01, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x61, 0x69, 0x6c, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x19, 0x0a,
+	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x50,
+	0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x53,
+	0x65, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x60, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x22, 0x70, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x75, 0x74, 0x78, 0x6f, 0x70, 0x62, 0x2e, 0x55, 0x74,
+	0x78, 0x6f, 0x52, 0x05, 0x75, 0x74, 0x78, 0x6f, 0x73, 0x12, 0x39, 0x0a, 0x0d, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x73, 0x22, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x06, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x22, 0x3e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0x40, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a,
 	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62,
 	0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c,
-	0x6f, 0x63, 0x6b, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74,
-	0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e,
-	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f,
-	0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12,
-	0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a,
-	0x0e, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x70, 0x66, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x22,
-	0xc8, 0x03, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0e, 0x32, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x78, 0x5f,
-	0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a,
-	0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75,
-	0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61,
-	0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x23, 0x0a,
-	0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65,
-	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18,
-	0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
-	0x22, 0x78, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11,
-	0x0a, 0x0d, 0x54, 0x58, 0x5f, 0x50, 0x4f, 0x4f, 0x4c, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10,
-	0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x4b, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4c, 0x49,
-	0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x4f, 0x4e,
-	0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0f, 0x0a, 0x0b, 0x4d, 0x41, 0x58, 0x5f, 0x43,
-	0x4f, 0x4e, 0x4e, 0x5f, 0x49, 0x4e, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x58, 0x5f,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x50,
-	0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10, 0x05, 0x22, 0x32, 0x0a, 0x13, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f,
-	0x0a, 0x10, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22,
-	0x3f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x32, 0xc8, 0x0a, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65,
-	0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61,
-	0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
-	0x5b, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a,
-	0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58,
-	0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x52,
-	0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
-	0x12, 0x52, 0x0a, 0x11, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42,
-	0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55,
-	0x0a, 0x12, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64,
-	0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74,
-	0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
-	0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x52,
-	0x70, 0x63, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x70,
-	0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
-	0x01, 0x12, 0x68, 0x0a, 0x1f, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x78,
-	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x6f, 0x63, 0x6b, 0x22, 0x57, 0x0a, 0x17, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c,
+	0x0a, 0x1a, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x18, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x1e, 0x0a, 0x1c,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x59, 0x0a, 0x15,
+	0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x04, 0x74, 0x78, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x59, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x27, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x22, 0x1b, 0x0a, 0x19, 0x47,
 	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x70, 0x0a, 0x1b, 0x52,
-	0x70, 0x63, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72,
-	0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x26, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61,
-	0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c,
-	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0e, 0x52, 0x70, 0x63, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12,
-	0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
-	0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47,
-	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70,
-	0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70,
-	0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xbb, 0x03, 0x0a, 0x0c,
-	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a,
-	0x52, 0x70, 0x63, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x52,
-	0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e,
-	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10,
-	0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72,
-	0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f,
-	0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69,
-	0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a,
-	0x0e, 0x52, 0x70, 0x63, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12,
-	0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x55,
-	0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x72,
-	0x70, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xfa, 0x01, 0x0a, 0x0d, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0b, 0x52,
-	0x70, 0x63, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63,
-	0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
-	0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x53, 0x65, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70,
-	0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
-	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x06, 0xa2, 0x02, 0x03, 0x48, 0x4c, 0x57, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41,
+	0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x48, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x22, 0x60, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70,
+	0x62, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x65, 0x22, 0xa6, 0x02, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x70, 0x62, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x24, 0x0a, 0x0d,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x73, 0x5f, 0x69, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x69, 0x73, 0x49,
+	0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xdb, 0x01, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72,
+	0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x70, 0x62,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x70, 0x62, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x75, 0x6d, 0x5f,
+	0x74, 0x78, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x54, 0x78,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x22, 0x6f, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x70, 0x62, 0x2e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x6e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3c, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x22, 0xeb, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74,
+	0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f,
+	0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x62, 0x6c, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x70, 0x66, 0x73, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x69, 0x70,
+	0x66, 0x73, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72,
+	0x70, 0x63, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72,
+	0x70, 0x63, 0x50, 0x6f, 0x72, 0x74, 0x22, 0xc8, 0x03, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4f, 0x0a, 0x0f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x0e, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73,
+	0x12, 0x22, 0x0a, 0x0d, 0x74, 0x78, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x74, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x62, 0x6c, 0x6b, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x62, 0x6c,
+	0x6b, 0x53, 0x69, 0x7a, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61,
+	0x78, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6f, 0x75, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4f, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x6d, 0x61, 0x78,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x73, 0x22, 0x78, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x54, 0x79, 0x70, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x54, 0x58, 0x5f, 0x50, 0x4f, 0x4f, 0x4c,
+	0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x00, 0x12, 0x12, 0x0a, 0x0e, 0x42, 0x4c, 0x4b, 0x5f,
+	0x53, 0x49, 0x5a, 0x45, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c,
+	0x4d, 0x41, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x4f, 0x55, 0x54, 0x10, 0x02, 0x12, 0x0f,
+	0x0a, 0x0b, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x4f, 0x4e, 0x4e, 0x5f, 0x49, 0x4e, 0x10, 0x03, 0x12,
+	0x11, 0x0a, 0x0d, 0x4d, 0x41, 0x58, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53,
+	0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x45, 0x52, 0x53, 0x10,
+	0x05, 0x22, 0x32, 0x0a, 0x13, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61, 0x73,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2f, 0x0a, 0x10, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x67, 0x61,
+	0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x3f, 0x0a, 0x15, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x32, 0xc8, 0x0d, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x46, 0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x46,
+	0x0a, 0x0d, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12,
+	0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1f,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58,
+	0x4f, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58,
+	0x4f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x54, 0x58, 0x4f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x11, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1c, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48,
+	0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x61, 0x73,
+	0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x13, 0x52,
+	0x70, 0x63, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x42, 0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x17,
+	0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x5d, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x77, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x45, 0x0a, 0x0c, 0x52, 0x70, 0x63, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x68, 0x0a, 0x1f, 0x52, 0x70, 0x63, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x20, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x70, 0x0a, 0x1b, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x26, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
+	0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x72, 0x70, 0x63, 0x70,
+	0x62, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74, 0x49, 0x72, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x62, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x6a, 0x0a, 0x19, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x46, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x12, 0x24, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x69, 0x6e,
+	0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x74, 0x79, 0x43, 0x65, 0x72, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x52, 0x0a, 0x11, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x61, 0x0a, 0x16, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x21,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x22, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x14, 0x52, 0x70, 0x63, 0x47, 0x65,
+	0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x20, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x47, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x40, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x16,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47,
+	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0xda, 0x04, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x52, 0x70, 0x63, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x12, 0x15, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62,
+	0x2e, 0x41, 0x64, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x34, 0x0a, 0x07, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x12, 0x12, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x13, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63, 0x47,
+	0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x65, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x53, 0x65, 0x6e, 0x64, 0x46, 0x72,
+	0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x6e,
+	0x64, 0x46, 0x72, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0e, 0x52, 0x70, 0x63, 0x41, 0x64, 0x64, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x12, 0x19, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41,
+	0x64, 0x64, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x41, 0x64, 0x64, 0x50, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x10, 0x52, 0x70, 0x63, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f,
+	0x63, 0x6b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x55, 0x6e, 0x6c, 0x6f, 0x63, 0x6b, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x58, 0x0a, 0x13, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1e, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x0c, 0x52, 0x70,
+	0x63, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x72, 0x70, 0x63,
+	0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x61, 0x6c,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xfa, 0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x46, 0x0a, 0x0b, 0x52, 0x70, 0x63, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x1c, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
+	0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x50, 0x0a, 0x10, 0x52, 0x70, 0x63,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e,
+	0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72, 0x70,
+	0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x10, 0x52,
+	0x70, 0x63, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x1b, 0x2e, 0x72, 0x70, 0x63, 0x70, 0x62, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x72,
+	0x70, 0x63, 0x70, 0x62, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x06, 0xa2, 0x02,
+	0x03, 0x48, 0x4c, 0x57, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -3005,7 +3894,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDescGZIP() []byte {
 }
 
 var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 50)
+var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
 var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_goTypes = []interface{}{
 	(SetNodeConfigRequest_ConfigType)(0),     // 0: rpcpb.SetNodeConfigRequest.ConfigType
 	(*CreateAccountRequest)(nil),             // 1: rpcpb.CreateAccountRequest
@@ -3015,126 +3904,159 @@ var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_goTypes = []interface{}{
 	(*SendFromMinerRequest)(nil),             // 5: rpcpb.SendFromMinerRequest
 	(*SendRequest)(nil),                      // 6: rpcpb.SendRequest
 	(*GetPeerInfoRequest)(nil),               // 7: rpcpb.GetPeerInfoRequest
-	(*GetBlockchainInfoRequest)(nil),         // 8: rpcpb.GetBlockchainInfoRequest
-	(*AddPeerRequest)(nil),                   // 9: rpcpb.AddPeerRequest
-	(*GetVersionRequest)(nil),                // 10: rpcpb.GetVersionRequest
-	(*GetUTXORequest)(nil),                   // 11: rpcpb.GetUTXORequest
-	(*GetBlocksRequest)(nil),                 // 12: rpcpb.GetBlocksRequest
-	(*GetBlockByHashRequest)(nil),            // 13: rpcpb.GetBlockByHashRequest
-	(*GetBlockByHeightRequest)(nil),          // 14: rpcpb.GetBlockByHeightRequest
-	(*SendTransactionRequest)(nil),           // 15: rpcpb.SendTransactionRequest
-	(*SendBatchTransactionRequest)(nil),      // 16: rpcpb.SendBatchTransactionRequest
-	(*GetNewTransactionRequest)(nil),         // 17: rpcpb.GetNewTransactionRequest
-	(*SubscribeRequest)(nil),                 // 18: rpcpb.SubscribeRequest
-	(*MetricsServiceRequest)(nil),            // 19: rpcpb.MetricsServiceRequest
-	(*GetLastIrreversibleBlockRequest)(nil),  // 20: rpcpb.GetLastIrreversibleBlockRequest
-	(*EstimateGasRequest)(nil),               // 21: rpcpb.EstimateGasRequest
-	(*GasPriceRequest)(nil),                  // 22: rpcpb.GasPriceRequest
-	(*ContractQueryRequest)(nil),             // 23: rpcpb.ContractQueryRequest
-	(*AddProducerResponse)(nil),              // 24: rpcpb.AddProducerResponse
-	(*UnlockAccountResponse)(nil),            // 25: rpcpb.UnlockAccountResponse
-	(*GetBalanceResponse)(nil),               // 26: rpcpb.GetBalanceResponse
-	(*SendFromMinerResponse)(nil),            // 27: rpcpb.SendFromMinerResponse
-	(*SendResponse)(nil),                     // 28: rpcpb.SendResponse
-	(*GetPeerInfoResponse)(nil),              // 29: rpcpb.GetPeerInfoResponse
-	(*GetBlockchainInfoResponse)(nil),        // 30: rpcpb.GetBlockchainInfoResponse
-	(*AddPeerResponse)(nil),                  // 31: rpcpb.AddPeerResponse
-	(*GetVersionResponse)(nil),               // 32: rpcpb.GetVersionResponse
-	(*GetUTXOResponse)(nil),                  // 33: rpcpb.GetUTXOResponse
-	(*GetBlocksResponse)(nil),                // 34: rpcpb.GetBlocksResponse
-	(*GetBlockByHashResponse)(nil),           // 35: rpcpb.GetBlockByHashResponse
-	(*GetBlockByHeightResponse)(nil),         // 36: rpcpb.GetBlockByHeightResponse
-	(*SendTransactionResponse)(nil),          // 37: rpcpb.SendTransactionResponse
-	(*SendBatchTransactionResponse)(nil),     // 38: rpcpb.SendBatchTransactionResponse
-	(*SendTransactionStatus)(nil),            // 39: rpcpb.SendTransactionStatus
-	(*GetNewTransactionResponse)(nil),        // 40: rpcpb.GetNewTransactionResponse
-	(*SubscribeResponse)(nil),                // 41: rpcpb.SubscribeResponse
-	(*GetAllTransactionsRequest)(nil),        // 42: rpcpb.GetAllTransactionsRequest
-	(*GetAllTransactionsResponse)(nil),       // 43: rpcpb.GetAllTransactionsResponse
-	(*GetLastIrreversibleBlockResponse)(nil), // 44: rpcpb.GetLastIrreversibleBlockResponse
-	(*GetStatsResponse)(nil),                 // 45: rpcpb.GetStatsResponse
-	(*GetNodeConfigResponse)(nil),            // 46: rpcpb.GetNodeConfigResponse
-	(*SetNodeConfigRequest)(nil),             // 47: rpcpb.SetNodeConfigRequest
-	(*EstimateGasResponse)(nil),              // 48: rpcpb.EstimateGasResponse
-	(*GasPriceResponse)(nil),                 // 49: rpcpb.GasPriceResponse
-	(*ContractQueryResponse)(nil),            // 50: rpcpb.ContractQueryResponse
-	(*pb.Transaction)(nil),                   // 51: transactionpb.Transaction
-	(*pb1.PeerInfo)(nil),                     // 52: networkpb.PeerInfo
-	(*pb2.Utxo)(nil),                         // 53: utxopb.Utxo
-	(*pb3.BlockHeader)(nil),                  // 54: blockpb.BlockHeader
-	(*pb3.Block)(nil),                        // 55: blockpb.Block
-	(*pb4.Metrics)(nil),                      // 56: metricspb.Metrics
+	(*GetProducerStatsRequest)(nil),          // 8: rpcpb.GetProducerStatsRequest
+	(*GetBlockchainInfoRequest)(nil),         // 9: rpcpb.GetBlockchainInfoRequest
+	(*SealBlockRequest)(nil),                 // 10: rpcpb.SealBlockRequest
+	(*AddPeerRequest)(nil),                   // 11: rpcpb.AddPeerRequest
+	(*GetVersionRequest)(nil),                // 12: rpcpb.GetVersionRequest
+	(*GetUTXORequest)(nil),                   // 13: rpcpb.GetUTXORequest
+	(*GetBlocksRequest)(nil),                 // 14: rpcpb.GetBlocksRequest
+	(*GetBlockByHashRequest)(nil),            // 15: rpcpb.GetBlockByHashRequest
+	(*GetBlockByHeightRequest)(nil),          // 16: rpcpb.GetBlockByHeightRequest
+	(*SendTransactionRequest)(nil),           // 17: rpcpb.SendTransactionRequest
+	(*SendBatchTransactionRequest)(nil),      // 18: rpcpb.SendBatchTransactionRequest
+	(*GetNewTransactionRequest)(nil),         // 19: rpcpb.GetNewTransactionRequest
+	(*SubscribeRequest)(nil),                 // 20: rpcpb.SubscribeRequest
+	(*MetricsServiceRequest)(nil),            // 21: rpcpb.MetricsServiceRequest
+	(*GetLastIrreversibleBlockRequest)(nil),  // 22: rpcpb.GetLastIrreversibleBlockRequest
+	(*GetFinalityCertificateRequest)(nil),    // 23: rpcpb.GetFinalityCertificateRequest
+	(*GetTransactionRequest)(nil),            // 24: rpcpb.GetTransactionRequest
+	(*GetTransactionProofRequest)(nil),       // 25: rpcpb.GetTransactionProofRequest
+	(*GetAddressHistoryRequest)(nil),         // 26: rpcpb.GetAddressHistoryRequest
+	(*EstimateGasRequest)(nil),               // 27: rpcpb.EstimateGasRequest
+	(*GasPriceRequest)(nil),                  // 28: rpcpb.GasPriceRequest
+	(*ContractQueryRequest)(nil),             // 29: rpcpb.ContractQueryRequest
+	(*AddProducerResponse)(nil),              // 30: rpcpb.AddProducerResponse
+	(*UnlockAccountResponse)(nil),            // 31: rpcpb.UnlockAccountResponse
+	(*GetBalanceResponse)(nil),               // 32: rpcpb.GetBalanceResponse
+	(*SendFromMinerResponse)(nil),            // 33: rpcpb.SendFromMinerResponse
+	(*SendResponse)(nil),                     // 34: rpcpb.SendResponse
+	(*GetPeerInfoResponse)(nil),              // 35: rpcpb.GetPeerInfoResponse
+	(*ProducerStats)(nil),                    // 36: rpcpb.ProducerStats
+	(*GetProducerStatsResponse)(nil),         // 37: rpcpb.GetProducerStatsResponse
+	(*GetBlockchainInfoResponse)(nil),        // 38: rpcpb.GetBlockchainInfoResponse
+	(*AddPeerResponse)(nil),                  // 39: rpcpb.AddPeerResponse
+	(*SealBlockResponse)(nil),                // 40: rpcpb.SealBlockResponse
+	(*GetVersionResponse)(nil),               // 41: rpcpb.GetVersionResponse
+	(*GetUTXOResponse)(nil),                  // 42: rpcpb.GetUTXOResponse
+	(*GetBlocksResponse)(nil),                // 43: rpcpb.GetBlocksResponse
+	(*GetBlockByHashResponse)(nil),           // 44: rpcpb.GetBlockByHashResponse
+	(*GetBlockByHeightResponse)(nil),         // 45: rpcpb.GetBlockByHeightResponse
+	(*SendTransactionResponse)(nil),          // 46: rpcpb.SendTransactionResponse
+	(*SendBatchTransactionResponse)(nil),     // 47: rpcpb.SendBatchTransactionResponse
+	(*SendTransactionStatus)(nil),            // 48: rpcpb.SendTransactionStatus
+	(*GetNewTransactionResponse)(nil),        // 49: rpcpb.GetNewTransactionResponse
+	(*SubscribeResponse)(nil),                // 50: rpcpb.SubscribeResponse
+	(*GetAllTransactionsRequest)(nil),        // 51: rpcpb.GetAllTransactionsRequest
+	(*GetAllTransactionsResponse)(nil),       // 52: rpcpb.GetAllTransactionsResponse
+	(*GetLastIrreversibleBlockResponse)(nil), // 53: rpcpb.GetLastIrreversibleBlockResponse
+	(*GetFinalityCertificateResponse)(nil),   // 54: rpcpb.GetFinalityCertificateResponse
+	(*GetTransactionResponse)(nil),           // 55: rpcpb.GetTransactionResponse
+	(*GetTransactionProofResponse)(nil),      // 56: rpcpb.GetTransactionProofResponse
+	(*GetAddressHistoryResponse)(nil),        // 57: rpcpb.GetAddressHistoryResponse
+	(*GetStatsResponse)(nil),                 // 58: rpcpb.GetStatsResponse
+	(*GetNodeConfigResponse)(nil),            // 59: rpcpb.GetNodeConfigResponse
+	(*SetNodeConfigRequest)(nil),             // 60: rpcpb.SetNodeConfigRequest
+	(*EstimateGasResponse)(nil),              // 61: rpcpb.EstimateGasResponse
+	(*GasPriceResponse)(nil),                 // 62: rpcpb.GasPriceResponse
+	(*ContractQueryResponse)(nil),            // 63: rpcpb.ContractQueryResponse
+	(*pb.Transaction)(nil),                   // 64: transactionpb.Transaction
+	(*pb1.PeerInfo)(nil),                     // 65: networkpb.PeerInfo
+	(*pb2.Utxo)(nil),                         // 66: utxopb.Utxo
+	(*pb3.BlockHeader)(nil),                  // 67: blockpb.BlockHeader
+	(*pb3.Block)(nil),                        // 68: blockpb.Block
+	(*pb3.FinalityCertificate)(nil),          // 69: blockpb.FinalityCertificate
+	(*pb4.HistoryEntry)(nil),                 // 70: historypb.HistoryEntry
+	(*pb5.Metrics)(nil),                      // 71: metricspb.Metrics
 }
 var file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_depIdxs = []int32{
-	51, // 0: rpcpb.SendTransactionRequest.transaction:type_name -> transactionpb.Transaction
-	51, // 1: rpcpb.SendBatchTransactionRequest.transactions:type_name -> transactionpb.Transaction
-	51, // 2: rpcpb.EstimateGasRequest.transaction:type_name -> transactionpb.Transaction
-	52, // 3: rpcpb.GetPeerInfoResponse.peer_list:type_name -> networkpb.PeerInfo
-	53, // 4: rpcpb.GetUTXOResponse.utxos:type_name -> utxopb.Utxo
-	54, // 5: rpcpb.GetUTXOResponse.block_headers:type_name -> blockpb.BlockHeader
-	55, // 6: rpcpb.GetBlocksResponse.blocks:type_name -> blockpb.Block
-	55, // 7: rpcpb.GetBlockByHashResponse.block:type_name -> blockpb.Block
-	55, // 8: rpcpb.GetBlockByHeightResponse.block:type_name -> blockpb.Block
-	51, // 9: rpcpb.GetNewTransactionResponse.transaction:type_name -> transactionpb.Transaction
-	51, // 10: rpcpb.GetAllTransactionsResponse.transactions:type_name -> transactionpb.Transaction
-	55, // 11: rpcpb.GetLastIrreversibleBlockResponse.block:type_name -> blockpb.Block
-	56, // 12: rpcpb.GetStatsResponse.stats:type_name -> metricspb.Metrics
-	0,  // 13: rpcpb.SetNodeConfigRequest.updated_configs:type_name -> rpcpb.SetNodeConfigRequest.ConfigType
-	10, // 14: rpcpb.RpcService.RpcGetVersion:input_type -> rpcpb.GetVersionRequest
-	4,  // 15: rpcpb.RpcService.RpcGetBalance:input_type -> rpcpb.GetBalanceRequest
-	8,  // 16: rpcpb.RpcService.RpcGetBlockchainInfo:input_type -> rpcpb.GetBlockchainInfoRequest
-	11, // 17: rpcpb.RpcService.RpcGetUTXO:input_type -> rpcpb.GetUTXORequest
-	12, // 18: rpcpb.RpcService.RpcGetBlocks:input_type -> rpcpb.GetBlocksRequest
-	13, // 19: rpcpb.RpcService.RpcGetBlockByHash:input_type -> rpcpb.GetBlockByHashRequest
-	14, // 20: rpcpb.RpcService.RpcGetBlockByHeight:input_type -> rpcpb.GetBlockByHeightRequest
-	15, // 21: rpcpb.RpcService.RpcSendTransaction:input_type -> rpcpb.SendTransactionRequest
-	16, // 22: rpcpb.RpcService.RpcSendBatchTransaction:input_type -> rpcpb.SendBatchTransactionRequest
-	17, // 23: rpcpb.RpcService.RpcGetNewTransaction:input_type -> rpcpb.GetNewTransactionRequest
-	18, // 24: rpcpb.RpcService.RpcSubscribe:input_type -> rpcpb.SubscribeRequest
-	42, // 25: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:input_type -> rpcpb.GetAllTransactionsRequest
-	20, // 26: rpcpb.RpcService.RpcGetLastIrreversibleBlock:input_type -> rpcpb.GetLastIrreversibleBlockRequest
-	21, // 27: rpcpb.RpcService.RpcEstimateGas:input_type -> rpcpb.EstimateGasRequest
-	22, // 28: rpcpb.RpcService.RpcGasPrice:input_type -> rpcpb.GasPriceRequest
-	23, // 29: rpcpb.RpcService.RpcContractQuery:input_type -> rpcpb.ContractQueryRequest
-	9,  // 30: rpcpb.AdminService.RpcAddPeer:input_type -> rpcpb.AddPeerRequest
-	6,  // 31: rpcpb.AdminService.RpcSend:input_type -> rpcpb.SendRequest
-	7,  // 32: rpcpb.AdminService.RpcGetPeerInfo:input_type -> rpcpb.GetPeerInfoRequest
-	5,  // 33: rpcpb.AdminService.RpcSendFromMiner:input_type -> rpcpb.SendFromMinerRequest
-	3,  // 34: rpcpb.AdminService.RpcAddProducer:input_type -> rpcpb.AddProducerRequest
-	2,  // 35: rpcpb.AdminService.RpcUnlockAccount:input_type -> rpcpb.UnlockAccountRequest
-	19, // 36: rpcpb.MetricService.RpcGetStats:input_type -> rpcpb.MetricsServiceRequest
-	19, // 37: rpcpb.MetricService.RpcGetNodeConfig:input_type -> rpcpb.MetricsServiceRequest
-	47, // 38: rpcpb.MetricService.RpcSetNodeConfig:input_type -> rpcpb.SetNodeConfigRequest
-	32, // 39: rpcpb.RpcService.RpcGetVersion:output_type -> rpcpb.GetVersionResponse
-	26, // 40: rpcpb.RpcService.RpcGetBalance:output_type -> rpcpb.GetBalanceResponse
-	30, // 41: rpcpb.RpcService.RpcGetBlockchainInfo:output_type -> rpcpb.GetBlockchainInfoResponse
-	33, // 42: rpcpb.RpcService.RpcGetUTXO:output_type -> rpcpb.GetUTXOResponse
-	34, // 43: rpcpb.RpcService.RpcGetBlocks:output_type -> rpcpb.GetBlocksResponse
-	35, // 44: rpcpb.RpcService.RpcGetBlockByHash:output_type -> rpcpb.GetBlockByHashResponse
-	36, // 45: rpcpb.RpcService.RpcGetBlockByHeight:output_type -> rpcpb.GetBlockByHeightResponse
-	37, // 46: rpcpb.RpcService.RpcSendTransaction:output_type -> rpcpb.SendTransactionResponse
-	38, // 47: rpcpb.RpcService.RpcSendBatchTransaction:output_type -> rpcpb.SendBatchTransactionResponse
-	40, // 48: rpcpb.RpcService.RpcGetNewTransaction:output_type -> rpcpb.GetNewTransactionResponse
-	41, // 49: rpcpb.RpcService.RpcSubscribe:output_type -> rpcpb.SubscribeResponse
-	43, // 50: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:output_type -> rpcpb.GetAllTransactionsResponse
-	44, // 51: rpcpb.RpcService.RpcGetLastIrreversibleBlock:output_type -> rpcpb.GetLastIrreversibleBlockResponse
-	48, // 52: rpcpb.RpcService.RpcEstimateGas:output_type -> rpcpb.EstimateGasResponse
-	49, // 53: rpcpb.RpcService.RpcGasPrice:output_type -> rpcpb.GasPriceResponse
-	50, // 54: rpcpb.RpcService.RpcContractQuery:output_type -> rpcpb.ContractQueryResponse
-	31, // 55: rpcpb.AdminService.RpcAddPeer:output_type -> rpcpb.AddPeerResponse
-	28, // 56: rpcpb.AdminService.RpcSend:output_type -> rpcpb.SendResponse
-	29, // 57: rpcpb.AdminService.RpcGetPeerInfo:output_type -> rpcpb.GetPeerInfoResponse
-	27, // 58: rpcpb.AdminService.RpcSendFromMiner:output_type -> rpcpb.SendFromMinerResponse
-	24, // 59: rpcpb.AdminService.RpcAddProducer:output_type -> rpcpb.AddProducerResponse
-	25, // 60: rpcpb.AdminService.RpcUnlockAccount:output_type -> rpcpb.UnlockAccountResponse
-	45, // 61: rpcpb.MetricService.RpcGetStats:output_type -> rpcpb.GetStatsResponse
-	46, // 62: rpcpb.MetricService.RpcGetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
-	46, // 63: rpcpb.MetricService.RpcSetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
-	39, // [39:64] is the sub-list for method output_type
-	14, // [14:39] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	64, // 0: rpcpb.SendTransactionRequest.transaction:type_name -> transactionpb.Transaction
+	64, // 1: rpcpb.SendBatchTransactionRequest.transactions:type_name -> transactionpb.Transaction
+	64, // 2: rpcpb.EstimateGasRequest.transaction:type_name -> transactionpb.Transaction
+	65, // 3: rpcpb.GetPeerInfoResponse.peer_list:type_name -> networkpb.PeerInfo
+	36, // 4: rpcpb.GetProducerStatsResponse.stats:type_name -> rpcpb.ProducerStats
+	66, // 5: rpcpb.GetUTXOResponse.utxos:type_name -> utxopb.Utxo
+	67, // 6: rpcpb.GetUTXOResponse.block_headers:type_name -> blockpb.BlockHeader
+	68, // 7: rpcpb.GetBlocksResponse.blocks:type_name -> blockpb.Block
+	68, // 8: rpcpb.GetBlockByHashResponse.block:type_name -> blockpb.Block
+	68, // 9: rpcpb.GetBlockByHeightResponse.block:type_name -> blockpb.Block
+	64, // 10: rpcpb.GetNewTransactionResponse.transaction:type_name -> transactionpb.Transaction
+	64, // 11: rpcpb.GetAllTransactionsResponse.transactions:type_name -> transactionpb.Transaction
+	68, // 12: rpcpb.GetLastIrreversibleBlockResponse.block:type_name -> blockpb.Block
+	69, // 13: rpcpb.GetFinalityCertificateResponse.certificate:type_name -> blockpb.FinalityCertificate
+	64, // 14: rpcpb.GetTransactionResponse.transaction:type_name -> transactionpb.Transaction
+	64, // 15: rpcpb.GetTransactionProofResponse.transaction:type_name -> transactionpb.Transaction
+	67, // 16: rpcpb.GetTransactionProofResponse.block_header:type_name -> blockpb.BlockHeader
+	70, // 17: rpcpb.GetAddressHistoryResponse.entries:type_name -> historypb.HistoryEntry
+	71, // 18: rpcpb.GetStatsResponse.stats:type_name -> metricspb.Metrics
+	0,  // 19: rpcpb.SetNodeConfigRequest.updated_configs:type_name -> rpcpb.SetNodeConfigRequest.ConfigType
+	12, // 20: rpcpb.RpcService.RpcGetVersion:input_type -> rpcpb.GetVersionRequest
+	4,  // 21: rpcpb.RpcService.RpcGetBalance:input_type -> rpcpb.GetBalanceRequest
+	9,  // 22: rpcpb.RpcService.RpcGetBlockchainInfo:input_type -> rpcpb.GetBlockchainInfoRequest
+	13, // 23: rpcpb.RpcService.RpcGetUTXO:input_type -> rpcpb.GetUTXORequest
+	14, // 24: rpcpb.RpcService.RpcGetBlocks:input_type -> rpcpb.GetBlocksRequest
+	15, // 25: rpcpb.RpcService.RpcGetBlockByHash:input_type -> rpcpb.GetBlockByHashRequest
+	16, // 26: rpcpb.RpcService.RpcGetBlockByHeight:input_type -> rpcpb.GetBlockByHeightRequest
+	17, // 27: rpcpb.RpcService.RpcSendTransaction:input_type -> rpcpb.SendTransactionRequest
+	18, // 28: rpcpb.RpcService.RpcSendBatchTransaction:input_type -> rpcpb.SendBatchTransactionRequest
+	19, // 29: rpcpb.RpcService.RpcGetNewTransaction:input_type -> rpcpb.GetNewTransactionRequest
+	20, // 30: rpcpb.RpcService.RpcSubscribe:input_type -> rpcpb.SubscribeRequest
+	51, // 31: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:input_type -> rpcpb.GetAllTransactionsRequest
+	22, // 32: rpcpb.RpcService.RpcGetLastIrreversibleBlock:input_type -> rpcpb.GetLastIrreversibleBlockRequest
+	23, // 33: rpcpb.RpcService.RpcGetFinalityCertificate:input_type -> rpcpb.GetFinalityCertificateRequest
+	24, // 34: rpcpb.RpcService.RpcGetTransaction:input_type -> rpcpb.GetTransactionRequest
+	25, // 35: rpcpb.RpcService.RpcGetTransactionProof:input_type -> rpcpb.GetTransactionProofRequest
+	26, // 36: rpcpb.RpcService.RpcGetAddressHistory:input_type -> rpcpb.GetAddressHistoryRequest
+	27, // 37: rpcpb.RpcService.RpcEstimateGas:input_type -> rpcpb.EstimateGasRequest
+	28, // 38: rpcpb.RpcService.RpcGasPrice:input_type -> rpcpb.GasPriceRequest
+	29, // 39: rpcpb.RpcService.RpcContractQuery:input_type -> rpcpb.ContractQueryRequest
+	11, // 40: rpcpb.AdminService.RpcAddPeer:input_type -> rpcpb.AddPeerRequest
+	6,  // 41: rpcpb.AdminService.RpcSend:input_type -> rpcpb.SendRequest
+	7,  // 42: rpcpb.AdminService.RpcGetPeerInfo:input_type -> rpcpb.GetPeerInfoRequest
+	5,  // 43: rpcpb.AdminService.RpcSendFromMiner:input_type -> rpcpb.SendFromMinerRequest
+	3,  // 44: rpcpb.AdminService.RpcAddProducer:input_type -> rpcpb.AddProducerRequest
+	2,  // 45: rpcpb.AdminService.RpcUnlockAccount:input_type -> rpcpb.UnlockAccountRequest
+	8,  // 46: rpcpb.AdminService.RpcGetProducerStats:input_type -> rpcpb.GetProducerStatsRequest
+	10, // 47: rpcpb.AdminService.RpcSealBlock:input_type -> rpcpb.SealBlockRequest
+	21, // 48: rpcpb.MetricService.RpcGetStats:input_type -> rpcpb.MetricsServiceRequest
+	21, // 49: rpcpb.MetricService.RpcGetNodeConfig:input_type -> rpcpb.MetricsServiceRequest
+	60, // 50: rpcpb.MetricService.RpcSetNodeConfig:input_type -> rpcpb.SetNodeConfigRequest
+	41, // 51: rpcpb.RpcService.RpcGetVersion:output_type -> rpcpb.GetVersionResponse
+	32, // 52: rpcpb.RpcService.RpcGetBalance:output_type -> rpcpb.GetBalanceResponse
+	38, // 53: rpcpb.RpcService.RpcGetBlockchainInfo:output_type -> rpcpb.GetBlockchainInfoResponse
+	42, // 54: rpcpb.RpcService.RpcGetUTXO:output_type -> rpcpb.GetUTXOResponse
+	43, // 55: rpcpb.RpcService.RpcGetBlocks:output_type -> rpcpb.GetBlocksResponse
+	44, // 56: rpcpb.RpcService.RpcGetBlockByHash:output_type -> rpcpb.GetBlockByHashResponse
+	45, // 57: rpcpb.RpcService.RpcGetBlockByHeight:output_type -> rpcpb.GetBlockByHeightResponse
+	46, // 58: rpcpb.RpcService.RpcSendTransaction:output_type -> rpcpb.SendTransactionResponse
+	47, // 59: rpcpb.RpcService.RpcSendBatchTransaction:output_type -> rpcpb.SendBatchTransactionResponse
+	49, // 60: rpcpb.RpcService.RpcGetNewTransaction:output_type -> rpcpb.GetNewTransactionResponse
+	50, // 61: rpcpb.RpcService.RpcSubscribe:output_type -> rpcpb.SubscribeResponse
+	52, // 62: rpcpb.RpcService.RpcGetAllTransactionsFromTxPool:output_type -> rpcpb.GetAllTransactionsResponse
+	53, // 63: rpcpb.RpcService.RpcGetLastIrreversibleBlock:output_type -> rpcpb.GetLastIrreversibleBlockResponse
+	54, // 64: rpcpb.RpcService.RpcGetFinalityCertificate:output_type -> rpcpb.GetFinalityCertificateResponse
+	55, // 65: rpcpb.RpcService.RpcGetTransaction:output_type -> rpcpb.GetTransactionResponse
+	56, // 66: rpcpb.RpcService.RpcGetTransactionProof:output_type -> rpcpb.GetTransactionProofResponse
+	57, // 67: rpcpb.RpcService.RpcGetAddressHistory:output_type -> rpcpb.GetAddressHistoryResponse
+	61, // 68: rpcpb.RpcService.RpcEstimateGas:output_type -> rpcpb.EstimateGasResponse
+	62, // 69: rpcpb.RpcService.RpcGasPrice:output_type -> rpcpb.GasPriceResponse
+	63, // 70: rpcpb.RpcService.RpcContractQuery:output_type -> rpcpb.ContractQueryResponse
+	39, // 71: rpcpb.AdminService.RpcAddPeer:output_type -> rpcpb.AddPeerResponse
+	34, // 72: rpcpb.AdminService.RpcSend:output_type -> rpcpb.SendResponse
+	35, // 73: rpcpb.AdminService.RpcGetPeerInfo:output_type -> rpcpb.GetPeerInfoResponse
+	33, // 74: rpcpb.AdminService.RpcSendFromMiner:output_type -> rpcpb.SendFromMinerResponse
+	30, // 75: rpcpb.AdminService.RpcAddProducer:output_type -> rpcpb.AddProducerResponse
+	31, // 76: rpcpb.AdminService.RpcUnlockAccount:output_type -> rpcpb.UnlockAccountResponse
+	37, // 77: rpcpb.AdminService.RpcGetProducerStats:output_type -> rpcpb.GetProducerStatsResponse
+	40, // 78: rpcpb.AdminService.RpcSealBlock:output_type -> rpcpb.SealBlockResponse
+	58, // 79: rpcpb.MetricService.RpcGetStats:output_type -> rpcpb.GetStatsResponse
+	59, // 80: rpcpb.MetricService.RpcGetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
+	59, // 81: rpcpb.MetricService.RpcSetNodeConfig:output_type -> rpcpb.GetNodeConfigResponse
+	51, // [51:82] is the sub-list for method output_type
+	20, // [20:51] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() }
@@ -3228,7 +4150,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 			}
 		}
 		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoRequest); i {
+			switch v := v.(*GetProducerStatsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3240,7 +4162,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 			}
 		}
 		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerRequest); i {
+			switch v := v.(*GetBlockchainInfoRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3252,7 +4174,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 			}
 		}
 		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionRequest); i {
+			switch v := v.(*SealBlockRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3264,6 +4186,30 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 			}
 		}
 		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUTXORequest); i {
 			case 0:
 				return &v.state
@@ -3275,8 +4221,140 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlocksRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlocksRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHeightRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBatchTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MetricsServiceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastIrreversibleBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinalityCertificateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionProofRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3287,8 +4365,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHashRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressHistoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3299,8 +4377,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHeightRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateGasRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3311,8 +4389,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GasPriceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3323,8 +4401,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBatchTransactionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractQueryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3335,8 +4413,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewTransactionRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddProducerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3347,8 +4425,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnlockAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3359,8 +4437,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MetricsServiceRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBalanceResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3371,8 +4449,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastIrreversibleBlockRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendFromMinerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3383,8 +4461,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateGasRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3395,8 +4473,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GasPriceRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPeerInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3407,8 +4485,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContractQueryRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProducerStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3419,8 +4497,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddProducerResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProducerStatsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3431,8 +4509,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnlockAccountResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockchainInfoResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3443,8 +4521,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBalanceResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddPeerResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3455,8 +4533,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendFromMinerResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SealBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3467,8 +4545,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3479,8 +4557,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPeerInfoResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUTXOResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3491,8 +4569,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockchainInfoResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlocksResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3503,8 +4581,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddPeerResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHashResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3515,8 +4593,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetVersionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockByHeightResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3527,8 +4605,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUTXOResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3539,8 +4617,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlocksResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendBatchTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3551,8 +4629,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHashResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendTransactionStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3563,8 +4641,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetBlockByHeightResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNewTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3575,8 +4653,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3587,8 +4665,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendBatchTransactionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTransactionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3599,8 +4677,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendTransactionStatus); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAllTransactionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3611,8 +4689,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNewTransactionResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLastIrreversibleBlockResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3623,8 +4701,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFinalityCertificateResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3635,8 +4713,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTransactionsRequest); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3647,8 +4725,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAllTransactionsResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTransactionProofResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3659,8 +4737,8 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetLastIrreversibleBlockResponse); i {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAddressHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3671,7 +4749,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetStatsResponse); i {
 			case 0:
 				return &v.state
@@ -3683,7 +4761,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetNodeConfigResponse); i {
 			case 0:
 				return &v.state
@@ -3695,7 +4773,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetNodeConfigRequest); i {
 			case 0:
 				return &v.state
@@ -3707,7 +4785,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*EstimateGasResponse); i {
 			case 0:
 				return &v.state
@@ -3719,7 +4797,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GasPriceResponse); i {
 			case 0:
 				return &v.state
@@ -3731,7 +4809,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ContractQueryResponse); i {
 			case 0:
 				return &v.state
@@ -3750,7 +4828,7 @@ func file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_github_com_dappley_go_dappley_rpc_pb_rpc_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   50,
+			NumMessages:   63,
 			NumExtensions: 0,
 			NumServices:   3,
 		},
@@ -3790,6 +4868,10 @@ type RpcServiceClient interface {
 	RpcSubscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (RpcService_RpcSubscribeClient, error)
 	RpcGetAllTransactionsFromTxPool(ctx context.Context, in *GetAllTransactionsRequest, opts ...grpc.CallOption) (*GetAllTransactionsResponse, error)
 	RpcGetLastIrreversibleBlock(ctx context.Context, in *GetLastIrreversibleBlockRequest, opts ...grpc.CallOption) (*GetLastIrreversibleBlockResponse, error)
+	RpcGetFinalityCertificate(ctx context.Context, in *GetFinalityCertificateRequest, opts ...grpc.CallOption) (*GetFinalityCertificateResponse, error)
+	RpcGetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error)
+	RpcGetTransactionProof(ctx context.Context, in *GetTransactionProofRequest, opts ...grpc.CallOption) (*GetTransactionProofResponse, error)
+	RpcGetAddressHistory(ctx context.Context, in *GetAddressHistoryRequest, opts ...grpc.CallOption) (*GetAddressHistoryResponse, error)
 	RpcEstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error)
 	RpcGasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
 	RpcContractQuery(ctx context.Context, in *ContractQueryRequest, opts ...grpc.CallOption) (*ContractQueryResponse, error)
@@ -3966,6 +5048,42 @@ func (c *rpcServiceClient) RpcGetLastIrreversibleBlock(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *rpcServiceClient) RpcGetFinalityCertificate(ctx context.Context, in *GetFinalityCertificateRequest, opts ...grpc.CallOption) (*GetFinalityCertificateResponse, error) {
+	out := new(GetFinalityCertificateResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetFinalityCertificate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcGetTransaction(ctx context.Context, in *GetTransactionRequest, opts ...grpc.CallOption) (*GetTransactionResponse, error) {
+	out := new(GetTransactionResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcGetTransactionProof(ctx context.Context, in *GetTransactionProofRequest, opts ...grpc.CallOption) (*GetTransactionProofResponse, error) {
+	out := new(GetTransactionProofResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetTransactionProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rpcServiceClient) RpcGetAddressHistory(ctx context.Context, in *GetAddressHistoryRequest, opts ...grpc.CallOption) (*GetAddressHistoryResponse, error) {
+	out := new(GetAddressHistoryResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcGetAddressHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rpcServiceClient) RpcEstimateGas(ctx context.Context, in *EstimateGasRequest, opts ...grpc.CallOption) (*EstimateGasResponse, error) {
 	out := new(EstimateGasResponse)
 	err := c.cc.Invoke(ctx, "/rpcpb.RpcService/RpcEstimateGas", in, out, opts...)
@@ -4008,6 +5126,10 @@ type RpcServiceServer interface {
 	RpcSubscribe(*SubscribeRequest, RpcService_RpcSubscribeServer) error
 	RpcGetAllTransactionsFromTxPool(context.Context, *GetAllTransactionsRequest) (*GetAllTransactionsResponse, error)
 	RpcGetLastIrreversibleBlock(context.Context, *GetLastIrreversibleBlockRequest) (*GetLastIrreversibleBlockResponse, error)
+	RpcGetFinalityCertificate(context.Context, *GetFinalityCertificateRequest) (*GetFinalityCertificateResponse, error)
+	RpcGetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error)
+	RpcGetTransactionProof(context.Context, *GetTransactionProofRequest) (*GetTransactionProofResponse, error)
+	RpcGetAddressHistory(context.Context, *GetAddressHistoryRequest) (*GetAddressHistoryResponse, error)
 	RpcEstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error)
 	RpcGasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
 	RpcContractQuery(context.Context, *ContractQueryRequest) (*ContractQueryResponse, error)
@@ -4056,6 +5178,18 @@ func (*UnimplementedRpcServiceServer) RpcGetAllTransactionsFromTxPool(context.Co
 func (*UnimplementedRpcServiceServer) RpcGetLastIrreversibleBlock(context.Context, *GetLastIrreversibleBlockRequest) (*GetLastIrreversibleBlockResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcGetLastIrreversibleBlock not implemented")
 }
+func (*UnimplementedRpcServiceServer) RpcGetFinalityCertificate(context.Context, *GetFinalityCertificateRequest) (*GetFinalityCertificateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetFinalityCertificate not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetTransaction(context.Context, *GetTransactionRequest) (*GetTransactionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetTransaction not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetTransactionProof(context.Context, *GetTransactionProofRequest) (*GetTransactionProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetTransactionProof not implemented")
+}
+func (*UnimplementedRpcServiceServer) RpcGetAddressHistory(context.Context, *GetAddressHistoryRequest) (*GetAddressHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetAddressHistory not implemented")
+}
 func (*UnimplementedRpcServiceServer) RpcEstimateGas(context.Context, *EstimateGasRequest) (*EstimateGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcEstimateGas not implemented")
 }
@@ -4310,6 +5444,78 @@ func _RpcService_RpcGetLastIrreversibleBlock_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RpcService_RpcGetFinalityCertificate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFinalityCertificateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetFinalityCertificate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetFinalityCertificate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetFinalityCertificate(ctx, req.(*GetFinalityCertificateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetTransaction(ctx, req.(*GetTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetTransactionProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetTransactionProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetTransactionProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetTransactionProof(ctx, req.(*GetTransactionProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RpcService_RpcGetAddressHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAddressHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RpcServiceServer).RpcGetAddressHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.RpcService/RpcGetAddressHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RpcServiceServer).RpcGetAddressHistory(ctx, req.(*GetAddressHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RpcService_RpcEstimateGas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EstimateGasRequest)
 	if err := dec(in); err != nil {
@@ -4412,6 +5618,22 @@ var _RpcService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RpcGetLastIrreversibleBlock",
 			Handler:    _RpcService_RpcGetLastIrreversibleBlock_Handler,
 		},
+		{
+			MethodName: "RpcGetFinalityCertificate",
+			Handler:    _RpcService_RpcGetFinalityCertificate_Handler,
+		},
+		{
+			MethodName: "RpcGetTransaction",
+			Handler:    _RpcService_RpcGetTransaction_Handler,
+		},
+		{
+			MethodName: "RpcGetTransactionProof",
+			Handler:    _RpcService_RpcGetTransactionProof_Handler,
+		},
+		{
+			MethodName: "RpcGetAddressHistory",
+			Handler:    _RpcService_RpcGetAddressHistory_Handler,
+		},
 		{
 			MethodName: "RpcEstimateGas",
 			Handler:    _RpcService_RpcEstimateGas_Handler,
@@ -4450,6 +5672,8 @@ type AdminServiceClient interface {
 	RpcSendFromMiner(ctx context.Context, in *SendFromMinerRequest, opts ...grpc.CallOption) (*SendFromMinerResponse, error)
 	RpcAddProducer(ctx context.Context, in *AddProducerRequest, opts ...grpc.CallOption) (*AddProducerResponse, error)
 	RpcUnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...grpc.CallOption) (*UnlockAccountResponse, error)
+	RpcGetProducerStats(ctx context.Context, in *GetProducerStatsRequest, opts ...grpc.CallOption) (*GetProducerStatsResponse, error)
+	RpcSealBlock(ctx context.Context, in *SealBlockRequest, opts ...grpc.CallOption) (*SealBlockResponse, error)
 }
 
 type adminServiceClient struct {
@@ -4514,6 +5738,24 @@ func (c *adminServiceClient) RpcUnlockAccount(ctx context.Context, in *UnlockAcc
 	return out, nil
 }
 
+func (c *adminServiceClient) RpcGetProducerStats(ctx context.Context, in *GetProducerStatsRequest, opts ...grpc.CallOption) (*GetProducerStatsResponse, error) {
+	out := new(GetProducerStatsResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcGetProducerStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RpcSealBlock(ctx context.Context, in *SealBlockRequest, opts ...grpc.CallOption) (*SealBlockResponse, error) {
+	out := new(SealBlockResponse)
+	err := c.cc.Invoke(ctx, "/rpcpb.AdminService/RpcSealBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 type AdminServiceServer interface {
 	RpcAddPeer(context.Context, *AddPeerRequest) (*AddPeerResponse, error)
@@ -4522,6 +5764,8 @@ type AdminServiceServer interface {
 	RpcSendFromMiner(context.Context, *SendFromMinerRequest) (*SendFromMinerResponse, error)
 	RpcAddProducer(context.Context, *AddProducerRequest) (*AddProducerResponse, error)
 	RpcUnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error)
+	RpcGetProducerStats(context.Context, *GetProducerStatsRequest) (*GetProducerStatsResponse, error)
+	RpcSealBlock(context.Context, *SealBlockRequest) (*SealBlockResponse, error)
 }
 
 // UnimplementedAdminServiceServer can be embedded to have forward compatible implementations.
@@ -4546,6 +5790,12 @@ func (*UnimplementedAdminServiceServer) RpcAddProducer(context.Context, *AddProd
 func (*UnimplementedAdminServiceServer) RpcUnlockAccount(context.Context, *UnlockAccountRequest) (*UnlockAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RpcUnlockAccount not implemented")
 }
+func (*UnimplementedAdminServiceServer) RpcGetProducerStats(context.Context, *GetProducerStatsRequest) (*GetProducerStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcGetProducerStats not implemented")
+}
+func (*UnimplementedAdminServiceServer) RpcSealBlock(context.Context, *SealBlockRequest) (*SealBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RpcSealBlock not implemented")
+}
 
 func RegisterAdminServiceServer(s *grpc.Server, srv AdminServiceServer) {
 	s.RegisterService(&_AdminService_serviceDesc, srv)
@@ -4659,6 +5909,42 @@ func _AdminService_RpcUnlockAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_RpcGetProducerStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProducerStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcGetProducerStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcGetProducerStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcGetProducerStats(ctx, req.(*GetProducerStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RpcSealBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SealBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RpcSealBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcpb.AdminService/RpcSealBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RpcSealBlock(ctx, req.(*SealBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _AdminService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcpb.AdminService",
 	HandlerType: (*AdminServiceServer)(nil),
@@ -4687,6 +5973,14 @@ var _AdminService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RpcUnlockAccount",
 			Handler:    _AdminService_RpcUnlockAccount_Handler,
 		},
+		{
+			MethodName: "RpcGetProducerStats",
+			Handler:    _AdminService_RpcGetProducerStats_Handler,
+		},
+		{
+			MethodName: "RpcSealBlock",
+			Handler:    _AdminService_RpcSealBlock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "github.com/dappley/go-dappley/rpc/pb/rpc.proto",
@@ -27,6 +27,7 @@ import (
 	"time"
 
 	"github.com/dappley/go-dappley/core/scState"
+	"github.com/dappley/go-dappley/core/history/pb"
 	"github.com/dappley/go-dappley/core/transaction"
 	"github.com/dappley/go-dappley/core/transaction/pb"
 	"github.com/dappley/go-dappley/core/utxo/pb"
@@ -35,6 +36,7 @@ import (
 
 	"github.com/dappley/go-dappley/core/block"
 	"github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/logic/lblock"
 	"github.com/dappley/go-dappley/logic/lblockchain"
 
 	"github.com/dappley/go-dappley/core/account"
@@ -55,6 +57,7 @@ import (
 const (
 	ProtoVersion                   = "1.0.0"
 	MaxGetBlocksCount       int32  = 500
+	MaxGetHistoryCount      uint32 = 500
 	MinUtxoBlockHeaderCount uint64 = 6
 )
 
@@ -127,6 +130,7 @@ func (rpcService *RpcService) RpcGetBlockchainInfo(ctx context.Context, in *rpcp
 		BlockHeight:   rpcService.GetBlockchain().GetMaxHeight(),
 		Producers:     rpcService.dynasty.GetProducers(),
 		Timestamp:     tailBlock.GetTimestamp(),
+		ChainId:       rpcService.GetBlockchain().GetChainID(),
 	}, nil
 }
 
@@ -190,6 +194,11 @@ func (rpcService *RpcService) RpcGetBlocks(ctx context.Context, in *rpcpb.GetBlo
 		return nil, status.Error(codes.InvalidArgument, "block count overflow")
 	}
 
+	//the bodies of pruned blocks cannot be returned
+	if rpcService.GetBlockchain().IsPruned(blk.GetHeight() + 1) {
+		return nil, status.Error(codes.NotFound, lblockchain.ErrBlockPruned.Error())
+	}
+
 	blk, err := rpcService.GetBlockchain().GetBlockByHeight(blk.GetHeight() + 1)
 	for i := int32(0); i < maxBlockCount && err == nil; i++ {
 		blocks = append(blocks, blk)
@@ -225,6 +234,9 @@ func (rpcService *RpcService) RpcGetBlockByHash(ctx context.Context, in *rpcpb.G
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	if rpcService.GetBlockchain().IsPruned(blk.GetHeight()) {
+		return nil, status.Error(codes.NotFound, lblockchain.ErrBlockPruned.Error())
+	}
 
 	return &rpcpb.GetBlockByHashResponse{Block: blk.ToProto().(*blockpb.Block)}, nil
 }
@@ -236,6 +248,9 @@ func (rpcService *RpcService) RpcGetBlockByHeight(ctx context.Context, in *rpcpb
 	if err != nil {
 		return nil, status.Error(codes.NotFound, err.Error())
 	}
+	if rpcService.GetBlockchain().IsPruned(in.GetHeight()) {
+		return nil, status.Error(codes.NotFound, lblockchain.ErrBlockPruned.Error())
+	}
 
 	return &rpcpb.GetBlockByHeightResponse{Block: blk.ToProto().(*blockpb.Block)}, nil
 }
@@ -243,13 +258,13 @@ func (rpcService *RpcService) RpcGetBlockByHeight(ctx context.Context, in *rpcpb
 // RpcSendTransaction Send transaction to blockchain created by account account
 func (rpcService *RpcService) RpcSendTransaction(ctx context.Context, in *rpcpb.SendTransactionRequest) (*rpcpb.SendTransactionResponse, error) {
 
-	tx := &transaction.Transaction{nil, nil, nil, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeDefault}
+	tx := &transaction.Transaction{nil, nil, nil, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeDefault, 0}
 
 	tx.FromProto(in.GetTransaction())
 
 	adaptedTx := transaction.NewTxAdapter(tx)
-	if !adaptedTx.IsNormal() && !adaptedTx.IsContract() {
-		return nil, status.Error(codes.InvalidArgument, "transaction type error, must be normal or contract")
+	if !adaptedTx.IsNormal() && !adaptedTx.IsContract() && !adaptedTx.IsVote() {
+		return nil, status.Error(codes.InvalidArgument, "transaction type error, must be normal, contract or vote")
 	}
 
 	if adaptedTx.IsContract() && adaptedTx.GasPrice.Cmp(common.NewAmount(0)) <= 0 {
@@ -264,7 +279,7 @@ func (rpcService *RpcService) RpcSendTransaction(ctx context.Context, in *rpcpb.
 	}
 	rpcService.mutex.Unlock()
 
-	if err := ltransaction.VerifyTransaction(rpcService.utxoIndex, tx, 0); err != nil {
+	if err := ltransaction.VerifyTransaction(rpcService.utxoIndex, tx, 0, bc.GetChainID()); err != nil {
 		logger.Warn(err.Error())
 		return nil, status.Error(codes.FailedPrecondition, lblockchain.ErrTransactionVerifyFailed.Error())
 	}
@@ -303,10 +318,11 @@ func (rpcService *RpcService) RpcSendTransaction(ctx context.Context, in *rpcpb.
 func (rpcService *RpcService) RpcSendBatchTransaction(ctx context.Context, in *rpcpb.SendBatchTransactionRequest) (*rpcpb.SendBatchTransactionResponse, error) {
 	var respon []proto.Message
 	utxoIndex := rpcService.GetBlockchain().GetUpdatedUTXOIndex()
+	chainID := rpcService.GetBlockchain().GetChainID()
 
 	txs := []transaction.Transaction{}
 	for _, txInReq := range in.Transactions {
-		tx := transaction.Transaction{nil, nil, nil, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeDefault}
+		tx := transaction.Transaction{nil, nil, nil, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeDefault, 0}
 		tx.FromProto(txInReq)
 		txs = append(txs, tx)
 	}
@@ -316,12 +332,12 @@ func (rpcService *RpcService) RpcSendBatchTransaction(ctx context.Context, in *r
 	st := status.New(codes.OK, "")
 	for _, tx := range txs {
 		adaptedTx := transaction.NewTxAdapter(&tx)
-		if !adaptedTx.IsNormal() && !adaptedTx.IsContract() {
+		if !adaptedTx.IsNormal() && !adaptedTx.IsContract() && !adaptedTx.IsVote() {
 			st = status.New(codes.Unknown, "one or more transactions are invalid")
 			respon = append(respon, &rpcpb.SendTransactionStatus{
 				Txid:    tx.ID,
 				Code:    uint32(codes.InvalidArgument),
-				Message: "transaction type error, must be normal or contract",
+				Message: "transaction type error, must be normal, contract or vote",
 			})
 			continue
 		}
@@ -336,7 +352,7 @@ func (rpcService *RpcService) RpcSendBatchTransaction(ctx context.Context, in *r
 			continue
 		}
 
-		if err := ltransaction.VerifyTransaction(utxoIndex, &tx, 0); err != nil {
+		if err := ltransaction.VerifyTransaction(utxoIndex, &tx, 0, chainID); err != nil {
 			st = status.New(codes.Unknown, "one or more transactions are invalid")
 			// add invalid transactions to response details if exists
 			respon = append(respon, &rpcpb.SendTransactionStatus{
@@ -426,10 +442,101 @@ func (rpcService *RpcService) RpcGetLastIrreversibleBlock(ctx context.Context, i
 	return &rpcpb.GetLastIrreversibleBlockResponse{Block: blk.ToProto().(*blockpb.Block)}, nil
 }
 
+// RpcGetFinalityCertificate returns the precommit signatures that make the block irreversible
+func (rpcService *RpcService) RpcGetFinalityCertificate(ctx context.Context, in *rpcpb.GetFinalityCertificateRequest) (*rpcpb.GetFinalityCertificateResponse, error) {
+	cert, err := rpcService.GetBlockchain().GetFinalityCertificate(in.GetBlockHash())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	return &rpcpb.GetFinalityCertificateResponse{Certificate: cert.ToProto().(*blockpb.FinalityCertificate)}, nil
+}
+
+//RpcGetTransaction returns the transaction with the input id on the main chain with the block that contains it, its
+//number of confirmations and whether its block is irreversible
+func (rpcService *RpcService) RpcGetTransaction(ctx context.Context, in *rpcpb.GetTransactionRequest) (*rpcpb.GetTransactionResponse, error) {
+	bc := rpcService.GetBlockchain()
+	tx, blk, index, err := bc.GetTransactionByID(in.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	confirmations := uint64(0)
+	if maxHeight := bc.GetMaxHeight(); maxHeight >= blk.GetHeight() {
+		confirmations = maxHeight - blk.GetHeight() + 1
+	}
+	return &rpcpb.GetTransactionResponse{
+		Transaction:    tx.ToProto().(*transactionpb.Transaction),
+		BlockHash:      blk.GetHash(),
+		BlockHeight:    blk.GetHeight(),
+		BlockTimestamp: blk.GetTimestamp(),
+		Index:          uint32(index),
+		Confirmations:  confirmations,
+		IsIrreversible: blk.GetHeight() <= bc.GetLIBHeight(),
+	}, nil
+}
+
+//RpcGetTransactionProof returns the transaction with the input id on the main chain with the header of its block and
+//the Merkle branch that proves the transaction is in the block
+func (rpcService *RpcService) RpcGetTransactionProof(ctx context.Context, in *rpcpb.GetTransactionProofRequest) (*rpcpb.GetTransactionProofResponse, error) {
+	tx, blk, index, err := rpcService.GetBlockchain().GetTransactionByID(in.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	branch, err := lblock.GetTransactionMerkleBranch(blk, index, rpcService.GetBlockchain().GetForks())
+	if err == lblock.ErrMerkleRootInactive {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &rpcpb.GetTransactionProofResponse{
+		Transaction: tx.ToProto().(*transactionpb.Transaction),
+		BlockHeader: blk.GetHeader().ToProto().(*blockpb.BlockHeader),
+		Index:       uint32(index),
+		NumTxs:      uint32(len(blk.GetTransactions())),
+	}
+	for _, siblingHash := range branch {
+		response.Branch = append(response.Branch, siblingHash)
+	}
+	return response, nil
+}
+
+//RpcGetAddressHistory returns a page of the outputs received and spent by the address in the height range. The next
+//page starts at the returned cursor, which is 0 after the last page
+func (rpcService *RpcService) RpcGetAddressHistory(ctx context.Context, in *rpcpb.GetAddressHistoryRequest) (*rpcpb.GetAddressHistoryResponse, error) {
+	acc := account.NewTransactionAccountByAddress(account.NewAddress(in.GetAddress()))
+	if !acc.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, logic.ErrInvalidAddress.Error())
+	}
+	if in.GetMaxCount() > MaxGetHistoryCount {
+		return nil, status.Error(codes.InvalidArgument, "history count overflow")
+	}
+	if in.GetEndHeight() != 0 && in.GetEndHeight() < in.GetStartHeight() {
+		return nil, status.Error(codes.InvalidArgument, "invalid height range")
+	}
+
+	maxCount := in.GetMaxCount()
+	if maxCount == 0 {
+		maxCount = MaxGetHistoryCount
+	}
+	entries, cursor, err := rpcService.GetBlockchain().GetAddressHistory(acc.GetPubKeyHash(), in.GetStartHeight(), in.GetEndHeight(), in.GetCursor(), int(maxCount))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	response := &rpcpb.GetAddressHistoryResponse{NextCursor: cursor}
+	for _, entry := range entries {
+		response.Entries = append(response.Entries, entry.ToProto().(*historypb.HistoryEntry))
+	}
+	return response, nil
+}
+
 // RpcEstimateGas estimate gas value of contract deploy and execution.
 func (rpcService *RpcService) RpcEstimateGas(ctx context.Context, in *rpcpb.EstimateGasRequest) (*rpcpb.EstimateGasResponse, error) {
 
-	tx := &transaction.Transaction{nil, nil, nil, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeDefault}
+	tx := &transaction.Transaction{nil, nil, nil, common.NewAmount(0), common.NewAmount(0), common.NewAmount(0), time.Now().UnixNano() / 1e6, transaction.TxTypeDefault, 0}
 
 	tx.FromProto(in.GetTransaction())
 
@@ -439,7 +546,7 @@ func (rpcService *RpcService) RpcEstimateGas(ctx context.Context, in *rpcpb.Esti
 	}
 	utxoIndex := rpcService.GetBlockchain().GetUpdatedUTXOIndex()
 
-	err := contractTx.VerifyInEstimate(utxoIndex)
+	err := contractTx.VerifyInEstimate(utxoIndex, rpcService.GetBlockchain().GetChainID())
 	if err != nil {
 		return nil, status.Error(codes.FailedPrecondition, err.Error())
 	}
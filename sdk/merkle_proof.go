@@ -0,0 +1,49 @@
+package sdk
+
+import (
+	"bytes"
+
+	"github.com/dappley/go-dappley/common/hash"
+	"github.com/dappley/go-dappley/common/merkle"
+	"github.com/dappley/go-dappley/core/block"
+	blockpb "github.com/dappley/go-dappley/core/block/pb"
+	"github.com/dappley/go-dappley/core/transaction"
+	transactionpb "github.com/dappley/go-dappley/core/transaction/pb"
+	rpcpb "github.com/dappley/go-dappley/rpc/pb"
+)
+
+//VerifyTransactionProof checks that the transaction is the one at the index of the block with the input header, given
+//the number of transactions in the block and the Merkle branch of the transaction. The header is only checked against
+//its own hash, so that its signature has to be verified separately against the producers of the chain
+func VerifyTransactionProof(txPb *transactionpb.Transaction, header *blockpb.BlockHeader, index uint32, numTxs uint32, branch [][]byte) bool {
+	if txPb == nil || header == nil {
+		return false
+	}
+	tx := &transaction.Transaction{}
+	tx.FromProto(txPb)
+
+	branchHashes := make([]hash.Hash, len(branch))
+	for i, siblingHash := range branch {
+		branchHashes[i] = siblingHash
+	}
+	txsHash, err := merkle.CalculateRootFromBranch(tx.Hash(), int(index), int(numTxs), branchHashes)
+	if err != nil {
+		return false
+	}
+
+	blkHash := block.CalculateHeaderHash(
+		header.GetPreviousHash(),
+		txsHash,
+		header.GetTimestamp(),
+		header.GetNonce(),
+		header.GetProducer(),
+		header.GetVrfProof(),
+		header.GetStateRoot(),
+	)
+	return bytes.Equal(blkHash, header.GetHash())
+}
+
+//VerifyTransactionProofResponse checks the Merkle proof returned by RpcGetTransactionProof
+func VerifyTransactionProofResponse(response *rpcpb.GetTransactionProofResponse) bool {
+	return VerifyTransactionProof(response.GetTransaction(), response.GetBlockHeader(), response.GetIndex(), response.GetNumTxs(), response.GetBranch())
+}
@@ -36,6 +36,20 @@ func (sdk *DappSdk) GetBlockHeight() (uint64, error) {
 	return resp.BlockHeight, nil
 }
 
+//GetChainID requests the chain id that the transactions sent to the server have to be signed for
+func (sdk *DappSdk) GetChainID() (uint64, error) {
+	resp, err := sdk.conn.rpcClient.RpcGetBlockchainInfo(
+		context.Background(),
+		&rpcpb.GetBlockchainInfoRequest{},
+	)
+
+	if err != nil || resp == nil {
+		return 0, err
+	}
+
+	return resp.ChainId, nil
+}
+
 //GetBlockHeight requests the balance of the input address from the server
 func (sdk *DappSdk) GetBalance(address string) (int64, error) {
 	response, err := sdk.conn.rpcClient.RpcGetBalance(context.Background(), &rpcpb.GetBalanceRequest{Address: address})
@@ -108,3 +122,9 @@ func (sdk *DappSdk) GetUtxoByAddr(addr account.Address) ([]*utxopb.Utxo, error)
 
 	return resp.Utxos, nil
 }
+
+//GetTransactionProof requests the transaction with the input id from the server with the header of its block and the
+//Merkle branch that proves the transaction is in the block
+func (sdk *DappSdk) GetTransactionProof(txid []byte) (*rpcpb.GetTransactionProofResponse, error) {
+	return sdk.conn.rpcClient.RpcGetTransactionProof(context.Background(), &rpcpb.GetTransactionProofRequest{Id: txid})
+}
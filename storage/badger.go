@@ -0,0 +1,283 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dgraph-io/badger"
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	badgerGCInterval     = 10 * time.Minute
+	badgerGCDiscardRatio = 0.5
+)
+
+var (
+	ErrBadgerNotAbleToOpenFile = errors.New("badger failed to open file")
+	ErrBatchTooBig             = errors.New("badger batch does not fit in one transaction")
+)
+
+// BadgerDB is a storage backed by Badger, a pure Go key-value store that keeps the values in a separate log and does
+// not stall writes for compactions the way LevelDB does
+type BadgerDB struct {
+	db        *badger.DB
+	batchLock sync.Mutex
+	batch     *badgerBatch
+	quit      chan bool
+}
+
+//OpenBadgerDatabase opens the Badger database in the directory
+func OpenBadgerDatabase(dbFilePath string) *BadgerDB {
+	db, err := badger.Open(badger.DefaultOptions(dbFilePath).WithLogger(logger.WithField("module", "badger")))
+	if err != nil {
+		logger.WithError(err).Panic(ErrBadgerNotAbleToOpenFile)
+	}
+
+	bdb := &BadgerDB{
+		db:   db,
+		quit: make(chan bool),
+	}
+	go bdb.runValueLogGC()
+	return bdb
+}
+
+func (bdb *BadgerDB) Close() error {
+	logger.Info("BadgerDB: is closing the database connection.")
+	close(bdb.quit)
+	return bdb.db.Close()
+}
+
+func (bdb *BadgerDB) Get(key []byte) ([]byte, error) {
+	var val []byte
+	err := bdb.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		if err != nil {
+			return err
+		}
+		val, err = item.ValueCopy(nil)
+		return err
+	})
+	if err == badger.ErrKeyNotFound {
+		return nil, ErrKeyInvalid
+	}
+	return val, err
+}
+
+func (bdb *BadgerDB) Put(key []byte, val []byte) error {
+	bdb.batchLock.Lock()
+	if bdb.batch != nil {
+		defer bdb.batchLock.Unlock()
+		return bdb.batch.Put(key, val)
+	}
+	bdb.batchLock.Unlock()
+
+	err := bdb.db.Update(func(txn *badger.Txn) error {
+		return txn.Set(key, val)
+	})
+	if err != nil {
+		logger.Error(err)
+	}
+	return err
+}
+
+func (bdb *BadgerDB) Del(key []byte) error {
+	return bdb.db.Update(func(txn *badger.Txn) error {
+		return txn.Delete(key)
+	})
+}
+
+func (bdb *BadgerDB) EnableBatch() {
+	bdb.batchLock.Lock()
+	defer bdb.batchLock.Unlock()
+	bdb.batch = &badgerBatch{db: bdb.db}
+}
+
+func (bdb *BadgerDB) Flush() error {
+	bdb.batchLock.Lock()
+	defer bdb.batchLock.Unlock()
+	if bdb.batch != nil {
+		logger.Debugf("BadgerDB: is flushing %d operations to storage.", bdb.batch.Len())
+		return bdb.batch.Write()
+	}
+	return nil
+}
+
+func (bdb *BadgerDB) DisableBatch() {
+	bdb.batchLock.Lock()
+	defer bdb.batchLock.Unlock()
+	bdb.batch = nil
+}
+
+//NewIterator returns an iterator over the keys in the range [start, limit) of the database
+func (bdb *BadgerDB) NewIterator(start []byte, limit []byte) Iterator {
+	return newBadgerIterator(bdb.db, start, limit, nil)
+}
+
+//NewPrefixIterator returns an iterator over the keys with the prefix in the database
+func (bdb *BadgerDB) NewPrefixIterator(prefix []byte) Iterator {
+	return newBadgerIterator(bdb.db, prefix, nil, prefix)
+}
+
+//NewBatch returns a write batch of the database
+func (bdb *BadgerDB) NewBatch() Batch {
+	return &badgerBatch{db: bdb.db}
+}
+
+//runValueLogGC reclaims the space of the overwritten and deleted values in the value log until the database is closed
+func (bdb *BadgerDB) runValueLogGC() {
+	ticker := time.NewTicker(badgerGCInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-bdb.quit:
+			return
+		case <-ticker.C:
+			for bdb.db.RunValueLogGC(badgerGCDiscardRatio) == nil {
+			}
+		}
+	}
+}
+
+// badgerIterator walks the keys of a read-only transaction, so that it sees the database as it was when created
+type badgerIterator struct {
+	txn     *badger.Txn
+	it      *badger.Iterator
+	start   []byte
+	limit   []byte
+	prefix  []byte
+	started bool
+	key     []byte
+	value   []byte
+	err     error
+}
+
+func newBadgerIterator(db *badger.DB, start []byte, limit []byte, prefix []byte) *badgerIterator {
+	txn := db.NewTransaction(false)
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+	return &badgerIterator{
+		txn:    txn,
+		it:     txn.NewIterator(opts),
+		start:  start,
+		limit:  limit,
+		prefix: prefix,
+	}
+}
+
+func (bi *badgerIterator) Next() bool {
+	if bi.err != nil || bi.it == nil {
+		return false
+	}
+	if !bi.started {
+		bi.it.Seek(bi.start)
+		bi.started = true
+	} else if bi.it.Valid() {
+		bi.it.Next()
+	}
+
+	bi.key, bi.value = nil, nil
+	if !bi.it.ValidForPrefix(bi.prefix) {
+		return false
+	}
+	item := bi.it.Item()
+	if bi.limit != nil && bytes.Compare(item.Key(), bi.limit) >= 0 {
+		return false
+	}
+	bi.key = item.KeyCopy(nil)
+	bi.value, bi.err = item.ValueCopy(nil)
+	return bi.err == nil
+}
+
+func (bi *badgerIterator) Key() []byte {
+	return bi.key
+}
+
+func (bi *badgerIterator) Value() []byte {
+	return bi.value
+}
+
+func (bi *badgerIterator) Error() error {
+	return bi.err
+}
+
+func (bi *badgerIterator) Release() {
+	if bi.it == nil {
+		return
+	}
+	bi.it.Close()
+	bi.txn.Discard()
+	bi.it = nil
+	bi.key, bi.value = nil, nil
+}
+
+// badgerBatch applies its writes in one transaction, so that a batch is written atomically. A batch too big for one
+// transaction is not written at all
+type badgerBatch struct {
+	db  *badger.DB
+	ops []batchOp
+}
+
+func (b *badgerBatch) Put(key []byte, val []byte) error {
+	b.ops = append(b.ops, batchOp{key: string(key), val: val})
+	return nil
+}
+
+func (b *badgerBatch) Del(key []byte) error {
+	b.ops = append(b.ops, batchOp{key: string(key), isDel: true})
+	return nil
+}
+
+func (b *badgerBatch) Len() int {
+	return len(b.ops)
+}
+
+func (b *badgerBatch) Write() error {
+	txn := b.db.NewTransaction(true)
+	defer txn.Discard()
+	for _, op := range b.ops {
+		err := b.apply(txn, op)
+		if err == badger.ErrTxnTooBig {
+			return ErrBatchTooBig
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := txn.Commit(); err != nil {
+		return err
+	}
+	b.ops = nil
+	return nil
+}
+
+func (b *badgerBatch) apply(txn *badger.Txn, op batchOp) error {
+	if op.isDel {
+		return txn.Delete([]byte(op.key))
+	}
+	return txn.Set([]byte(op.key), op.val)
+}
+
+func (b *badgerBatch) Discard() {
+	b.ops = nil
+}
@@ -0,0 +1,100 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testBadgerDbFile = "../bin/testbadger.db"
+
+func TestBadgerDB_PutAndGet(t *testing.T) {
+	bdb := OpenBadgerDatabase(testBadgerDbFile)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer bdb.Close()
+
+	assert.Nil(t, bdb.Put([]byte("a"), []byte("1")))
+	v, err := bdb.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), v)
+
+	_, err = bdb.Get([]byte("b"))
+	assert.Equal(t, ErrKeyInvalid, err)
+
+	assert.Nil(t, bdb.Del([]byte("a")))
+	_, err = bdb.Get([]byte("a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+}
+
+func TestBadgerDB_BatchWrite(t *testing.T) {
+	bdb := OpenBadgerDatabase(testBadgerDbFile)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer bdb.Close()
+
+	bdb.EnableBatch()
+	bdb.Put([]byte("1"), []byte("a"))
+	bdb.Put([]byte("1"), []byte("c"))
+	bdb.Put([]byte("2"), []byte("1234"))
+
+	_, err := bdb.Get([]byte("1"))
+	assert.Equal(t, ErrKeyInvalid, err)
+
+	assert.Nil(t, bdb.Flush())
+	v1, err := bdb.Get([]byte("1"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("c"), v1)
+	v2, err := bdb.Get([]byte("2"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1234"), v2)
+
+	bdb.DisableBatch()
+}
+
+func TestBadgerDB_BatchTooBig(t *testing.T) {
+	bdb := OpenBadgerDatabase(testBadgerDbFile)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer bdb.Close()
+
+	//the batch exceeds the number of writes in one transaction and is not written in part
+	batch := bdb.NewBatch()
+	for i := 0; i < 200000; i++ {
+		batch.Put([]byte(strconv.Itoa(i)), []byte("a"))
+	}
+	assert.Equal(t, ErrBatchTooBig, batch.Write())
+	_, err := bdb.Get([]byte("0"))
+	assert.Equal(t, ErrKeyInvalid, err)
+}
+
+func TestBadgerDB_Iterator(t *testing.T) {
+	bdb := OpenBadgerDatabase(testBadgerDbFile)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer bdb.Close()
+	testIterator(t, bdb)
+}
+
+func TestBadgerDB_NewBatch(t *testing.T) {
+	bdb := OpenBadgerDatabase(testBadgerDbFile)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer bdb.Close()
+	testNewBatch(t, bdb)
+}
@@ -0,0 +1,75 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+// DefaultChunkLen is the number of writes of a chunk of a ChunkedBatch that fits into the transaction of every storage
+// engine
+const DefaultChunkLen = 1000
+
+// ChunkedBatch is a write batch that is written to the storage every time it holds the maximal number of writes, so that
+// any number of writes fits into the transaction of a storage engine. Unlike a Batch, its writes are not applied
+// atomically as a whole, and a failed write leaves the earlier chunks written
+type ChunkedBatch struct {
+	batch  Batch
+	maxLen int
+}
+
+//NewChunkedBatch returns a chunked batch of the storage that writes every maxLen writes
+func NewChunkedBatch(db Storage, maxLen int) *ChunkedBatch {
+	if maxLen <= 0 {
+		maxLen = DefaultChunkLen
+	}
+	return &ChunkedBatch{batch: db.NewBatch(), maxLen: maxLen}
+}
+
+func (b *ChunkedBatch) Put(key []byte, val []byte) error {
+	if err := b.batch.Put(key, val); err != nil {
+		return err
+	}
+	return b.writeIfFull()
+}
+
+func (b *ChunkedBatch) Del(key []byte) error {
+	if err := b.batch.Del(key); err != nil {
+		return err
+	}
+	return b.writeIfFull()
+}
+
+//Len returns the number of writes that are not written yet
+func (b *ChunkedBatch) Len() int {
+	return b.batch.Len()
+}
+
+//Write writes the writes that are not written yet
+func (b *ChunkedBatch) Write() error {
+	return b.batch.Write()
+}
+
+//Discard drops the writes that are not written yet
+func (b *ChunkedBatch) Discard() {
+	b.batch.Discard()
+}
+
+func (b *ChunkedBatch) writeIfFull() error {
+	if b.batch.Len() < b.maxLen {
+		return nil
+	}
+	return b.batch.Write()
+}
@@ -0,0 +1,58 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChunkedBatch(t *testing.T) {
+	db := NewRamStorage()
+	batch := NewChunkedBatch(db, 2)
+
+	//the writes are written every time the batch is full
+	require.Nil(t, batch.Put([]byte("a"), []byte("1")))
+	assert.Equal(t, 1, batch.Len())
+	_, err := db.Get([]byte("a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+	require.Nil(t, batch.Put([]byte("b"), []byte("2")))
+	assert.Equal(t, 0, batch.Len())
+	v, err := db.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), v)
+
+	require.Nil(t, batch.Del([]byte("a")))
+	require.Nil(t, batch.Write())
+	_, err = db.Get([]byte("a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+
+	for i := 0; i < 5; i++ {
+		require.Nil(t, batch.Put([]byte(fmt.Sprintf("k%d", i)), []byte("v")))
+	}
+	assert.Equal(t, 1, batch.Len())
+	batch.Discard()
+	_, err = db.Get([]byte("k3"))
+	assert.Nil(t, err)
+	_, err = db.Get([]byte("k4"))
+	assert.Equal(t, ErrKeyInvalid, err)
+}
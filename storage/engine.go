@@ -0,0 +1,80 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"errors"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	LevelDBEngine = "leveldb"
+	BadgerEngine  = "badger"
+
+	copyBatchSize = 1000
+)
+
+var (
+	ErrDbEngineNotSupported = errors.New("db engine is not supported")
+)
+
+//OpenStorage opens the database in the path with the engine. LevelDB is used if the engine is empty
+func OpenStorage(engine string, dbFilePath string) (Storage, error) {
+	switch engine {
+	case "", LevelDBEngine:
+		return OpenDatabase(dbFilePath), nil
+	case BadgerEngine:
+		return OpenBadgerDatabase(dbFilePath), nil
+	default:
+		return nil, ErrDbEngineNotSupported
+	}
+}
+
+//CopyStorage writes all keys of the source storage into the destination storage and returns the number of keys copied
+func CopyStorage(src Storage, dst Storage) (int, error) {
+	it := src.NewIterator(nil, nil)
+	defer it.Release()
+
+	batch := dst.NewBatch()
+	count := 0
+	for it.Next() {
+		if err := batch.Put(append([]byte{}, it.Key()...), append([]byte{}, it.Value()...)); err != nil {
+			return count, err
+		}
+		if batch.Len() < copyBatchSize {
+			continue
+		}
+		if err := batch.Write(); err != nil {
+			return count, err
+		}
+		count += copyBatchSize
+		logger.WithFields(logger.Fields{
+			"num_keys": count,
+		}).Info("Storage: is copying the database.")
+	}
+	if err := it.Error(); err != nil {
+		return count, err
+	}
+	numKeys := batch.Len()
+	if err := batch.Write(); err != nil {
+		return count, err
+	}
+	return count + numKeys, nil
+}
@@ -0,0 +1,62 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenStorage(t *testing.T) {
+	_, err := OpenStorage("rocksdb", testBadgerDbFile)
+	assert.Equal(t, ErrDbEngineNotSupported, err)
+
+	db, err := OpenStorage(BadgerEngine, testBadgerDbFile)
+	require.Nil(t, err)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer db.Close()
+	assert.IsType(t, &BadgerDB{}, db)
+}
+
+func TestCopyStorage(t *testing.T) {
+	src := NewRamStorage()
+	for i := 0; i < copyBatchSize+10; i++ {
+		src.Put([]byte{byte(i >> 8), byte(i)}, []byte{byte(i)})
+	}
+
+	bdb := OpenBadgerDatabase(testBadgerDbFile)
+	defer os.RemoveAll(testBadgerDbFile)
+	defer bdb.Close()
+	count, err := CopyStorage(src, bdb)
+	assert.Nil(t, err)
+	assert.Equal(t, copyBatchSize+10, count)
+
+	dst := NewRamStorage()
+	count, err = CopyStorage(bdb, dst)
+	assert.Nil(t, err)
+	assert.Equal(t, copyBatchSize+10, count)
+	for i := 0; i < copyBatchSize+10; i++ {
+		v, err := dst.Get([]byte{byte(i >> 8), byte(i)})
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{byte(i)}, v)
+	}
+}
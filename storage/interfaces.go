@@ -43,6 +43,45 @@ type Storage interface {
 
 	// Flush write and flush pending batch write.
 	Flush() error
+
+	// NewIterator returns an iterator over the keys in the range [start, limit). A nil limit iterates to the last key.
+	NewIterator(start []byte, limit []byte) Iterator
+
+	// NewPrefixIterator returns an iterator over the keys with the prefix.
+	NewPrefixIterator(prefix []byte) Iterator
+
+	// NewBatch returns a write batch that is independent of the batch mode of the storage and of other batches.
+	NewBatch() Batch
+}
+
+// Iterator walks the keys of a storage in ascending byte order. The key and the value are only valid until the next
+// call of Next and must not be modified. An iterator has to be released after use.
+type Iterator interface {
+	Next() bool
+
+	Key() []byte
+
+	Value() []byte
+
+	Error() error
+
+	Release()
+}
+
+// Batch collects writes that are applied to the storage in order by a single Write.
+type Batch interface {
+	Put(key []byte, val []byte) error
+
+	Del(key []byte) error
+
+	// Len returns the number of writes in the batch.
+	Len() int
+
+	// Write applies the writes to the storage and empties the batch.
+	Write() error
+
+	// Discard drops the writes in the batch.
+	Discard()
 }
 
 type FileStorage interface {
@@ -24,6 +24,7 @@ import (
 
 	logger "github.com/sirupsen/logrus"
 	"github.com/syndtr/goleveldb/leveldb"
+	leveldbutil "github.com/syndtr/goleveldb/leveldb/util"
 )
 
 var (
@@ -107,6 +108,52 @@ func (ldb *LevelDB) DisableBatch() {
 	ldb.batch = nil
 }
 
+//NewIterator returns an iterator over the keys in the range [start, limit) of the database
+func (ldb *LevelDB) NewIterator(start []byte, limit []byte) Iterator {
+	return ldb.db.NewIterator(&leveldbutil.Range{Start: start, Limit: limit}, nil)
+}
+
+//NewPrefixIterator returns an iterator over the keys with the prefix in the database
+func (ldb *LevelDB) NewPrefixIterator(prefix []byte) Iterator {
+	return ldb.db.NewIterator(leveldbutil.BytesPrefix(prefix), nil)
+}
+
+//NewBatch returns a write batch of the database
+func (ldb *LevelDB) NewBatch() Batch {
+	return &levelDBBatch{db: ldb.db, batch: new(leveldb.Batch)}
+}
+
+type levelDBBatch struct {
+	db    *leveldb.DB
+	batch *leveldb.Batch
+}
+
+func (b *levelDBBatch) Put(key []byte, val []byte) error {
+	b.batch.Put(key, val)
+	return nil
+}
+
+func (b *levelDBBatch) Del(key []byte) error {
+	b.batch.Delete(key)
+	return nil
+}
+
+func (b *levelDBBatch) Len() int {
+	return b.batch.Len()
+}
+
+func (b *levelDBBatch) Write() error {
+	if err := b.db.Write(b.batch, nil); err != nil {
+		return err
+	}
+	b.batch.Reset()
+	return nil
+}
+
+func (b *levelDBBatch) Discard() {
+	b.batch.Reset()
+}
+
 func DbExists(dbFilePath string) bool {
 	if _, err := os.Stat(dbFilePath); os.IsNotExist(err) {
 		return false
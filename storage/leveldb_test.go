@@ -114,6 +114,18 @@ func TestLevelDB_BatchWrite(t *testing.T) {
 	ldb.DisableBatch()
 }
 
+func TestLevelDB_Iterator(t *testing.T) {
+	ldb := OpenDatabase(testDbFile)
+	defer ldb.Close()
+	testIterator(t, ldb)
+}
+
+func TestLevelDB_NewBatch(t *testing.T) {
+	ldb := OpenDatabase(testDbFile)
+	defer ldb.Close()
+	testNewBatch(t, ldb)
+}
+
 func setup() {
 	cleanUpDatabase()
 }
@@ -3,6 +3,7 @@
 package mocks
 
 import mock "github.com/stretchr/testify/mock"
+import storage "github.com/dappley/go-dappley/storage"
 
 // Storage is an autogenerated mock type for the Storage type
 type Storage struct {
@@ -84,6 +85,54 @@ func (_m *Storage) Get(key []byte) ([]byte, error) {
 	return r0, r1
 }
 
+// NewBatch provides a mock function with given fields:
+func (_m *Storage) NewBatch() storage.Batch {
+	ret := _m.Called()
+
+	var r0 storage.Batch
+	if rf, ok := ret.Get(0).(func() storage.Batch); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(storage.Batch)
+		}
+	}
+
+	return r0
+}
+
+// NewIterator provides a mock function with given fields: start, limit
+func (_m *Storage) NewIterator(start []byte, limit []byte) storage.Iterator {
+	ret := _m.Called(start, limit)
+
+	var r0 storage.Iterator
+	if rf, ok := ret.Get(0).(func([]byte, []byte) storage.Iterator); ok {
+		r0 = rf(start, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(storage.Iterator)
+		}
+	}
+
+	return r0
+}
+
+// NewPrefixIterator provides a mock function with given fields: prefix
+func (_m *Storage) NewPrefixIterator(prefix []byte) storage.Iterator {
+	ret := _m.Called(prefix)
+
+	var r0 storage.Iterator
+	if rf, ok := ret.Get(0).(func([]byte) storage.Iterator); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(storage.Iterator)
+		}
+	}
+
+	return r0
+}
+
 // Put provides a mock function with given fields: key, val
 func (_m *Storage) Put(key []byte, val []byte) error {
 	ret := _m.Called(key, val)
@@ -0,0 +1,232 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// Change is a write of a key buffered in an overlay. The value of a removed key is nil
+type Change struct {
+	Key     []byte
+	Value   []byte
+	Deleted bool
+}
+
+// Overlay buffers the writes to a storage and applies them in one batch on Commit. The reads through the overlay see
+// the buffered writes on top of the storage, so that a sequence of dependent writes can be made atomic
+type Overlay struct {
+	db      Storage
+	mutex   sync.RWMutex
+	changes map[string]*Change
+	order   []string
+}
+
+//NewOverlay returns an overlay without writes on top of the storage
+func NewOverlay(db Storage) *Overlay {
+	return &Overlay{
+		db:      db,
+		changes: make(map[string]*Change),
+	}
+}
+
+//GetStorage returns the storage under the overlay
+func (o *Overlay) GetStorage() Storage {
+	return o.db
+}
+
+func (o *Overlay) Close() error {
+	o.Discard()
+	return nil
+}
+
+func (o *Overlay) Get(key []byte) ([]byte, error) {
+	o.mutex.RLock()
+	change, ok := o.changes[string(key)]
+	o.mutex.RUnlock()
+	if !ok {
+		return o.db.Get(key)
+	}
+	if change.Deleted {
+		return nil, ErrKeyInvalid
+	}
+	return change.Value, nil
+}
+
+func (o *Overlay) Put(key []byte, val []byte) error {
+	o.set(&Change{Key: key, Value: val})
+	return nil
+}
+
+func (o *Overlay) Del(key []byte) error {
+	o.set(&Change{Key: key, Deleted: true})
+	return nil
+}
+
+//EnableBatch does nothing as all writes of the overlay are buffered
+func (o *Overlay) EnableBatch() {}
+
+//DisableBatch does nothing as all writes of the overlay are buffered
+func (o *Overlay) DisableBatch() {}
+
+//Flush does nothing. The buffered writes are only applied by Commit
+func (o *Overlay) Flush() error {
+	return nil
+}
+
+//NewIterator returns an iterator over the keys in the range [start, limit) of the storage with the buffered writes
+func (o *Overlay) NewIterator(start []byte, limit []byte) Iterator {
+	return o.newIterator(o.db.NewIterator(start, limit), func(key []byte) bool {
+		return bytes.Compare(key, start) >= 0 && (limit == nil || bytes.Compare(key, limit) < 0)
+	})
+}
+
+//NewPrefixIterator returns an iterator over the keys with the prefix in the storage with the buffered writes
+func (o *Overlay) NewPrefixIterator(prefix []byte) Iterator {
+	return o.newIterator(o.db.NewPrefixIterator(prefix), func(key []byte) bool {
+		return bytes.HasPrefix(key, prefix)
+	})
+}
+
+//NewBatch returns a write batch whose writes are buffered in the overlay
+func (o *Overlay) NewBatch() Batch {
+	return &overlayBatch{overlay: o}
+}
+
+//Len returns the number of keys written in the overlay
+func (o *Overlay) Len() int {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	return len(o.order)
+}
+
+//GetChanges returns the last write of every key in the order the keys are first written
+func (o *Overlay) GetChanges() []*Change {
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+	changes := make([]*Change, 0, len(o.order))
+	for _, key := range o.order {
+		changes = append(changes, o.changes[key])
+	}
+	return changes
+}
+
+//Commit applies the buffered writes to the storage in one batch and empties the overlay
+func (o *Overlay) Commit() error {
+	batch := o.db.NewBatch()
+	for _, change := range o.GetChanges() {
+		if err := ApplyChange(batch, change); err != nil {
+			batch.Discard()
+			return err
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return err
+	}
+	o.Discard()
+	return nil
+}
+
+//Discard drops the buffered writes
+func (o *Overlay) Discard() {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	o.changes = make(map[string]*Change)
+	o.order = nil
+}
+
+//set buffers a copy of the change, as the caller may reuse the key and the value
+func (o *Overlay) set(change *Change) {
+	key := string(change.Key)
+	saved := &Change{Key: []byte(key), Deleted: change.Deleted}
+	if !change.Deleted {
+		saved.Value = append([]byte{}, change.Value...)
+	}
+
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+	if _, ok := o.changes[key]; !ok {
+		o.order = append(o.order, key)
+	}
+	o.changes[key] = saved
+}
+
+func (o *Overlay) newIterator(dbIt Iterator, filter func(key []byte) bool) Iterator {
+	defer dbIt.Release()
+	o.mutex.RLock()
+	defer o.mutex.RUnlock()
+
+	it := &ramIterator{index: -1}
+	for dbIt.Next() {
+		if _, ok := o.changes[string(dbIt.Key())]; ok {
+			continue
+		}
+		it.keys = append(it.keys, append([]byte{}, dbIt.Key()...))
+		it.values = append(it.values, append([]byte{}, dbIt.Value()...))
+	}
+	for _, change := range o.changes {
+		if !change.Deleted && filter(change.Key) {
+			it.keys = append(it.keys, change.Key)
+			it.values = append(it.values, change.Value)
+		}
+	}
+	sort.Sort(it)
+	return it
+}
+
+//ApplyChange adds the write of the change to the batch
+func ApplyChange(batch Batch, change *Change) error {
+	if change.Deleted {
+		return batch.Del(change.Key)
+	}
+	return batch.Put(change.Key, change.Value)
+}
+
+type overlayBatch struct {
+	overlay *Overlay
+	changes []*Change
+}
+
+func (b *overlayBatch) Put(key []byte, val []byte) error {
+	b.changes = append(b.changes, &Change{Key: key, Value: val})
+	return nil
+}
+
+func (b *overlayBatch) Del(key []byte) error {
+	b.changes = append(b.changes, &Change{Key: key, Deleted: true})
+	return nil
+}
+
+func (b *overlayBatch) Len() int {
+	return len(b.changes)
+}
+
+func (b *overlayBatch) Write() error {
+	for _, change := range b.changes {
+		b.overlay.set(change)
+	}
+	b.changes = nil
+	return nil
+}
+
+func (b *overlayBatch) Discard() {
+	b.changes = nil
+}
@@ -0,0 +1,83 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOverlay(t *testing.T) {
+	db := NewRamStorage()
+	db.Put([]byte("a"), []byte("1"))
+	db.Put([]byte("b"), []byte("2"))
+
+	o := NewOverlay(db)
+	val := []byte("3")
+	o.Put([]byte("c"), val)
+	//the overlay keeps its own copy of the value
+	val[0] = '0'
+	o.Del([]byte("a"))
+	batch := o.NewBatch()
+	batch.Put([]byte("b"), []byte("4"))
+	require.Nil(t, batch.Write())
+
+	//the reads through the overlay see the writes, which are not in the storage yet
+	_, err := o.Get([]byte("a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+	v, err := o.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("4"), v)
+	v, err = o.Get([]byte("c"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("3"), v)
+	v, err = db.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), v)
+	_, err = db.Get([]byte("c"))
+	assert.Equal(t, ErrKeyInvalid, err)
+
+	it := o.NewIterator([]byte("a"), nil)
+	var keys, values []string
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+		values = append(values, string(it.Value()))
+	}
+	it.Release()
+	assert.Equal(t, []string{"b", "c"}, keys)
+	assert.Equal(t, []string{"4", "3"}, values)
+
+	assert.Equal(t, 3, o.Len())
+	changes := o.GetChanges()
+	assert.Equal(t, []byte("c"), changes[0].Key)
+	assert.True(t, changes[1].Deleted)
+
+	require.Nil(t, o.Commit())
+	assert.Equal(t, 0, o.Len())
+	_, err = db.Get([]byte("a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+	v, err = db.Get([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("4"), v)
+	v, err = db.Get([]byte("c"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("3"), v)
+}
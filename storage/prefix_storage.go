@@ -0,0 +1,117 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+// PrefixStorage is an area of a storage whose keys are stored under a prefix, e.g. a scratch area that is written beside
+// the live keys of the storage. The keys read and iterated through the area are the ones without the prefix
+type PrefixStorage struct {
+	db     Storage
+	prefix []byte
+}
+
+//NewPrefixStorage returns the area of the storage under the prefix
+func NewPrefixStorage(db Storage, prefix []byte) *PrefixStorage {
+	return &PrefixStorage{db: db, prefix: append([]byte{}, prefix...)}
+}
+
+//Close does nothing, as the storage under the area stays open
+func (ps *PrefixStorage) Close() error {
+	return nil
+}
+
+func (ps *PrefixStorage) Get(key []byte) ([]byte, error) {
+	return ps.db.Get(ps.key(key))
+}
+
+func (ps *PrefixStorage) Put(key []byte, val []byte) error {
+	return ps.db.Put(ps.key(key), val)
+}
+
+func (ps *PrefixStorage) Del(key []byte) error {
+	return ps.db.Del(ps.key(key))
+}
+
+func (ps *PrefixStorage) EnableBatch() {
+	ps.db.EnableBatch()
+}
+
+func (ps *PrefixStorage) DisableBatch() {
+	ps.db.DisableBatch()
+}
+
+func (ps *PrefixStorage) Flush() error {
+	return ps.db.Flush()
+}
+
+//NewIterator returns an iterator over the keys in the range [start, limit) of the area
+func (ps *PrefixStorage) NewIterator(start []byte, limit []byte) Iterator {
+	prefixLimit := ps.key(limit)
+	if limit == nil {
+		prefixLimit = prefixEnd(ps.prefix)
+	}
+	return &prefixIterator{ps.db.NewIterator(ps.key(start), prefixLimit), len(ps.prefix)}
+}
+
+//NewPrefixIterator returns an iterator over the keys with the prefix in the area
+func (ps *PrefixStorage) NewPrefixIterator(prefix []byte) Iterator {
+	return &prefixIterator{ps.db.NewPrefixIterator(ps.key(prefix)), len(ps.prefix)}
+}
+
+//NewBatch returns a write batch of the storage whose writes are made in the area
+func (ps *PrefixStorage) NewBatch() Batch {
+	return &prefixBatch{ps.db.NewBatch(), ps}
+}
+
+func (ps *PrefixStorage) key(key []byte) []byte {
+	return append(append([]byte{}, ps.prefix...), key...)
+}
+
+//prefixEnd returns the first key after all keys with the prefix, or nil if there is none
+func prefixEnd(prefix []byte) []byte {
+	end := append([]byte{}, prefix...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
+
+type prefixIterator struct {
+	Iterator
+	prefixLen int
+}
+
+func (it *prefixIterator) Key() []byte {
+	return it.Iterator.Key()[it.prefixLen:]
+}
+
+type prefixBatch struct {
+	Batch
+	storage *PrefixStorage
+}
+
+func (b *prefixBatch) Put(key []byte, val []byte) error {
+	return b.Batch.Put(b.storage.key(key), val)
+}
+
+func (b *prefixBatch) Del(key []byte) error {
+	return b.Batch.Del(b.storage.key(key))
+}
@@ -0,0 +1,69 @@
+// Copyright (C) 2018 go-dappley authors
+//
+// This file is part of the go-dappley library.
+//
+// the go-dappley library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// the go-dappley library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with the go-dappley library.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrefixStorage(t *testing.T) {
+	db := NewRamStorage()
+	db.Put([]byte("a"), []byte("1"))
+	ps := NewPrefixStorage(db, []byte("p_"))
+
+	//the area does not see the keys of the storage and writes its keys under the prefix
+	_, err := ps.Get([]byte("a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+	require.Nil(t, ps.Put([]byte("a"), []byte("2")))
+	batch := ps.NewBatch()
+	batch.Put([]byte("b"), []byte("3"))
+	batch.Put([]byte("c"), []byte("4"))
+	require.Nil(t, batch.Write())
+	require.Nil(t, ps.Del([]byte("c")))
+
+	v, err := ps.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("2"), v)
+	v, err = db.Get([]byte("a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("1"), v)
+	v, err = db.Get([]byte("p_b"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("3"), v)
+
+	//the keys are iterated without the prefix
+	var keys []string
+	it := ps.NewIterator(nil, nil)
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Release()
+	assert.Equal(t, []string{"a", "b"}, keys)
+
+	keys = nil
+	it = ps.NewPrefixIterator([]byte("b"))
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Release()
+	assert.Equal(t, []string{"b"}, keys)
+}
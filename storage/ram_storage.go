@@ -19,6 +19,8 @@
 package storage
 
 import (
+	"bytes"
+	"sort"
 	"sync"
 )
 
@@ -99,3 +101,128 @@ func (rs *RamStorage) DisableBatch() {
 	rs.batchData = make(map[string][]byte)
 	rs.isBatchEnabled = false
 }
+
+//NewIterator returns an iterator over the keys in the range [start, limit). The iterator walks the keys stored when it
+//is created
+func (rs *RamStorage) NewIterator(start []byte, limit []byte) Iterator {
+	return rs.newIterator(func(key []byte) bool {
+		return bytes.Compare(key, start) >= 0 && (limit == nil || bytes.Compare(key, limit) < 0)
+	})
+}
+
+//NewPrefixIterator returns an iterator over the keys with the prefix. The iterator walks the keys stored when it is
+//created
+func (rs *RamStorage) NewPrefixIterator(prefix []byte) Iterator {
+	return rs.newIterator(func(key []byte) bool {
+		return bytes.HasPrefix(key, prefix)
+	})
+}
+
+func (rs *RamStorage) newIterator(filter func(key []byte) bool) Iterator {
+	it := &ramIterator{index: -1}
+	rs.data.Range(func(key, value interface{}) bool {
+		if filter([]byte(key.(string))) {
+			it.keys = append(it.keys, []byte(key.(string)))
+			it.values = append(it.values, value.([]byte))
+		}
+		return true
+	})
+	sort.Sort(it)
+	return it
+}
+
+//NewBatch returns a write batch of the storage
+func (rs *RamStorage) NewBatch() Batch {
+	return &ramBatch{rs: rs}
+}
+
+type ramIterator struct {
+	keys   [][]byte
+	values [][]byte
+	index  int
+}
+
+func (it *ramIterator) Len() int {
+	return len(it.keys)
+}
+
+func (it *ramIterator) Less(i, j int) bool {
+	return bytes.Compare(it.keys[i], it.keys[j]) < 0
+}
+
+func (it *ramIterator) Swap(i, j int) {
+	it.keys[i], it.keys[j] = it.keys[j], it.keys[i]
+	it.values[i], it.values[j] = it.values[j], it.values[i]
+}
+
+func (it *ramIterator) Next() bool {
+	if it.index < len(it.keys) {
+		it.index++
+	}
+	return it.index < len(it.keys)
+}
+
+func (it *ramIterator) Key() []byte {
+	if it.index < 0 || it.index >= len(it.keys) {
+		return nil
+	}
+	return it.keys[it.index]
+}
+
+func (it *ramIterator) Value() []byte {
+	if it.index < 0 || it.index >= len(it.values) {
+		return nil
+	}
+	return it.values[it.index]
+}
+
+func (it *ramIterator) Error() error {
+	return nil
+}
+
+func (it *ramIterator) Release() {
+	it.keys = nil
+	it.values = nil
+	it.index = 0
+}
+
+type batchOp struct {
+	key   string
+	val   []byte
+	isDel bool
+}
+
+type ramBatch struct {
+	rs  *RamStorage
+	ops []batchOp
+}
+
+func (b *ramBatch) Put(key []byte, val []byte) error {
+	b.ops = append(b.ops, batchOp{key: string(key), val: val})
+	return nil
+}
+
+func (b *ramBatch) Del(key []byte) error {
+	b.ops = append(b.ops, batchOp{key: string(key), isDel: true})
+	return nil
+}
+
+func (b *ramBatch) Len() int {
+	return len(b.ops)
+}
+
+func (b *ramBatch) Write() error {
+	for _, op := range b.ops {
+		if op.isDel {
+			b.rs.data.Delete(op.key)
+		} else {
+			b.rs.data.Store(op.key, op.val)
+		}
+	}
+	b.ops = nil
+	return nil
+}
+
+func (b *ramBatch) Discard() {
+	b.ops = nil
+}
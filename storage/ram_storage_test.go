@@ -127,3 +127,81 @@ func TestRamStorage_BatchWrite(t *testing.T) {
 
 	rs.DisableBatch()
 }
+
+func TestRamStorage_Iterator(t *testing.T) {
+	testIterator(t, NewRamStorage())
+}
+
+func TestRamStorage_NewBatch(t *testing.T) {
+	testNewBatch(t, NewRamStorage())
+}
+
+//testIterator checks the range and prefix iterators of the storage
+func testIterator(t *testing.T, s Storage) {
+	for _, key := range []string{"iter_c", "iter_a", "iter_d", "iter_b", "iteration", "item"} {
+		assert.Nil(t, s.Put([]byte(key), []byte("v_"+key)))
+	}
+
+	it := s.NewPrefixIterator([]byte("iter_"))
+	var keys []string
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+		assert.Equal(t, "v_"+string(it.Key()), string(it.Value()))
+	}
+	assert.Nil(t, it.Error())
+	it.Release()
+	assert.Equal(t, []string{"iter_a", "iter_b", "iter_c", "iter_d"}, keys)
+
+	it = s.NewIterator([]byte("iter_b"), []byte("iter_d"))
+	keys = nil
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Release()
+	assert.Equal(t, []string{"iter_b", "iter_c"}, keys)
+
+	//a nil limit iterates to the last key
+	it = s.NewIterator([]byte("iter_d"), nil)
+	keys = nil
+	for it.Next() {
+		keys = append(keys, string(it.Key()))
+	}
+	it.Release()
+	assert.Equal(t, []string{"iter_d", "iteration"}, keys)
+
+	it = s.NewPrefixIterator([]byte("missing"))
+	assert.False(t, it.Next())
+	it.Release()
+}
+
+//testNewBatch checks that batches are applied by Write only and do not affect each other
+func testNewBatch(t *testing.T, s Storage) {
+	assert.Nil(t, s.Put([]byte("batch_old"), []byte("1")))
+
+	batch := s.NewBatch()
+	other := s.NewBatch()
+	assert.Nil(t, batch.Put([]byte("batch_a"), []byte("a")))
+	assert.Nil(t, batch.Put([]byte("batch_a"), []byte("b")))
+	assert.Nil(t, batch.Del([]byte("batch_old")))
+	assert.Nil(t, other.Put([]byte("batch_other"), []byte("c")))
+	assert.Equal(t, 3, batch.Len())
+
+	_, err := s.Get([]byte("batch_a"))
+	assert.Equal(t, ErrKeyInvalid, err)
+
+	assert.Nil(t, batch.Write())
+	assert.Equal(t, 0, batch.Len())
+	v, err := s.Get([]byte("batch_a"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("b"), v)
+	_, err = s.Get([]byte("batch_old"))
+	assert.Equal(t, ErrKeyInvalid, err)
+	_, err = s.Get([]byte("batch_other"))
+	assert.Equal(t, ErrKeyInvalid, err)
+
+	other.Discard()
+	assert.Equal(t, 0, other.Len())
+	assert.Nil(t, other.Write())
+	_, err = s.Get([]byte("batch_other"))
+	assert.Equal(t, ErrKeyInvalid, err)
+}
@@ -175,7 +175,7 @@ func prepareNode(db storage.Storage) (*lblockchain.BlockchainManager, *network.N
 	genesisConf := &configpb.DynastyConfig{}
 	config.LoadConfig(genesisFilePathTest, genesisConf)
 	maxProducers := (int)(genesisConf.GetMaxProducers())
-	dynasty := consensus.NewDynastyWithConfigProducers(genesisConf.GetProducers(), maxProducers)
+	dynasty := consensus.NewDynastyWithConfigProducers(genesisConf.GetProducers(), maxProducers, (int)(genesisConf.GetTimeBetweenBlk()))
 	conss := consensus.NewDPOS(blockproducerinfo.NewBlockProducerInfo(""))
 	conss.SetDynasty(dynasty)
 	node := network.NewNode(db, nil)
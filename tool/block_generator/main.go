@@ -33,7 +33,7 @@ func main() {
 	number := *numberBuffer
 	files := make([]tool.FileInfo, number)
 	maxProducers := (int)(genesisConf.GetMaxProducers())
-	dynasty := consensus.NewDynastyWithConfigProducers(genesisConf.GetProducers(), maxProducers)
+	dynasty := consensus.NewDynastyWithConfigProducers(genesisConf.GetProducers(), maxProducers, (int)(genesisConf.GetTimeBetweenBlk()))
 	keys := tool.LoadPrivateKey()
 	reader := bufio.NewReader(os.Stdin)
 	for i := 0; i < number; i++ {
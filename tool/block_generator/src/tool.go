@@ -16,6 +16,7 @@ import (
 
 	"github.com/dappley/go-dappley/common"
 	"github.com/dappley/go-dappley/consensus"
+	"github.com/dappley/go-dappley/consensus/blocksigner"
 	"github.com/dappley/go-dappley/core/account"
 	"github.com/dappley/go-dappley/logic"
 	"github.com/dappley/go-dappley/storage"
@@ -24,12 +25,11 @@ import (
 )
 
 const (
-	genesisAddr           = "121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"
-	genesisFilePath       = "conf/genesis.conf"
-	defaultPassword       = "password"
-	defaultTimeBetweenBlk = 5
-	contractFunctionCall  = "{\"function\":\"record\",\"args\":[\"dEhFf5mWTSe67mbemZdK3WiJh8FcCayJqm\",\"4\"]}"
-	contractFilePath      = "contract/test_contract.js"
+	genesisAddr          = "121yKAXeG4cw6uaGCBYjWk9yTWmMkhcoDD"
+	genesisFilePath      = "conf/genesis.conf"
+	defaultPassword      = "password"
+	contractFunctionCall = "{\"function\":\"record\",\"args\":[\"dEhFf5mWTSe67mbemZdK3WiJh8FcCayJqm\",\"4\"]}"
+	contractFilePath     = "contract/test_contract.js"
 )
 
 var (
@@ -146,16 +146,25 @@ func makeBlockChainToSize(utxoIndex *lutxo.UTXOIndex, parentBlk *block.Block, bc
 }
 
 func generateBlock(utxoIndex *lutxo.UTXOIndex, parentBlk *block.Block, bc *lblockchain.Blockchain, d *consensus.Dynasty, keys Keys, txs []*transaction.Transaction) *block.Block {
-	producer := account.NewAddress(d.ProducerAtATime(time))
+	//blocks are stamped at the start of their time slot
+	time = time - time%int64(d.GetTimeBetweenBlk()) + int64(d.GetTimeBetweenBlk())
+	shuffler := consensus.NewSlotShuffler(bc)
+	producer := account.NewAddress(shuffler.ShuffleDynasty(d, parentBlk.GetHeight()+1).ProducerAtATime(time))
 	key := keys.getPrivateKeyByAddress(producer)
+	signer, err := blocksigner.NewLocalSignerFromHex(key)
+	if err != nil {
+		logger.WithError(err).Panic("Tool: the key of the producer is invalid.")
+	}
 	cbtx := ltransaction.NewCoinbaseTX(producer, "", parentBlk.GetHeight()+1, common.NewAmount(0))
 	txs = append(txs, &cbtx)
 	utxoIndex.UpdateUtxo(&cbtx)
 	b := block.NewBlockWithTimestamp(txs, parentBlk, time, producer.String())
-	b.SetHash(lblock.CalculateHashWithNonce(b))
+	if err := shuffler.ProveBlock(b, d.GetDynastyTime(), signer); err != nil {
+		logger.WithError(err).Panic("Tool: failed to evaluate the VRF of the block.")
+	}
+	b.SetHash(lblock.CalculateHashWithNonce(b, nil))
 	b.SetNonce(0)
 	lblock.SignBlock(b, key)
-	time = time + defaultTimeBetweenBlk
 	logger.WithFields(logger.Fields{
 		"producer":  producer.String(),
 		"timestamp": time,
@@ -256,6 +265,8 @@ func newTransaction(sender, receiver account.Address, senderKeyPair *account.Key
 	utxos, _ := utxoIndex.GetUTXOsByAmount([]byte(senderPkh), amount)
 
 	sendTxParam := transaction.NewSendTxParam(sender, senderKeyPair, receiver, amount, common.NewAmount(0), gasLimit, gasPrice, contract)
+	//the blockchains are created with the default genesis block
+	sendTxParam.ChainID = lblockchain.DefaultChainID
 	tx, err := ltransaction.NewUTXOTransaction(utxos, sendTxParam)
 
 	if err != nil {
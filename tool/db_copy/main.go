@@ -0,0 +1,51 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+
+	"github.com/dappley/go-dappley/storage"
+)
+
+//db_copy copies all keys of a database into a new database, which may use another storage engine
+func main() {
+	var srcPath, srcEngine, dstPath, dstEngine string
+	flag.StringVar(&srcPath, "from", "", "path of the source database")
+	flag.StringVar(&srcEngine, "fromEngine", storage.LevelDBEngine, "engine of the source database, leveldb or badger")
+	flag.StringVar(&dstPath, "to", "", "path of the destination database")
+	flag.StringVar(&dstEngine, "toEngine", storage.BadgerEngine, "engine of the destination database, leveldb or badger")
+	flag.Parse()
+
+	if srcPath == "" || dstPath == "" {
+		flag.Usage()
+		return
+	}
+	if !storage.DbExists(srcPath) {
+		fmt.Printf("Source database %v does not exist\n", srcPath)
+		return
+	}
+	if storage.DbExists(dstPath) {
+		fmt.Printf("Destination database %v already exists\n", dstPath)
+		return
+	}
+
+	src, err := storage.OpenStorage(srcEngine, srcPath)
+	if err != nil {
+		fmt.Printf("Open source database error %v\n", err)
+		return
+	}
+	defer src.Close()
+	dst, err := storage.OpenStorage(dstEngine, dstPath)
+	if err != nil {
+		fmt.Printf("Open destination database error %v\n", err)
+		return
+	}
+	defer dst.Close()
+
+	count, err := storage.CopyStorage(src, dst)
+	if err != nil {
+		fmt.Printf("Copy error after %v keys %v\n", count, err)
+		return
+	}
+	fmt.Printf("Copied %v keys from %v (%v) to %v (%v)\n", count, srcPath, srcEngine, dstPath, dstEngine)
+}
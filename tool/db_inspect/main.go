@@ -31,6 +31,7 @@ var commands = []CommandInfo{
 	{"getTransaction", "Get transaction information by hash", GetTransactionHandle},
 	{"getCostTransaction", "Get cost transaction block", GetCostTransactionHandle},
 	{"getUtxo", "Get utxo of address", GetUtxoHandle},
+	{"listKeys", "List keys with a prefix", ListKeysHandle},
 }
 
 func GetBlockHandle() {
@@ -215,6 +216,48 @@ func GetUtxoHandle() {
 	dumpUtxos(utxoTx)
 }
 
+func ListKeysHandle() {
+	var dbPath string
+	var prefix string
+	var hexPrefix string
+	var limit int
+
+	flagSet := flag.NewFlagSet("listKeys", flag.ExitOnError)
+	flagSet.StringVar(&dbPath, "d", "", "database path")
+	flagSet.StringVar(&prefix, "prefix", "", "key prefix")
+	flagSet.StringVar(&hexPrefix, "hex", "", "key prefix in hex, used instead of -prefix")
+	flagSet.IntVar(&limit, "limit", 100, "maximum number of keys listed, 0 for all keys")
+	flagSet.Parse(os.Args[2:])
+
+	prefixBytes := []byte(prefix)
+	if hexPrefix != "" {
+		var err error
+		prefixBytes, err = hex.DecodeString(hexPrefix)
+		if err != nil {
+			panic("Decode key prefix failed " + hexPrefix)
+		}
+	}
+
+	db := storage.OpenDatabase(dbPath)
+	defer db.Close()
+
+	it := db.NewPrefixIterator(prefixBytes)
+	defer it.Release()
+	count := 0
+	for it.Next() {
+		if limit > 0 && count >= limit {
+			fmt.Printf("... more keys not listed\n")
+			break
+		}
+		fmt.Printf("%v\t%v bytes\n", hex.EncodeToString(it.Key()), len(it.Value()))
+		count++
+	}
+	if err := it.Error(); err != nil {
+		panic("Iterate keys failed " + err.Error())
+	}
+	fmt.Printf("listed %v keys\n", count)
+}
+
 func block2PrettyPb(block *block.Block) proto.Message {
 	blockHeaderPb := &db_inspect_pb.BlockHeader{
 		Hash:         block.GetHash().String(),
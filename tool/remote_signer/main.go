@@ -0,0 +1,134 @@
+package main
+
+import (
+	"crypto/tls"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/dappley/go-dappley/consensus/blocksigner"
+	"github.com/dappley/go-dappley/storage"
+	"github.com/dappley/go-dappley/util"
+)
+
+type HandleFunc func()
+
+type CommandInfo struct {
+	command     string
+	description string
+	handle      HandleFunc
+}
+
+var commands = []CommandInfo{
+	{"import", "Encrypt a producer private key into a keystore file", ImportHandle},
+	{"serve", "Serve the producer key in a keystore file to the nodes of the producer", ServeHandle},
+}
+
+func ImportHandle() {
+	var keystorePath string
+	var privateKey string
+
+	flagSet := flag.NewFlagSet("import", flag.ExitOnError)
+	flagSet.StringVar(&keystorePath, "keystore", "producer.keystore", "keystore file path")
+	flagSet.StringVar(&privateKey, "key", "", "producer private key in hex")
+	flagSet.Parse(os.Args[2:])
+
+	signer, err := blocksigner.NewLocalSignerFromHex(privateKey)
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	passphrase := util.Stdin.GetPassPhrase("Please input the passphrase of the keystore:", true)
+	if err := signer.SaveKeystore(keystorePath, []byte(passphrase)); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	fmt.Printf("The key of %v is saved to %v\n", signer.GetAddress(), keystorePath)
+}
+
+func ServeHandle() {
+	var keystorePath string
+	var dbPath string
+	var address string
+	var timeBetweenBlk uint64
+	var certPath string
+	var keyPath string
+	var caPath string
+
+	flagSet := flag.NewFlagSet("serve", flag.ExitOnError)
+	flagSet.StringVar(&keystorePath, "keystore", "producer.keystore", "keystore file path")
+	flagSet.StringVar(&dbPath, "d", "signer.db", "database path of the signed slots")
+	flagSet.StringVar(&address, "listen", "unix:///tmp/dappley_signer.sock", "host:port or unix:///path to listen")
+	flagSet.Uint64Var(&timeBetweenBlk, "blktime", 0, "block interval of the network in seconds")
+	flagSet.StringVar(&certPath, "cert", "", "server certificate file, required on a TCP address")
+	flagSet.StringVar(&keyPath, "key", "", "server certificate key file")
+	flagSet.StringVar(&caPath, "ca", "", "CA file that issues the client certificates of the nodes")
+	flagSet.Parse(os.Args[2:])
+
+	if timeBetweenBlk == 0 {
+		fmt.Println("Error: the block interval of the network is required")
+		return
+	}
+	var tlsConfig *tls.Config
+	if certPath != "" {
+		var err error
+		tlsConfig, err = blocksigner.LoadTLSConfig(certPath, keyPath, caPath)
+		if err != nil {
+			fmt.Printf("Error: %v\n", err)
+			return
+		}
+	}
+
+	passphrase := util.Stdin.GetPassPhrase("Please input the passphrase of the keystore:", false)
+	signer, err := blocksigner.LoadLocalSigner(keystorePath, []byte(passphrase))
+	if err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+
+	db := storage.OpenDatabase(dbPath)
+	defer db.Close()
+
+	server := blocksigner.NewSignerServer(signer, blocksigner.NewSignGuard(db, timeBetweenBlk))
+	if err := server.Start(address, tlsConfig); err != nil {
+		fmt.Printf("Error: %v\n", err)
+		return
+	}
+	defer server.Stop()
+	fmt.Printf("Serving the key of %v at %v\n", signer.GetAddress(), address)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	<-sigs
+}
+
+func printUsage() {
+	fmt.Printf("Usage:\n")
+	fmt.Printf("\tremote_signer <commands> [options]\n\n")
+	fmt.Printf("The commands are:\n\n")
+
+	for _, command := range commands {
+		fmt.Printf("\t %v \t %v\n", command.command, command.description)
+	}
+
+	fmt.Printf("Use \"remote_signer <command> -h\" for more information about a command.\n")
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+		return
+	}
+
+	commandStr := os.Args[1]
+	for _, command := range commands {
+		if command.command == commandStr {
+			command.handle()
+			return
+		}
+	}
+
+	printUsage()
+}
@@ -41,7 +41,11 @@ func main() {
 
 	isScDeployed, scAddr := deploySmartContract(dappSdk, account)
 
-	sender := util.NewBatchTxSender(toolConfigs.GetTps(), account, dappSdk, toolConfigs.GetScFreq(), scAddr)
+	chainID, err := dappSdk.GetChainID()
+	if err != nil {
+		logger.WithError(err).Panic("Unable to get the chain id from the server!")
+	}
+	sender := util.NewBatchTxSender(toolConfigs.GetTps(), account, dappSdk, toolConfigs.GetScFreq(), scAddr, chainID)
 	if isScDeployed {
 		sender.EnableSmartContract()
 	}
@@ -29,9 +29,10 @@ type BatchTxSender struct {
 	scAddr      string
 	scSendFreq  uint32
 	scCounter   uint32
+	chainID     uint64
 }
 
-func NewBatchTxSender(tps uint32, account *sdk.DappSdkAccount, dappSdk *sdk.DappSdk, smartContractSendFreq uint32, scAddr string) *BatchTxSender {
+func NewBatchTxSender(tps uint32, account *sdk.DappSdkAccount, dappSdk *sdk.DappSdk, smartContractSendFreq uint32, scAddr string, chainID uint64) *BatchTxSender {
 	return &BatchTxSender{
 		tps:         tps,
 		account:     account,
@@ -41,6 +42,7 @@ func NewBatchTxSender(tps uint32, account *sdk.DappSdkAccount, dappSdk *sdk.Dapp
 		scAddr:      scAddr,
 		scSendFreq:  smartContractSendFreq,
 		scCounter:   0,
+		chainID:     chainID,
 	}
 }
 
@@ -185,6 +187,7 @@ func (sender *BatchTxSender) createTransaction(from, to account.Address, amount,
 		return nil
 	}
 	sendTxParam := transaction.NewSendTxParam(from, senderKeyPair, to, amount, tip, gasLimit, gasPrice, contract)
+	sendTxParam.ChainID = sender.chainID
 	tx, err := ltransaction.NewUTXOTransaction(prevUtxos, sendTxParam)
 
 	if err != nil {
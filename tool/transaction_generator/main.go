@@ -73,7 +73,7 @@ func prepareTestTransactions(dappSdk *sdk.DappSdk, account *sdk.DappSdkAccount)
 	}
 }
 
-func prepareSendParameters(account *sdk.DappSdkAccount) transaction.SendTxParam {
+func prepareSendParameters(account *sdk.DappSdkAccount, chainID uint64) transaction.SendTxParam {
 
 	fromAddr := account.GetAddrs()[0]
 	toAddr := account.GetAddrs()[1]
@@ -86,10 +86,15 @@ func prepareSendParameters(account *sdk.DappSdkAccount) transaction.SendTxParam
 		common.NewAmount(0),
 		common.NewAmount(0),
 		"",
+		chainID,
 	}
 }
 
 func sendTestTransactions(dappSdk *sdk.DappSdk, account *sdk.DappSdkAccount, testTransactions []util.TestTransaction) {
+	chainID, err := dappSdk.GetChainID()
+	if err != nil {
+		logger.WithError(err).Panic("Unable to get the chain id from the server!")
+	}
 	nextBlockTicker := tool.NewNextBlockTicker(dappSdk)
 	nextBlockTicker.Run()
 
@@ -100,7 +105,7 @@ func sendTestTransactions(dappSdk *sdk.DappSdk, account *sdk.DappSdkAccount, tes
 		logger.Info("Running test #", i)
 		testTx.Print()
 		account.Update()
-		testTx.Generate(prepareSendParameters(account))
+		testTx.Generate(prepareSendParameters(account, chainID))
 		testTx.Send()
 	}
 }
@@ -35,7 +35,7 @@ func (txSender *DoubleSpendingTxSender) Generate(params transaction.SendTxParam)
 	toTA := account.NewTransactionAccountByAddress(params.To)
 
 	vouts := prepareOutputLists(prevUtxos, fromTA, toTA, params.Amount, params.Tip)
-	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair)
+	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair, params.ChainID)
 }
 
 func (txSender *DoubleSpendingTxSender) Send() {
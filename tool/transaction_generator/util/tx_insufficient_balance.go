@@ -38,7 +38,7 @@ func (txSender *InsufficientBalanceTxSender) Generate(params transaction.SendTxP
 	vouts := prepareOutputLists(prevUtxos, fromTA, toTA, params.Amount, params.Tip)
 	vouts[0].Value = vouts[0].Value.Add(common.NewAmount(1))
 
-	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair)
+	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair, params.ChainID)
 }
 
 func (txSender *InsufficientBalanceTxSender) Send() {
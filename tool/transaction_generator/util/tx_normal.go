@@ -35,7 +35,7 @@ func (txSender *NormalTxSender) Generate(params transaction.SendTxParam) {
 	fromTA := account.NewTransactionAccountByAddress(params.From)
 	toTA := account.NewTransactionAccountByAddress(params.To)
 	vouts := prepareOutputLists(prevUtxos, fromTA, toTA, params.Amount, params.Tip)
-	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair)
+	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair, params.ChainID)
 }
 
 func (txSender *NormalTxSender) Send() {
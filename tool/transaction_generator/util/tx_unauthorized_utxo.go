@@ -42,7 +42,7 @@ func (txSender *UnauthorizedUtxoTxSender) Generate(params transaction.SendTxPara
 	fromTA := account.NewTransactionAccountByAddress(params.From)
 	toTA := account.NewTransactionAccountByAddress(params.To)
 	vouts := prepareOutputLists(prevUtxos, fromTA, toTA, params.Amount, params.Tip)
-	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair)
+	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair, params.ChainID)
 }
 
 func (txSender *UnauthorizedUtxoTxSender) Send() {
@@ -46,7 +46,7 @@ func (txSender *UnexistingUtxoTxSender) Generate(params transaction.SendTxParam)
 	prevUtxos = append(prevUtxos, unexistingUtxo)
 
 	vouts := prepareOutputLists(prevUtxos, fromTA, toTA, params.Amount, params.Tip)
-	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair)
+	txSender.tx = NewTransaction(prevUtxos, vouts, params.Tip, params.SenderKeyPair, params.ChainID)
 }
 
 func (txSender *UnexistingUtxoTxSender) Send() {
@@ -11,7 +11,7 @@ import (
 	"time"
 )
 
-func NewTransaction(prevUtxos []*utxo.UTXO, vouts []transactionbase.TXOutput, tip *common.Amount, senderKeyPair *account.KeyPair) *transaction.Transaction {
+func NewTransaction(prevUtxos []*utxo.UTXO, vouts []transactionbase.TXOutput, tip *common.Amount, senderKeyPair *account.KeyPair, chainID uint64) *transaction.Transaction {
 	tx := &transaction.Transaction{
 		nil,
 		prepareInputLists(prevUtxos, senderKeyPair.GetPublicKey(), nil),
@@ -19,7 +19,7 @@ func NewTransaction(prevUtxos []*utxo.UTXO, vouts []transactionbase.TXOutput, ti
 		tip,
 		common.NewAmount(0),
 		common.NewAmount(0),
-		time.Now().UnixNano() / 1e6, transaction.TxTypeDefault}
+		time.Now().UnixNano() / 1e6, transaction.TxTypeDefault, chainID}
 	tx.ID = tx.Hash()
 
 	err := ltransaction.NewTxDecorator(tx).Sign(senderKeyPair.GetPrivateKey(), prevUtxos)
@@ -151,7 +151,7 @@ module.exports = new StorageTest();
 `
 
 	ss := scState.NewScState()
-	ss.GetStorageByAddress(dummyAddr)["key"] = "7"
+	ss.Set(dummyAddr, "key", "7")
 	sc := NewV8Engine()
 	sc.ImportSourceCode(script)
 	sc.ImportContractAddr(account.NewAddress(dummyAddr))
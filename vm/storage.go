@@ -38,7 +38,7 @@ func StorageGetFunc(address unsafe.Pointer, key *C.char) *C.char {
 		return nil
 	}
 
-	val := engine.state.GetStorageByAddress(engine.contractAddr.String())[goKey]
+	val := engine.state.Get(engine.contractAddr.String(), goKey)
 	if val == "" {
 		logger.WithFields(logger.Fields{
 			"contract_address": addr,
@@ -65,8 +65,7 @@ func StorageSetFunc(address unsafe.Pointer, key, value *C.char) int {
 		return 1
 	}
 
-	engine.state.GetStorageByAddress(engine.contractAddr.String())[goKey] = goVal
-	return 0
+	return engine.state.Set(engine.contractAddr.String(), goKey, goVal)
 }
 
 //export StorageDelFunc
@@ -82,6 +81,6 @@ func StorageDelFunc(address unsafe.Pointer, key *C.char) int {
 		}).Debug("SmartContract: failed to get state handler!")
 		return 1
 	}
-	delete(engine.state.GetStorageByAddress(engine.contractAddr.String()), goKey)
+	engine.state.Del(engine.contractAddr.String(), goKey)
 	return 0
 }